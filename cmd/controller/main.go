@@ -27,16 +27,24 @@ import (
 	"knative.dev/pkg/injection/sharedmain"
 
 	"knative.dev/eventing/pkg/reconciler/apiserversource"
+	"knative.dev/eventing/pkg/reconciler/argocdapplicationsetsource"
+	"knative.dev/eventing/pkg/reconciler/brokerstatussource"
 	"knative.dev/eventing/pkg/reconciler/channel"
+	"knative.dev/eventing/pkg/reconciler/channelstatussource"
+	"knative.dev/eventing/pkg/reconciler/clusterclaimsource"
 	"knative.dev/eventing/pkg/reconciler/containersource"
 	"knative.dev/eventing/pkg/reconciler/eventtype"
+	"knative.dev/eventing/pkg/reconciler/nodepressuresource"
 	"knative.dev/eventing/pkg/reconciler/parallel"
+	"knative.dev/eventing/pkg/reconciler/permissionauditsource"
 	"knative.dev/eventing/pkg/reconciler/pingsource"
+	"knative.dev/eventing/pkg/reconciler/secretrotationsource"
 	"knative.dev/eventing/pkg/reconciler/sequence"
 	sourcecrd "knative.dev/eventing/pkg/reconciler/source/crd"
 	"knative.dev/eventing/pkg/reconciler/subscription"
 	sugarnamespace "knative.dev/eventing/pkg/reconciler/sugar/namespace"
 	sugartrigger "knative.dev/eventing/pkg/reconciler/sugar/trigger"
+	"knative.dev/eventing/pkg/reconciler/whereaboutssource"
 )
 
 func main() {
@@ -74,6 +82,14 @@ func main() {
 		apiserversource.NewController,
 		pingsource.NewController,
 		containersource.NewController,
+		nodepressuresource.NewController,
+		argocdapplicationsetsource.NewController,
+		whereaboutssource.NewController,
+		brokerstatussource.NewController,
+		secretrotationsource.NewController,
+		clusterclaimsource.NewController,
+		permissionauditsource.NewController,
+		channelstatussource.NewController,
 		// Sources CRD
 		sourcecrd.NewController,
 
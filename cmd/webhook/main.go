@@ -72,10 +72,18 @@ var ourTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	// v1beta2
 	sourcesv1beta2.SchemeGroupVersion.WithKind("PingSource"): &sourcesv1beta2.PingSource{},
 	// v1
-	sourcesv1.SchemeGroupVersion.WithKind("ApiServerSource"): &sourcesv1.ApiServerSource{},
-	sourcesv1.SchemeGroupVersion.WithKind("PingSource"):      &sourcesv1.PingSource{},
-	sourcesv1.SchemeGroupVersion.WithKind("SinkBinding"):     &sourcesv1.SinkBinding{},
-	sourcesv1.SchemeGroupVersion.WithKind("ContainerSource"): &sourcesv1.ContainerSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("ApiServerSource"):            &sourcesv1.ApiServerSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("PingSource"):                 &sourcesv1.PingSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("SinkBinding"):                &sourcesv1.SinkBinding{},
+	sourcesv1.SchemeGroupVersion.WithKind("ContainerSource"):            &sourcesv1.ContainerSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("NodePressureSource"):         &sourcesv1.NodePressureSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("ArgoCDApplicationSetSource"): &sourcesv1.ArgoCDApplicationSetSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("WhereaboutsSource"):          &sourcesv1.WhereaboutsSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("BrokerStatusSource"):         &sourcesv1.BrokerStatusSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("SecretRotationSource"):       &sourcesv1.SecretRotationSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("ClusterClaimSource"):         &sourcesv1.ClusterClaimSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("PermissionAuditSource"):      &sourcesv1.PermissionAuditSource{},
+	sourcesv1.SchemeGroupVersion.WithKind("ChannelStatusSource"):        &sourcesv1.ChannelStatusSource{},
 
 	// For group flows.knative.dev
 	// v1
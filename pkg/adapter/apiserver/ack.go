@@ -0,0 +1,216 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// acknowledger records the delivery of the events on the objects they are
+// about, in their v1.LastEventDeliveredAnnotationKey annotation. The objects
+// are patched within a rate limit, and the deliveries of the events of an
+// object waiting to be patched are recorded at once, with its last delivered
+// event. Like the checkpointer, it does nothing when it is nil.
+type acknowledger struct {
+	limiter *rate.Limiter
+	logger  *zap.SugaredLogger
+
+	mu sync.Mutex
+	// pending holds the acknowledgement waiting to be patched of each object,
+	// by key, and queue their keys in the order they are patched in.
+	pending map[string]acknowledgement
+	queue   []string
+	ready   chan struct{}
+}
+
+// acknowledgementTarget is the object an event is about, and the client of
+// its resource.
+type acknowledgementTarget struct {
+	res  dynamic.ResourceInterface
+	key  string
+	name string
+	uid  types.UID
+}
+
+// acknowledgement is the value of the annotation of a target.
+type acknowledgement struct {
+	target acknowledgementTarget
+	value  string
+}
+
+type acknowledgementTargetKey struct{}
+
+func newAcknowledger(patchesPerSecond int32, logger *zap.SugaredLogger) *acknowledger {
+	if patchesPerSecond < 1 {
+		patchesPerSecond = v1.DefaultApiServerSourceAcknowledgementPatchesPerSecond
+	}
+	return &acknowledger{
+		limiter: rate.NewLimiter(rate.Limit(patchesPerSecond), 1),
+		logger:  logger,
+		pending: make(map[string]acknowledgement),
+		ready:   make(chan struct{}, 1),
+	}
+}
+
+// withTarget returns a context carrying obj, of the resource of res labelled
+// resource, as the target of the acknowledgement of its event, or ctx when
+// k is nil.
+func (k *acknowledger) withTarget(ctx context.Context, res dynamic.ResourceInterface, resource string, obj interface{}) context.Context {
+	u, ok := obj.(*unstructured.Unstructured)
+	if k == nil || res == nil || !ok || u == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, acknowledgementTargetKey{}, acknowledgementTarget{
+		res:  res,
+		key:  resource + "/" + u.GetNamespace() + "/" + u.GetName(),
+		name: u.GetName(),
+		uid:  u.GetUID(),
+	})
+}
+
+// withoutTarget returns a context carrying no target, for the events which
+// are not about a single object.
+func withoutTarget(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(acknowledgementTargetKey{}).(acknowledgementTarget); !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, acknowledgementTargetKey{}, nil)
+}
+
+// delivered records the delivery of the event id on the target ctx carries.
+func (k *acknowledger) delivered(ctx context.Context, id string) {
+	if k == nil {
+		return
+	}
+	t, ok := ctx.Value(acknowledgementTargetKey{}).(acknowledgementTarget)
+	if !ok {
+		return
+	}
+	value := id + "@" + time.Now().UTC().Format(time.RFC3339)
+	k.mu.Lock()
+	if _, ok := k.pending[t.key]; !ok {
+		k.queue = append(k.queue, t.key)
+	}
+	k.pending[t.key] = acknowledgement{target: t, value: value}
+	k.mu.Unlock()
+	select {
+	case k.ready <- struct{}{}:
+	default:
+	}
+}
+
+// run patches the pending acknowledgements within the rate limit until
+// stopCh is closed.
+func (k *acknowledger) run(ctx context.Context, stopCh <-chan struct{}) {
+	for {
+		select {
+		case <-k.ready:
+		case <-stopCh:
+			return
+		}
+		for {
+			ack, ok := k.next()
+			if !ok {
+				break
+			}
+			if err := k.limiter.Wait(ctx); err != nil {
+				return
+			}
+			k.patch(ctx, ack)
+		}
+	}
+}
+
+// next pops the oldest pending acknowledgement.
+func (k *acknowledger) next() (acknowledgement, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if len(k.queue) == 0 {
+		return acknowledgement{}, false
+	}
+	key := k.queue[0]
+	k.queue = k.queue[1:]
+	ack := k.pending[key]
+	delete(k.pending, key)
+	return ack, true
+}
+
+// patch sets the annotation of the target of ack. The uid of the target is
+// patched too, so an object recreated with the same name is left as is.
+func (k *acknowledger) patch(ctx context.Context, ack acknowledgement) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"uid": ack.target.uid,
+			"annotations": map[string]string{
+				v1.LastEventDeliveredAnnotationKey: ack.value,
+			},
+		},
+	})
+	if err != nil {
+		k.logger.Errorw("Failed to encode the acknowledgement", zap.Error(err))
+		return
+	}
+	if _, err := ack.target.res.Patch(ctx, ack.target.name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			k.logger.Debugw("Object deleted before its acknowledgement", zap.String("object", ack.target.key))
+			return
+		}
+		k.logger.Warnw("Failed to acknowledge the delivery", zap.String("object", ack.target.key), zap.Error(err))
+	}
+}
+
+// echo returns whether obj only changed from old by the acknowledgement of
+// the delivery of an event, which is not sent then.
+func (k *acknowledger) echo(old *unstructured.Unstructured, obj interface{}) bool {
+	u, ok := obj.(*unstructured.Unstructured)
+	if k == nil || old == nil || !ok || u == nil {
+		return false
+	}
+	if old.GetAnnotations()[v1.LastEventDeliveredAnnotationKey] == u.GetAnnotations()[v1.LastEventDeliveredAnnotationKey] {
+		return false
+	}
+	return equality.Semantic.DeepEqual(unacknowledged(old), unacknowledged(u))
+}
+
+// unacknowledged returns the content of u without its acknowledgement, and
+// without the metadata its patch changes.
+func unacknowledged(u *unstructured.Unstructured) map[string]interface{} {
+	c := u.DeepCopy()
+	annotations := c.GetAnnotations()
+	delete(annotations, v1.LastEventDeliveredAnnotationKey)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	c.SetAnnotations(annotations)
+	c.SetResourceVersion("")
+	c.SetManagedFields(nil)
+	return c.Object
+}
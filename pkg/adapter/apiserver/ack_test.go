@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func acknowledgedPod() *unstructured.Unstructured {
+	pod := simplePod("unit", "test")
+	pod.SetUID("unit-uid")
+	pod.SetResourceVersion("1")
+	return pod
+}
+
+func TestAcknowledgerNil(t *testing.T) {
+	var k *acknowledger
+	ctx := context.Background()
+	if got := k.withTarget(ctx, nil, "pods", acknowledgedPod()); got != ctx {
+		t.Error("Expected withTarget() to return ctx on a nil acknowledger")
+	}
+	k.delivered(ctx, "id")
+	if k.echo(acknowledgedPod(), acknowledgedPod()) {
+		t.Error("Expected no echo on a nil acknowledger")
+	}
+}
+
+func TestAcknowledgerCoalesces(t *testing.T) {
+	k := newAcknowledger(10, zap.NewExample().Sugar())
+	res := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()).Resource(podsGVR).Namespace("test")
+	other := simplePod("other", "test")
+
+	ctx := k.withTarget(context.Background(), res, "pods", acknowledgedPod())
+	k.delivered(ctx, "first")
+	k.delivered(k.withTarget(context.Background(), res, "pods", other), "other")
+	k.delivered(ctx, "last")
+	k.delivered(withoutTarget(ctx), "batch")
+
+	ack, ok := k.next()
+	if !ok || ack.target.name != "unit" || !strings.HasPrefix(ack.value, "last@") {
+		t.Errorf("Expected the last delivery of unit first, got %q of %q", ack.value, ack.target.name)
+	}
+	if ack, ok = k.next(); !ok || ack.target.name != "other" {
+		t.Errorf("Expected the delivery of other next, got %q", ack.target.name)
+	}
+	if _, ok = k.next(); ok {
+		t.Error("Expected no more pending acknowledgements")
+	}
+}
+
+func TestAcknowledgerEcho(t *testing.T) {
+	k := newAcknowledger(10, zap.NewExample().Sugar())
+	old := acknowledgedPod()
+
+	acked := acknowledgedPod()
+	acked.SetAnnotations(map[string]string{v1.LastEventDeliveredAnnotationKey: "id@2022-01-01T00:00:00Z"})
+	acked.SetResourceVersion("2")
+	if !k.echo(old, acked) {
+		t.Error("Expected the acknowledgement to be an echo")
+	}
+
+	changed := acked.DeepCopy()
+	changed.SetLabels(map[string]string{"app": "web"})
+	if k.echo(old, changed) {
+		t.Error("Expected a change along with the acknowledgement not to be an echo")
+	}
+	if k.echo(acked, acked) {
+		t.Error("Expected a change without acknowledgement not to be an echo")
+	}
+}
+
+func TestResourceDelegateAcknowledgement(t *testing.T) {
+	sc := runtime.NewScheme()
+	_ = corev1.AddToScheme(sc)
+	k8s := dynamicfake.NewSimpleDynamicClient(sc, acknowledgedPod())
+	res := k8s.Resource(podsGVR).Namespace("test")
+
+	d, ce := makeResourceAndTestingClient()
+	d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+	d.acks = newAcknowledger(10, d.logger)
+	d.res = res
+	d.resource = "pods"
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go d.acks.run(context.Background(), stopCh)
+
+	if err := d.Add(acknowledgedPod()); err != nil {
+		t.Fatal("Add() =", err)
+	}
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+
+	var acked *unstructured.Unstructured
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var err error
+		if acked, err = res.Get(context.Background(), "unit", metav1.GetOptions{}); err != nil {
+			t.Fatal("Get() =", err)
+		}
+		if strings.HasPrefix(acked.GetAnnotations()[v1.LastEventDeliveredAnnotationKey], sent[0].ID()+"@") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the delivery of %s to be acknowledged, got %v", sent[0].ID(), acked.GetAnnotations())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The update of the acknowledgement is not sent.
+	if err := d.Update(acked); err != nil {
+		t.Fatal("Update() =", err)
+	}
+	if got := len(ce.Sent()); got != 1 {
+		t.Errorf("Expected the acknowledgement not to be sent, got %d events", got)
+	}
+}
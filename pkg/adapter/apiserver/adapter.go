@@ -18,20 +18,29 @@ package apiserver
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/cel-go/cel"
+	"github.com/rickb777/date/period"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
 	"knative.dev/eventing/pkg/adapter/v2"
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/metrics/source"
 )
 
 type envConfig struct {
@@ -39,21 +48,54 @@ type envConfig struct {
 	Name string `envconfig:"NAME" required:"true"`
 
 	ConfigJson string `envconfig:"K_SOURCE_CONFIG" required:"true"`
+
+	// DisableDeduplication sends the duplicate notifications of the objects
+	// in a same resourceVersion again, rather than suppressing them.
+	DisableDeduplication bool `envconfig:"K_DISABLE_DEDUPLICATION" default:"false"`
+
+	// DrainTimeout bounds the wait for the deliveries of the events in
+	// flight on shutdown. It defaults to 25s, within the default termination
+	// grace period of 30s of the pod.
+	DrainTimeout time.Duration `envconfig:"K_DRAIN_TIMEOUT" default:"25s"`
 }
 
 type apiServerAdapter struct {
 	ce     cloudevents.Client
 	logger *zap.SugaredLogger
 
+	// deadLetter sends the events whose delivery to sink failed to the dead
+	// letter sink, when there is one.
+	deadLetter cloudevents.Client
+	sink       string
+
+	// sinks are the additional sinks the events are sent to.
+	sinks []*sinkTarget
+
 	config Config
 
+	// disableDeduplication sends the duplicate notifications of the objects
+	// rather than suppressing them.
+	disableDeduplication bool
+
+	// drainTimeout bounds the wait for the deliveries of the events in
+	// flight on shutdown. The wait is not bounded when it is 0.
+	drainTimeout time.Duration
+
 	discover discovery.DiscoveryInterface
 	k8s      dynamic.Interface
+	kube     kubernetes.Interface
 	source   string // TODO: who dis?
 	name     string // TODO: who dis?
+
+	// clusterClients returns the clients of the remote cluster of the
+	// kubeconfig of the config, when it is set.
+	clusterClients clusterClients
 }
 
 func (a *apiServerAdapter) Start(ctx context.Context) error {
+	if a.config.Cluster != nil {
+		return a.startRemote(ctx, ctx.Done())
+	}
 	return a.start(ctx, ctx.Done())
 }
 
@@ -62,27 +104,224 @@ func (a *apiServerAdapter) start(ctx context.Context, stopCh <-chan struct{}) er
 	stop := make(chan struct{})
 
 	resyncPeriod := 10 * time.Hour
+	if a.config.ResyncPeriod != "" {
+		period, err := time.ParseDuration(a.config.ResyncPeriod)
+		if err != nil {
+			return err
+		}
+		resyncPeriod = period
+	}
 
-	var delegate cache.Store = &resourceDelegate{
+	var opts []events.EventOption
+	if a.discover != nil {
+		// The kinds which cannot be mapped, e.g. while the discovery is
+		// unavailable, keep their events with a guessed subject.
+		subjects := events.NewDiscoverySubjectResolver(a.discover, events.WithGuessFallback())
+		opts = append(opts, events.WithSubjectFunc(subjects.Subject))
+	}
+	if a.config.Cluster != nil {
+		opts = append(opts, events.WithCluster(a.config.Cluster.Name))
+	}
+	if a.watchesNamespaces() {
+		if lister := a.peerAuthenticationLister(ctx, stopCh); lister != nil {
+			opts = append(opts, events.WithPeerAuthenticationLister(lister))
+		}
+	}
+	if a.config.EventMode == v1.DiffMode {
+		opts = append(opts, events.WithDiff())
+	}
+	if a.config.Format == v1.KubernetesEventFormat {
+		opts = append(opts, events.WithKubernetesEventFormat())
+	}
+	if len(a.config.FieldsToDrop) > 0 {
+		paths := make([][]string, 0, len(a.config.FieldsToDrop))
+		for _, p := range a.config.FieldsToDrop {
+			path, err := v1.ParseFieldPath(p)
+			if err != nil {
+				a.logger.Errorw("Ignoring invalid field path", zap.String("path", p), zap.Error(err))
+				continue
+			}
+			paths = append(paths, path)
+		}
+		opts = append(opts, events.WithFieldsToDrop(paths))
+	}
+	if len(a.config.ExtensionExpressions) > 0 {
+		extensions := make(map[string]cel.Program, len(a.config.ExtensionExpressions))
+		for name, expr := range a.config.ExtensionExpressions {
+			program, err := v1.CompileExtensionExpression(expr)
+			if err != nil {
+				a.logger.Errorw("Ignoring invalid extension expression", zap.String("extension", name), zap.String("expression", expr), zap.Error(err))
+				continue
+			}
+			extensions[name] = program
+		}
+		opts = append(opts, events.WithExtensionExpressions(extensions))
+	}
+	if a.config.Kafka != nil {
+		opts = append(opts, events.WithPartitionKey())
+	}
+	if a.config.DataContentType != "" {
+		encoder, err := events.NewDataEncoder(a.config.DataContentType)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, events.WithDataEncoder(encoder))
+	}
+	if a.config.DataSchema != "" {
+		opts = append(opts, events.WithDataSchema(a.config.DataSchema))
+	}
+	var timeout time.Duration
+	if a.config.Delivery != nil {
+		retries, err := deliveryRetries(a.config.Delivery)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, retries)
+		if timeout, err = deliveryTimeout(a.config.Delivery); err != nil {
+			return err
+		}
+	}
+
+	resources := &resourceDelegate{
 		ce:                  a.ce,
 		source:              a.source,
 		logger:              a.logger,
 		ref:                 a.config.EventMode == v1.ReferenceMode,
 		apiServerSourceName: a.name,
+		opts:                opts,
+		timeout:             timeout,
+		deadLetter:          a.deadLetter,
+		sink:                a.sink,
+		sinks:               a.sinks,
+		resync:              a.config.ResyncPeriod != "",
+		metrics:             source.NewApiServerStatsReporter(),
+		deliveries:          newDeliveryQueue(),
+	}
+	if !a.disableDeduplication {
+		reporter, err := source.NewDuplicateStatsReporter()
+		if err != nil {
+			return err
+		}
+		resources.versions = newVersionDeduplicator(defaultVersionCacheSize, defaultVersionCacheTTL)
+		resources.duplicates = reporter
+	}
+	if a.config.OwnerRefMode == v1.OwnerRefModeController {
+		maxDepth := int(a.config.OwnerRefMaxDepth)
+		if maxDepth == 0 {
+			maxDepth = v1.DefaultApiServerSourceOwnerRefMaxDepth
+		}
+		resources.owners = newOwnerResolver(ctx, stopCh, a.discover, a.k8s, maxDepth, a.logger)
+		if a.config.OwnerRefDeduplicationWindow != "" {
+			window, err := time.ParseDuration(a.config.OwnerRefDeduplicationWindow)
+			if err != nil {
+				return err
+			}
+			resources.dedup = newOwnerDeduplicator(window)
+		}
+	}
+	if len(a.config.Filters) > 0 {
+		resources.filter = subscriptionsapi.CreateSubscriptionsAPIFilters(ctx, a.config.Filters)
+	}
+	if a.config.RateLimit != nil {
+		reporter, err := source.NewRateLimitedStatsReporter()
+		if err != nil {
+			return err
+		}
+		limiter := newRateLimiter(a.config.RateLimit, a.kube.CoreV1().ConfigMaps(a.config.Namespace), defaultThrottleReportInterval, a.logger)
+		resources.limiter = limiter
+		resources.rateLimited = reporter
+		go limiter.run(ctx, stopCh)
+	}
+	var checkpoint *checkpointer
+	if a.config.Checkpoint != nil {
+		interval, err := time.ParseDuration(a.config.Checkpoint.Interval)
+		if err != nil {
+			return err
+		}
+		checkpoint = newCheckpointer(a.kube.CoreV1().ConfigMaps(a.config.Namespace), a.config.Checkpoint.ConfigMapName, interval, a.logger)
+		if err := checkpoint.load(ctx); err != nil {
+			return err
+		}
+		resources.checkpoint = checkpoint
+		go checkpoint.run(ctx, stopCh)
+		// ctx is done by then, so the last store does without it.
+		defer checkpoint.store(context.Background())
+	}
+	if a.config.Concurrency > 1 {
+		args := &source.ApiServerReportArgs{Namespace: a.config.Namespace, Name: a.name}
+		workers := newWorkerPool(int(a.config.Concurrency), defaultWorkerQueueSize, source.NewApiServerDispatchStatsReporter(), args, a.logger)
+		workers.start()
+		// The workers keep dispatching until the events in flight are
+		// drained.
+		defer workers.stop()
+		resources.workers = workers
+		go workers.run(stopCh, defaultDispatchReportInterval)
+	}
+	if a.config.Acknowledgement != nil {
+		acks := newAcknowledger(a.config.Acknowledgement.PatchesPerSecond, a.logger)
+		resources.acks = acks
+		go acks.run(ctx, stopCh)
+	}
+	if a.config.Pause != nil {
+		pause := newPauser(a.kube.CoreV1().ConfigMaps(a.config.Namespace), a.config.Pause.ConfigMapName, a.logger)
+		if err := pause.load(ctx); err != nil {
+			return err
+		}
+		resources.pause = pause
+		go pause.run(ctx, stopCh)
+	}
+	if a.config.Batch != nil {
+		window, err := time.ParseDuration(a.config.Batch.Window)
+		if err != nil {
+			return err
+		}
+		resources.batcher = newBatcher(int(a.config.Batch.MaxSize), window, resources.sendBatch, a.logger)
 	}
 	if a.config.ResourceOwner != nil {
 		a.logger.Infow("will be filtered",
 			zap.String("APIVersion", a.config.ResourceOwner.APIVersion),
 			zap.String("Kind", a.config.ResourceOwner.Kind))
-		delegate = &controllerFilter{
-			apiVersion: a.config.ResourceOwner.APIVersion,
-			kind:       a.config.ResourceOwner.Kind,
-			delegate:   delegate,
+	}
+	replay := newReplayer(stop, a.logger)
+	// newDelegate returns the store of the reflector of a resource, which
+	// keeps the last seen state of the objects of that resource only, and
+	// reads their scale from res when it is watched. The objects are
+	// replayed on demand until stopCh is closed.
+	newDelegate := func(configRes ResourceWatch, res dynamic.ResourceInterface, stopCh <-chan struct{}) cache.Store {
+		rd := *resources
+		rd.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+		rd.resource = resourceLabel(configRes.GVR)
+		rd.res = res
+		if configRes.ClassifyUpdates {
+			rd.opts = append(rd.opts[:len(rd.opts):len(rd.opts)], events.WithUpdateClassification())
+		}
+		if configRes.EventTypeTemplate != "" {
+			rd.opts = append(rd.opts[:len(rd.opts):len(rd.opts)], events.WithEventTypeTemplate(configRes.EventTypeTemplate))
+		}
+		if len(configRes.Verbs) > 0 {
+			rd.verbs = sets.NewString(configRes.Verbs...)
+		}
+		if configRes.Subresource == v1.ScaleSubresource {
+			rd.scale = newScaleWatcher(ctx, res, a.logger)
 		}
+		replay.register(&rd, stopCh)
+		var delegate cache.Store = &rd
+		if a.config.ResourceOwner != nil {
+			delegate = &controllerFilter{
+				apiVersion: a.config.ResourceOwner.APIVersion,
+				kind:       a.config.ResourceOwner.Kind,
+				delegate:   delegate,
+			}
+		}
+		return delegate
 	}
 
 	a.logger.Infof("STARTING -- %#v", a.config)
 
+	// The namespaced resources watched in the selected namespaces.
+	var namespaced []ResourceWatch
+	selectsNamespaces := len(a.config.Namespaces) > 0 || a.config.NamespaceSelector != ""
+
 	for _, configRes := range a.config.Resources {
 
 		resources, err := a.discover.ServerResourcesForGroupVersion(configRes.GVR.GroupVersion().String())
@@ -94,21 +333,26 @@ func (a *apiServerAdapter) start(ctx context.Context, stopCh <-chan struct{}) er
 		exists := false
 		for _, apires := range resources.APIResources {
 			if apires.Name == configRes.GVR.Resource {
-
-				var res dynamic.ResourceInterface
-				if apires.Namespaced {
-					res = a.k8s.Resource(configRes.GVR).Namespace(a.config.Namespace)
-				} else {
-					res = a.k8s.Resource(configRes.GVR)
+				if checkpoint != nil {
+					checkpoint.watch(configRes.GVR, apires.Kind)
 				}
 
-				lw := &cache.ListWatch{
-					ListFunc:  asUnstructuredLister(ctx, res.List, configRes.LabelSelector),
-					WatchFunc: asUnstructuredWatcher(ctx, res.Watch, configRes.LabelSelector),
+				if configRes.Subresource != "" && !hasSubresource(resources.APIResources, configRes.GVR.Resource, configRes.Subresource) {
+					a.logger.Errorw("Ignoring the subresource the resource does not have",
+						zap.Stringer("resource", configRes.GVR), zap.String("subresource", configRes.Subresource))
+					configRes.Subresource = ""
 				}
 
-				reflector := cache.NewReflector(lw, &unstructured.Unstructured{}, delegate, resyncPeriod)
-				go reflector.Run(stop)
+				switch {
+				case !apires.Namespaced:
+					res := a.k8s.Resource(configRes.GVR)
+					a.watch(ctx, configRes, res, newDelegate(configRes, res, stop), resyncPeriod, stop)
+				case !selectsNamespaces:
+					res := a.k8s.Resource(configRes.GVR).Namespace(a.config.Namespace)
+					a.watch(ctx, configRes, res, newDelegate(configRes, res, stop), resyncPeriod, stop)
+				default:
+					namespaced = append(namespaced, configRes)
+				}
 				exists = true
 				break
 			}
@@ -119,6 +363,24 @@ func (a *apiServerAdapter) start(ctx context.Context, stopCh <-chan struct{}) er
 		}
 	}
 
+	if len(namespaced) > 0 {
+		namespaces := newNamespaceWatcher(func(namespace string, stopCh <-chan struct{}) {
+			for _, configRes := range namespaced {
+				res := a.k8s.Resource(configRes.GVR).Namespace(namespace)
+				if !permitted(ctx, res, a.logger.With(zap.String("namespace", namespace), zap.Stringer("resource", configRes.GVR))) {
+					continue
+				}
+				a.watch(ctx, configRes, res, newDelegate(configRes, res, stopCh), resyncPeriod, stopCh)
+			}
+		}, stop)
+		for _, namespace := range a.config.Namespaces {
+			namespaces.pin(namespace)
+		}
+		if a.config.NamespaceSelector != "" {
+			a.watch(ctx, ResourceWatch{GVR: namespacesGVR, LabelSelector: a.config.NamespaceSelector}, a.k8s.Resource(namespacesGVR), namespaces, 0, stop)
+		}
+	}
+
 	srv := &http.Server{
 		Addr: ":8080",
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -126,20 +388,68 @@ func (a *apiServerAdapter) start(ctx context.Context, stopCh <-chan struct{}) er
 		}),
 	}
 	go srv.ListenAndServe()
+	replayMux := http.NewServeMux()
+	replayMux.Handle(replayPath, replay)
+	replaySrv := &http.Server{
+		Addr:    replayAddr,
+		Handler: replayMux,
+	}
+	go replaySrv.ListenAndServe()
 
 	<-stopCh
-	stop <- struct{}{}
+	// The reflectors and the replays stop, and the events in flight are
+	// drained: the replays in progress return, then the pending batches are
+	// sent, and the deliveries complete, their retries included.
+	close(stop)
 	srv.Shutdown(ctx)
+	replaySrv.Shutdown(ctx)
+	replay.wait()
+	if resources.batcher != nil {
+		done := resources.deliveries.enqueue(nil)
+		go func() {
+			defer done()
+			resources.batcher.flushAll()
+		}()
+	}
+	if dropped := resources.deliveries.drain(a.drainTimeout); dropped > 0 {
+		a.logger.Warnw("Dropped the deliveries still in flight on shutdown", zap.Int("count", dropped), zap.Duration("timeout", a.drainTimeout))
+	}
 	return nil
 }
 
+// hasSubresource returns whether the resource has the subresource, among the
+// resources of its group version.
+func hasSubresource(resources []metav1.APIResource, resource, subresource string) bool {
+	for _, r := range resources {
+		if r.Name == resource+"/"+subresource {
+			return true
+		}
+	}
+	return false
+}
+
+// watch runs the reflector of the objects of res into store, until stopCh is
+// closed.
+func (a *apiServerAdapter) watch(ctx context.Context, configRes ResourceWatch, res dynamic.ResourceInterface, store cache.Store, resyncPeriod time.Duration, stopCh <-chan struct{}) {
+	lw := &cache.ListWatch{
+		ListFunc:  asUnstructuredLister(ctx, res.List, configRes.LabelSelector, configRes.FieldSelector),
+		WatchFunc: asUnstructuredWatcher(ctx, res.Watch, configRes.LabelSelector, configRes.FieldSelector),
+	}
+
+	reflector := cache.NewReflector(lw, &unstructured.Unstructured{}, store, resyncPeriod)
+	go reflector.Run(stopCh)
+}
+
 type unstructuredLister func(context.Context, metav1.ListOptions) (*unstructured.UnstructuredList, error)
 
-func asUnstructuredLister(ctx context.Context, ulist unstructuredLister, selector string) cache.ListFunc {
+func asUnstructuredLister(ctx context.Context, ulist unstructuredLister, selector, fieldSelector string) cache.ListFunc {
 	return func(opts metav1.ListOptions) (runtime.Object, error) {
 		if selector != "" && opts.LabelSelector == "" {
 			opts.LabelSelector = selector
 		}
+		if fieldSelector != "" && opts.FieldSelector == "" {
+			opts.FieldSelector = fieldSelector
+		}
 		ul, err := ulist(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -148,13 +458,53 @@ func asUnstructuredLister(ctx context.Context, ulist unstructuredLister, selecto
 	}
 }
 
+// deliveryRetries returns the option setting the retries of the delivery of
+// the events from delivery. The retry count, backoff policy and backoff delay
+// it leaves unset keep their default.
+func deliveryRetries(delivery *duckv1.DeliverySpec) (events.EventOption, error) {
+	retries := events.DefaultRetries
+	if delivery.Retry != nil {
+		retries = int(*delivery.Retry)
+	}
+	policy := duckv1.BackoffPolicyExponential
+	if delivery.BackoffPolicy != nil {
+		policy = *delivery.BackoffPolicy
+	}
+	delay := events.DefaultBackoffDelay
+	if delivery.BackoffDelay != nil {
+		p, err := period.Parse(*delivery.BackoffDelay)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse backoffDelay: %w", err)
+		}
+		delay, _ = p.Duration()
+	}
+	return events.WithRetries(retries, policy, delay), nil
+}
+
+// deliveryTimeout returns the timeout of the delivery of each event, its
+// retries included, or 0 when delivery sets no timeout.
+func deliveryTimeout(delivery *duckv1.DeliverySpec) (time.Duration, error) {
+	if delivery.Timeout == nil {
+		return 0, nil
+	}
+	p, err := period.Parse(*delivery.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse timeout: %w", err)
+	}
+	timeout, _ := p.Duration()
+	return timeout, nil
+}
+
 type structuredWatcher func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 
-func asUnstructuredWatcher(ctx context.Context, wf structuredWatcher, selector string) cache.WatchFunc {
+func asUnstructuredWatcher(ctx context.Context, wf structuredWatcher, selector, fieldSelector string) cache.WatchFunc {
 	return func(lo metav1.ListOptions) (watch.Interface, error) {
 		if selector != "" && lo.LabelSelector == "" {
 			lo.LabelSelector = selector
 		}
+		if fieldSelector != "" && lo.FieldSelector == "" {
+			lo.FieldSelector = fieldSelector
+		}
 		return wf(ctx, lo)
 	}
 }
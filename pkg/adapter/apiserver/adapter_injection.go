@@ -19,10 +19,15 @@ package apiserver
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
 	"k8s.io/client-go/rest"
 	"knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/metrics/source"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/injection/clients/dynamicclient"
@@ -66,14 +71,83 @@ func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClie
 		panic("failed to create config from json")
 	}
 
+	var ceOverrides *duckv1.CloudEventOverrides
+	if config.DeadLetterSink != "" || len(config.Sinks) > 0 || config.Kafka != nil {
+		var err error
+		if ceOverrides, err = env.GetCloudEventOverrides(); err != nil {
+			logger.Fatalw("Failed to get the CloudEvent overrides", zap.Error(err))
+		}
+	}
+
+	// The events are written to the Kafka topic of the sink rather than
+	// sent to it over HTTP.
+	sink := env.GetSink()
+	if config.Kafka != nil {
+		reporter, err := source.NewStatsReporter()
+		if err != nil {
+			logger.Fatalw("Failed to create the Kafka stats reporter", zap.Error(err))
+		}
+		ceClient, err = newKafkaClient(config.Kafka, ceOverrides, reporter)
+		if err != nil {
+			logger.Fatalw("Failed to create the Kafka client", zap.Strings("bootstrapServers", config.Kafka.BootstrapServers),
+				zap.String("topic", config.Kafka.Topic), zap.Error(err))
+		}
+		sink = "kafka://" + strings.Join(config.Kafka.BootstrapServers, ",") + "/" + config.Kafka.Topic
+	}
+
+	var deadLetter cloudevents.Client
+	if config.DeadLetterSink != "" {
+		reporter, err := source.NewDeadLetterStatsReporter()
+		if err != nil {
+			logger.Fatalw("Failed to create the dead letter sink stats reporter", zap.Error(err))
+		}
+		deadLetter, err = newSinkClient(env, config.DeadLetterSink, config.DeadLetterSinkAudience, ceOverrides, reporter)
+		if err != nil {
+			logger.Fatalw("Failed to create the dead letter sink client", zap.Error(err))
+		}
+	}
+
+	sinks := make([]*sinkTarget, 0, len(config.Sinks))
+	for _, sink := range config.Sinks {
+		reporter, err := source.NewSinkStatsReporter(sink.URI)
+		if err != nil {
+			logger.Fatalw("Failed to create the sink stats reporter", zap.String("sink", sink.URI), zap.Error(err))
+		}
+		client, err := newSinkClient(env, sink.URI, sink.Audience, ceOverrides, reporter)
+		if err != nil {
+			logger.Fatalw("Failed to create the sink client", zap.String("sink", sink.URI), zap.Error(err))
+		}
+		target := &sinkTarget{ce: client, uri: sink.URI}
+		if len(sink.Filters) > 0 {
+			target.filter = subscriptionsapi.CreateSubscriptionsAPIFilters(ctx, sink.Filters)
+		}
+		sinks = append(sinks, target)
+	}
+
 	return &apiServerAdapter{
-		discover: kubeclient.Get(ctx).Discovery(),
-		k8s:      dynamicclient.Get(ctx),
-		ce:       ceClient,
-		source:   Get(ctx),
-		name:     env.Name,
-		config:   config,
+		discover:   kubeclient.Get(ctx).Discovery(),
+		k8s:        dynamicclient.Get(ctx),
+		kube:       kubeclient.Get(ctx),
+		ce:         ceClient,
+		deadLetter: deadLetter,
+		sink:       sink,
+		sinks:      sinks,
+		source:     Get(ctx),
+		name:       env.Name,
+		config:     config,
+
+		disableDeduplication: env.DisableDeduplication,
+		drainTimeout:         env.DrainTimeout,
 
 		logger: logger,
 	}
 }
+
+// newSinkClient returns the client sending the events to the sink at uri,
+// with the certificates of env, or with the egress binding of the scheme of
+// uri. The events are encoded like the events of the sink of env, and sent
+// within its timeout. The events are authenticated with the OIDC token for audience,
+// projected in the OIDC tokens directory of env, when audience is set.
+func newSinkClient(env *envConfig, uri, audience string, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter) (cloudevents.Client, error) {
+	return adapter.NewCloudEventsClientForSink(env, uri, audience, ceOverrides, reporter)
+}
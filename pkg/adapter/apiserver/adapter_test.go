@@ -28,12 +28,14 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	discoveryfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	kubetesting "k8s.io/client-go/testing"
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	rectesting "knative.dev/eventing/pkg/reconciler/testing"
 	"knative.dev/pkg/logging"
 	pkgtesting "knative.dev/pkg/reconciler/testing"
@@ -136,6 +138,58 @@ func TestAdapter_StartResource(t *testing.T) {
 	}
 }
 
+func TestAsUnstructuredListerWatcherSelectors(t *testing.T) {
+	var listed, watched metav1.ListOptions
+	list := func(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		listed = opts
+		return &unstructured.UnstructuredList{}, nil
+	}
+	w := func(_ context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+		watched = opts
+		return watch.NewFake(), nil
+	}
+
+	ctx := context.Background()
+	if _, err := asUnstructuredLister(ctx, list, "app=web", "status.phase=Running")(metav1.ListOptions{}); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, err := asUnstructuredWatcher(ctx, w, "app=web", "status.phase=Running")(metav1.ListOptions{}); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	for _, opts := range []metav1.ListOptions{listed, watched} {
+		if opts.LabelSelector != "app=web" {
+			t.Errorf("Expected label selector %q, got %q", "app=web", opts.LabelSelector)
+		}
+		if opts.FieldSelector != "status.phase=Running" {
+			t.Errorf("Expected field selector %q, got %q", "status.phase=Running", opts.FieldSelector)
+		}
+	}
+}
+
+func TestDeliveryTimeout(t *testing.T) {
+	timeout := "PT2S"
+	got, err := deliveryTimeout(&duckv1.DeliverySpec{Timeout: &timeout})
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if got != 2*time.Second {
+		t.Errorf("Expected timeout %v, got %v", 2*time.Second, got)
+	}
+
+	if got, err := deliveryTimeout(&duckv1.DeliverySpec{}); err != nil || got != 0 {
+		t.Errorf("Expected no timeout, got %v, %v", got, err)
+	}
+
+	invalid := "2s"
+	if _, err := deliveryTimeout(&duckv1.DeliverySpec{Timeout: &invalid}); err == nil {
+		t.Error("Expected an error for an invalid timeout")
+	}
+	if _, err := deliveryRetries(&duckv1.DeliverySpec{BackoffDelay: &invalid}); err == nil {
+		t.Error("Expected an error for an invalid backoff delay")
+	}
+}
+
 func TestAdapter_StartNonNamespacedResource(t *testing.T) {
 	ce := adaptertest.NewTestClient()
 
@@ -183,6 +237,129 @@ func TestAdapter_StartNonNamespacedResource(t *testing.T) {
 	}
 }
 
+func TestAdapter_StartObjectsPerResource(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+
+	config := Config{
+		Namespace: "test",
+		Resources: []ResourceWatch{{
+			GVR: schema.GroupVersionResource{
+				Version:  "v1",
+				Resource: "pods",
+			},
+		}, {
+			GVR: schema.GroupVersionResource{
+				Version:  "v1",
+				Resource: "namespaces",
+			},
+		}},
+		EventMode: "Resource",
+	}
+	ctx, _ := pkgtesting.SetupFakeContext(t)
+
+	k8s := makeDynamicClient(restartedPod(1), simpleNamespace("test"))
+	a := &apiServerAdapter{
+		ce:     ce,
+		logger: logging.FromContext(ctx),
+		config: config,
+
+		discover: makeDiscoveryClient(),
+		k8s:      k8s,
+		source:   "unit-test",
+		name:     "unittest",
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		a.Start(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// Wait for the reflectors to be fully initialized.
+	time.Sleep(1 * time.Second)
+
+	// The initial list of the Namespaces does not drop the last seen state
+	// of the Pods.
+	pods := k8s.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace("test")
+	if _, err := pods.Update(ctx, restartedPod(3), metav1.UpdateOptions{}); err != nil {
+		t.Fatal("Update() =", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(ce.Sent()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+	if got, want := sent[0].Extensions()["newrestarts"], int32(2); got != want {
+		t.Errorf("newrestarts = %v, want %v", got, want)
+	}
+}
+
+// Common methods:
+
+// GetDynamicClient returns the mockDynamicClient to use for this test case.
+func TestAdapter_StartSubjects(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+
+	config := Config{
+		Resources: []ResourceWatch{{
+			GVR: schema.GroupVersionResource{
+				Version:  "v1",
+				Resource: "namespaces",
+			},
+		}},
+		EventMode: "Resource",
+	}
+	ctx, _ := pkgtesting.SetupFakeContext(t)
+
+	a := &apiServerAdapter{
+		ce:     ce,
+		logger: logging.FromContext(ctx),
+		config: config,
+
+		discover: makeDiscoveryClient(),
+		k8s:      makeDynamicClient(simpleNamespace("foo")),
+		source:   "unit-test",
+		name:     "unittest",
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		_ = a.Start(ctx)
+		close(done)
+	}()
+
+	// Wait for the reflector to be fully initialized, the objects listed
+	// initially are not sent.
+	time.Sleep(1 * time.Second)
+	if _, err := a.k8s.Resource(namespacesGVR).Create(ctx, simpleNamespace("bar"), metav1.CreateOptions{}); err != nil {
+		t.Fatal("Failed to create the namespace:", err)
+	}
+	for i := 0; i < 50 && len(ce.Sent()) == 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	sent := ce.Sent()
+	if len(sent) == 0 {
+		t.Fatal("Expected an event to be sent")
+	}
+	// The subjects of the cluster scoped objects have no namespace.
+	if got, want := sent[0].Subject(), "/api/v1/namespaces/bar"; got != want {
+		t.Errorf("Expected subject %q, got %q", want, got)
+	}
+}
+
 // Common methods:
 
 // GetDynamicClient returns the mockDynamicClient to use for this test case.
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+)
+
+// batcher coalesces the events of the resources of a same kind into batch
+// events. A batch is sent when it is full, or when the window of its first
+// event is over.
+type batcher struct {
+	maxSize int
+	window  time.Duration
+	send    func(context.Context, cloudevents.Event)
+	logger  *zap.SugaredLogger
+
+	mu      sync.Mutex
+	batches map[string]*batch
+}
+
+type batch struct {
+	// ctx is the context of the first event of the batch, which the batch
+	// event is sent with. It carries the checkpoint mark of the last event
+	// of the batch.
+	ctx    context.Context
+	events []cloudevents.Event
+	timer  *time.Timer
+}
+
+func newBatcher(maxSize int, window time.Duration, send func(context.Context, cloudevents.Event), logger *zap.SugaredLogger) *batcher {
+	return &batcher{
+		maxSize: maxSize,
+		window:  window,
+		send:    send,
+		logger:  logger,
+		batches: make(map[string]*batch),
+	}
+}
+
+// add adds event to the batch of its kind.
+func (b *batcher) add(ctx context.Context, event cloudevents.Event) {
+	key := events.BatchKey(event)
+
+	b.mu.Lock()
+	bt, ok := b.batches[key]
+	if !ok {
+		// The batch event is about several objects, so it is not
+		// acknowledged on the one of its first event.
+		bt = &batch{ctx: withoutTarget(ctx)}
+		b.batches[key] = bt
+		bt.timer = time.AfterFunc(b.window, func() {
+			b.flush(key, bt)
+		})
+	}
+	bt.events = append(bt.events, event)
+	if m, ok := ctx.Value(checkpointMarkKey{}).(checkpointMark); ok {
+		bt.ctx = context.WithValue(bt.ctx, checkpointMarkKey{}, m)
+	}
+	full := len(bt.events) >= b.maxSize
+	if full {
+		bt.timer.Stop()
+		delete(b.batches, key)
+	}
+	b.mu.Unlock()
+
+	if full {
+		b.sendBatch(bt)
+	}
+}
+
+// flush sends the batch of key, unless it has been sent already.
+func (b *batcher) flush(key string, bt *batch) {
+	b.mu.Lock()
+	if b.batches[key] != bt {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.batches, key)
+	b.mu.Unlock()
+
+	b.sendBatch(bt)
+}
+
+// flushAll sends all the pending batches.
+func (b *batcher) flushAll() {
+	b.mu.Lock()
+	pending := b.batches
+	b.batches = make(map[string]*batch)
+	b.mu.Unlock()
+
+	for _, bt := range pending {
+		bt.timer.Stop()
+		b.sendBatch(bt)
+	}
+}
+
+func (b *batcher) sendBatch(bt *batch) {
+	event, err := events.MakeBatchEvent(bt.events)
+	if err != nil {
+		b.logger.Infow("batch event creation failed", zap.Error(err))
+		return
+	}
+	b.send(bt.ctx, event)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+type sentBatches struct {
+	mu     sync.Mutex
+	events []cloudevents.Event
+}
+
+func (s *sentBatches) send(_ context.Context, event cloudevents.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+func (s *sentBatches) sent() []cloudevents.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]cloudevents.Event(nil), s.events...)
+}
+
+func makeTestEvent(t *testing.T, name string) cloudevents.Event {
+	_, event, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, simplePod(name, "default"), false)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	return event
+}
+
+func batchSize(t *testing.T, event cloudevents.Event) int {
+	var items []json.RawMessage
+	if err := json.Unmarshal(event.Data(), &items); err != nil {
+		t.Fatal("Failed to unmarshal the batch:", err)
+	}
+	return len(items)
+}
+
+func TestBatcherMaxSize(t *testing.T) {
+	s := &sentBatches{}
+	b := newBatcher(2, time.Hour, s.send, zap.NewNop().Sugar())
+
+	b.add(context.Background(), makeTestEvent(t, "a"))
+	if got := len(s.sent()); got != 0 {
+		t.Fatalf("Expected no batch to be sent, got %d", got)
+	}
+	b.add(context.Background(), makeTestEvent(t, "b"))
+
+	sent := s.sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 batch to be sent, got %d", len(sent))
+	}
+	if sent[0].Type() != sources.ApiServerSourceBatchEventType {
+		t.Errorf("Expected type %q, got %q", sources.ApiServerSourceBatchEventType, sent[0].Type())
+	}
+	if got := batchSize(t, sent[0]); got != 2 {
+		t.Errorf("Expected 2 events in the batch, got %d", got)
+	}
+}
+
+func TestBatcherWindow(t *testing.T) {
+	s := &sentBatches{}
+	b := newBatcher(100, 10*time.Millisecond, s.send, zap.NewNop().Sugar())
+
+	b.add(context.Background(), makeTestEvent(t, "a"))
+	b.add(context.Background(), makeTestEvent(t, "b"))
+	b.add(context.Background(), makeTestEvent(t, "c"))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(s.sent()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	sent := s.sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 batch to be sent, got %d", len(sent))
+	}
+	if got := batchSize(t, sent[0]); got != 3 {
+		t.Errorf("Expected 3 events in the batch, got %d", got)
+	}
+}
+
+func TestBatcherFlushAll(t *testing.T) {
+	s := &sentBatches{}
+	b := newBatcher(100, time.Hour, s.send, zap.NewNop().Sugar())
+
+	b.add(context.Background(), makeTestEvent(t, "a"))
+	_, ns, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, simpleNamespace("b"), false)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	b.add(context.Background(), ns)
+	b.flushAll()
+
+	sent := s.sent()
+	if len(sent) != 2 {
+		t.Fatalf("Expected a batch per kind to be sent, got %d", len(sent))
+	}
+	for _, event := range sent {
+		if got := batchSize(t, event); got != 1 {
+			t.Errorf("Expected 1 event in the %v batch, got %d", event.Extensions()["kind"], got)
+		}
+	}
+}
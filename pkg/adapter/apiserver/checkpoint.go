@@ -0,0 +1,265 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// checkpointer records the resourceVersion of the last delivered event of
+// each watched resource, and stores them in a ConfigMap so the changes a
+// restart of the adapter missed are replayed when it starts again.
+type checkpointer struct {
+	configMaps corev1client.ConfigMapInterface
+	name       string
+	interval   time.Duration
+	logger     *zap.SugaredLogger
+
+	mu sync.Mutex
+	// resources maps the kinds of the watched resources to the keys of their
+	// resourceVersion.
+	resources map[schema.GroupVersionKind]string
+	// versions holds the resourceVersion of the last delivered event of each
+	// resource, by key.
+	versions map[string]string
+	// pinned holds the keys of the resources an event failed to be delivered
+	// for. Their resourceVersion no longer moves, so the event is replayed
+	// after a restart.
+	pinned sets.String
+	dirty  bool
+}
+
+// checkpointMark is the resource and resourceVersion of the object an event
+// is about.
+type checkpointMark struct {
+	key             string
+	resourceVersion string
+}
+
+type checkpointMarkKey struct{}
+
+func newCheckpointer(configMaps corev1client.ConfigMapInterface, name string, interval time.Duration, logger *zap.SugaredLogger) *checkpointer {
+	return &checkpointer{
+		configMaps: configMaps,
+		name:       name,
+		interval:   interval,
+		logger:     logger,
+		resources:  make(map[schema.GroupVersionKind]string),
+		versions:   make(map[string]string),
+		pinned:     sets.NewString(),
+	}
+}
+
+// checkpointKey returns the key of the resourceVersion of gvr, e.g.
+// `deployments.v1.apps`.
+func checkpointKey(gvr schema.GroupVersionResource) string {
+	return strings.TrimSuffix(gvr.Resource+"."+gvr.Version+"."+gvr.Group, ".")
+}
+
+// watch registers kind as the kind of the watched resource gvr.
+func (c *checkpointer) watch(gvr schema.GroupVersionResource, kind string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resources[gvr.GroupVersion().WithKind(kind)] = checkpointKey(gvr)
+}
+
+// load reads the stored checkpoint, if any.
+func (c *checkpointer) load(ctx context.Context) error {
+	cm, err := c.configMaps.Get(ctx, c.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, version := range cm.Data {
+		c.versions[key] = version
+	}
+	return nil
+}
+
+// run stores the checkpoint every interval until stopCh is closed.
+func (c *checkpointer) run(ctx context.Context, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.store(ctx)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// store writes the checkpoint to its ConfigMap when it changed.
+func (c *checkpointer) store(ctx context.Context) {
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return
+	}
+	data := make(map[string]string, len(c.versions))
+	for key, version := range c.versions {
+		data[key] = version
+	}
+	c.dirty = false
+	c.mu.Unlock()
+
+	if err := c.write(ctx, data); err != nil {
+		c.logger.Errorw("Failed to store the checkpoint", zap.String("configMap", c.name), zap.Error(err))
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+	}
+}
+
+func (c *checkpointer) write(ctx context.Context, data map[string]string) error {
+	cm, err := c.configMaps.Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	cm.Data = data
+	_, err = c.configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// withMark returns a context carrying the checkpoint mark of obj, or ctx
+// when obj is not of a watched resource. Like delivered and failed, it does
+// nothing on a nil checkpointer.
+func (c *checkpointer) withMark(ctx context.Context, obj interface{}) context.Context {
+	if c == nil {
+		return ctx
+	}
+	if m, ok := c.mark(obj); ok {
+		return context.WithValue(ctx, checkpointMarkKey{}, m)
+	}
+	return ctx
+}
+
+func (c *checkpointer) mark(obj interface{}) (checkpointMark, bool) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u == nil {
+		return checkpointMark{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key, ok := c.resources[u.GroupVersionKind()]
+	if !ok {
+		return checkpointMark{}, false
+	}
+	return checkpointMark{key: key, resourceVersion: u.GetResourceVersion()}, true
+}
+
+// delivered records the delivery of the event of the mark ctx carries.
+func (c *checkpointer) delivered(ctx context.Context) {
+	if c == nil {
+		return
+	}
+	m, ok := ctx.Value(checkpointMarkKey{}).(checkpointMark)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pinned.Has(m.key) {
+		return
+	}
+	if newerResourceVersion(m.resourceVersion, c.versions[m.key]) {
+		c.versions[m.key] = m.resourceVersion
+		c.dirty = true
+	}
+}
+
+// failed records the failed delivery of the event of the mark ctx carries.
+func (c *checkpointer) failed(ctx context.Context) {
+	if c == nil {
+		return
+	}
+	if m, ok := ctx.Value(checkpointMarkKey{}).(checkpointMark); ok {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.pinned.Insert(m.key)
+	}
+}
+
+// missed returns the objects of list which changed after the last delivered
+// event of their resource. The objects of a resource without a checkpoint
+// start its checkpoint instead, as nothing was delivered for it yet.
+func (c *checkpointer) missed(list []interface{}) []interface{} {
+	marks := make([]checkpointMark, len(list))
+	for i, obj := range list {
+		marks[i], _ = c.mark(obj)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	baselines := make(map[string]string)
+	var missed []interface{}
+	for i, m := range marks {
+		if m.key == "" {
+			continue
+		}
+		version, ok := c.versions[m.key]
+		if !ok {
+			if newerResourceVersion(m.resourceVersion, baselines[m.key]) {
+				baselines[m.key] = m.resourceVersion
+			}
+			continue
+		}
+		if newerResourceVersion(m.resourceVersion, version) {
+			missed = append(missed, list[i])
+		}
+	}
+	for key, version := range baselines {
+		c.versions[key] = version
+		c.dirty = true
+	}
+	return missed
+}
+
+// newerResourceVersion reports whether the resourceVersion version is newer
+// than old. ResourceVersions are opaque, so a version which is not a number
+// is deemed newer, which replays its event rather than losing it.
+func newerResourceVersion(version, old string) bool {
+	if old == "" {
+		return true
+	}
+	v, err := strconv.ParseUint(version, 10, 64)
+	if err != nil {
+		return version != old
+	}
+	o, err := strconv.ParseUint(old, 10, 64)
+	if err != nil {
+		return version != old
+	}
+	return v > o
+}
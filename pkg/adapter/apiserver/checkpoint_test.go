@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+var podsGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+func TestCheckpointKey(t *testing.T) {
+	if got, want := checkpointKey(podsGVR), "pods.v1"; got != want {
+		t.Errorf("Expected key %q, got %q", want, got)
+	}
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	if got, want := checkpointKey(deployments), "deployments.v1.apps"; got != want {
+		t.Errorf("Expected key %q, got %q", want, got)
+	}
+}
+
+func TestNewerResourceVersion(t *testing.T) {
+	tests := []struct {
+		version, old string
+		want         bool
+	}{
+		{version: "10", old: "", want: true},
+		{version: "10", old: "9", want: true},
+		{version: "10", old: "10", want: false},
+		{version: "9", old: "10", want: false},
+		{version: "abc", old: "10", want: true},
+		{version: "abc", old: "abc", want: false},
+	}
+	for _, tc := range tests {
+		if got := newerResourceVersion(tc.version, tc.old); got != tc.want {
+			t.Errorf("newerResourceVersion(%q, %q) = %v, want %v", tc.version, tc.old, got, tc.want)
+		}
+	}
+}
+
+func TestCheckpointerMissed(t *testing.T) {
+	c := makeTestCheckpointer()
+	list := []interface{}{versionedPod("a", "5"), versionedPod("b", "7"), simpleNamespace("ns")}
+
+	// Nothing is replayed without a checkpoint, which starts at the newest
+	// listed object.
+	if got := c.missed(list); len(got) != 0 {
+		t.Errorf("Expected no missed objects, got %d", len(got))
+	}
+	if got := c.versions["pods.v1"]; got != "7" {
+		t.Errorf("Expected checkpoint %q, got %q", "7", got)
+	}
+
+	c.versions["pods.v1"] = "6"
+	got := c.missed(list)
+	if len(got) != 1 || got[0].(*unstructured.Unstructured).GetName() != "b" {
+		t.Errorf("Expected pod b to be missed, got %v", got)
+	}
+}
+
+func TestCheckpointerDelivered(t *testing.T) {
+	c := makeTestCheckpointer()
+
+	c.delivered(c.withMark(context.Background(), versionedPod("a", "5")))
+	c.delivered(c.withMark(context.Background(), versionedPod("a", "4")))
+	if got := c.versions["pods.v1"]; got != "5" {
+		t.Errorf("Expected checkpoint %q, got %q", "5", got)
+	}
+
+	// A failed delivery pins the checkpoint, so its event is replayed.
+	c.failed(c.withMark(context.Background(), versionedPod("a", "6")))
+	c.delivered(c.withMark(context.Background(), versionedPod("a", "7")))
+	if got := c.versions["pods.v1"]; got != "5" {
+		t.Errorf("Expected checkpoint %q, got %q", "5", got)
+	}
+
+	// Objects of resources which are not watched are not recorded.
+	c.delivered(c.withMark(context.Background(), simpleNamespace("ns")))
+	if len(c.versions) != 1 {
+		t.Errorf("Expected 1 checkpoint, got %v", c.versions)
+	}
+}
+
+func TestCheckpointerStoreLoad(t *testing.T) {
+	ctx := context.Background()
+	kube := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "checkpoint"},
+	})
+
+	c := newCheckpointer(kube.CoreV1().ConfigMaps("test"), "checkpoint", time.Second, zap.NewExample().Sugar())
+	c.watch(podsGVR, "Pod")
+	c.delivered(c.withMark(ctx, versionedPod("a", "5")))
+	c.store(ctx)
+
+	cm, err := kube.CoreV1().ConfigMaps("test").Get(ctx, "checkpoint", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if diff := cmp.Diff(map[string]string{"pods.v1": "5"}, cm.Data); diff != "" {
+		t.Error("Unexpected checkpoint (-want, +got) =", diff)
+	}
+
+	restarted := newCheckpointer(kube.CoreV1().ConfigMaps("test"), "checkpoint", time.Second, zap.NewExample().Sugar())
+	if err := restarted.load(ctx); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if got := restarted.versions["pods.v1"]; got != "5" {
+		t.Errorf("Expected loaded checkpoint %q, got %q", "5", got)
+	}
+}
+
+func TestResourceReplayMissed(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.checkpoint = makeTestCheckpointer()
+	d.checkpoint.versions["pods.v1"] = "6"
+
+	if err := d.Replace([]interface{}{versionedPod("a", "5"), versionedPod("b", "7")}, "7"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+	validateSent(t, ce, sources.ApiServerSourceAddEventType)
+	if got := ce.Sent()[0].Subject(); got != "/apis/v1/namespaces/test/pods/b" {
+		t.Errorf("Expected the event of pod b to be replayed, got %q", got)
+	}
+	if got := d.checkpoint.versions["pods.v1"]; got != "7" {
+		t.Errorf("Expected checkpoint %q, got %q", "7", got)
+	}
+}
+
+func makeTestCheckpointer() *checkpointer {
+	c := newCheckpointer(nil, "checkpoint", time.Second, zap.NewExample().Sugar())
+	c.watch(podsGVR, "Pod")
+	return c
+}
+
+func versionedPod(name, resourceVersion string) *unstructured.Unstructured {
+	pod := simplePod(name, "test")
+	pod.SetResourceVersion(resourceVersion)
+	return pod
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// clusterClients returns the clients of the cluster of a kubeconfig.
+type clusterClients func(kubeconfig []byte) (discovery.DiscoveryInterface, dynamic.Interface, error)
+
+// newClusterClients returns the clients of the cluster of kubeconfig.
+func newClusterClients(kubeconfig []byte) (discovery.DiscoveryInterface, dynamic.Interface, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the kubeconfig: %w", err)
+	}
+	discover, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the discovery client: %w", err)
+	}
+	k8s, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the dynamic client: %w", err)
+	}
+	return discover, k8s, nil
+}
+
+// startRemote watches the resources of the remote cluster of the kubeconfig
+// file until stopCh is closed. The watches are started again with new
+// clients when the file changes, such as when the kubelet updates the Secret
+// it is mounted from, so the rotated credentials are used. The changes
+// between the stop of the watches and their start are only replayed when
+// the source is checkpointed. The current watches are kept while the file is
+// not a valid kubeconfig.
+func (a *apiServerAdapter) startRemote(ctx context.Context, stopCh <-chan struct{}) error {
+	newClients := a.clusterClients
+	if newClients == nil {
+		newClients = newClusterClients
+	}
+	file := a.config.Cluster.KubeconfigFile
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch the kubeconfig: %w", err)
+	}
+	defer watcher.Close()
+	// The directory is watched rather than the file, as the kubelet replaces
+	// the mounted files by swapping a symbolic link.
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", filepath.Dir(file), err)
+	}
+
+	kubeconfig, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read the kubeconfig: %w", err)
+	}
+	discover, k8s, err := newClients(kubeconfig)
+	if err != nil {
+		return err
+	}
+	logger := a.logger.With(zap.String("cluster", a.config.Cluster.Name))
+	for {
+		a.discover, a.k8s = discover, k8s
+		stop := make(chan struct{})
+		done := make(chan error, 1)
+		go func() {
+			done <- a.start(ctx, stop)
+		}()
+
+	reload:
+		for {
+			select {
+			case <-stopCh:
+				close(stop)
+				return <-done
+			case err := <-done:
+				return err
+			case _, ok := <-watcher.Events:
+				if !ok {
+					close(stop)
+					return <-done
+				}
+				b, err := os.ReadFile(file)
+				if err != nil || bytes.Equal(b, kubeconfig) {
+					continue
+				}
+				d, k, err := newClients(b)
+				if err != nil {
+					// The files being partially written are not valid, and
+					// are loaded on their next change.
+					logger.Warnw("Keeping the clients of the previous kubeconfig", zap.Error(err))
+					continue
+				}
+				kubeconfig, discover, k8s = b, d, k
+				break reload
+			case err, ok := <-watcher.Errors:
+				if ok {
+					logger.Warnw("Failed to watch the kubeconfig", zap.Error(err))
+				}
+			}
+		}
+
+		logger.Info("Restarting the watches with the reloaded kubeconfig")
+		close(stop)
+		if err := <-done; err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/pkg/logging"
+	pkgtesting "knative.dev/pkg/reconciler/testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.com:6443
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+users:
+- name: remote
+  user:
+    token: secret
+`
+
+func TestNewClusterClients(t *testing.T) {
+	if _, _, err := newClusterClients([]byte(testKubeconfig)); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+	if _, _, err := newClusterClients([]byte("not a kubeconfig")); err == nil {
+		t.Error("Expected an error for an invalid kubeconfig")
+	}
+}
+
+// writeKubeconfig replaces the kubeconfig file atomically, as the kubelet
+// does.
+func writeKubeconfig(t *testing.T, file, content string) {
+	t.Helper()
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitSent waits for the events sent to ce to be n.
+func waitSent(ce *adaptertest.TestCloudEventsClient, n int) []cloudevents.Event {
+	for i := 0; i < 50 && len(ce.Sent()) < n; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	return ce.Sent()
+}
+
+func TestAdapterStartRemote(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+	file := filepath.Join(t.TempDir(), "kubeconfig")
+	writeKubeconfig(t, file, "first")
+
+	clusters := map[string]dynamic.Interface{
+		"first":  makeDynamicClient(),
+		"second": makeDynamicClient(),
+	}
+	var mu sync.Mutex
+	var loaded []string
+	ctx, _ := pkgtesting.SetupFakeContext(t)
+	a := &apiServerAdapter{
+		ce:     ce,
+		logger: logging.FromContext(ctx),
+		config: Config{
+			Resources: []ResourceWatch{{
+				GVR: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+			}},
+			EventMode: "Resource",
+			Cluster:   &ClusterConfig{Name: "remote", KubeconfigFile: file},
+		},
+		source: "unit-test",
+		name:   "unittest",
+		clusterClients: func(kubeconfig []byte) (discovery.DiscoveryInterface, dynamic.Interface, error) {
+			k8s, ok := clusters[string(kubeconfig)]
+			if !ok {
+				return nil, nil, errors.New("invalid kubeconfig")
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			loaded = append(loaded, string(kubeconfig))
+			return makeDiscoveryClient(), k8s, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- a.Start(ctx)
+	}()
+
+	// Wait for the reflectors to be fully initialized.
+	time.Sleep(time.Second)
+	if _, err := clusters["first"].Resource(namespacesGVR).Create(ctx, simpleNamespace("foo"), metav1.CreateOptions{}); err != nil {
+		t.Fatal("Failed to create the namespace:", err)
+	}
+	sent := waitSent(ce, 1)
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+	if got := sent[0].Extensions()["cluster"]; got != "remote" {
+		t.Errorf("Expected the cluster extension %q, got %v", "remote", got)
+	}
+
+	// The watches are kept while the kubeconfig is not valid.
+	writeKubeconfig(t, file, "partial")
+	// The rotated credentials are used once reloaded.
+	writeKubeconfig(t, file, "second")
+	for i := 0; i < 50; i++ {
+		mu.Lock()
+		n := len(loaded)
+		mu.Unlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	time.Sleep(time.Second)
+	if _, err := clusters["second"].Resource(namespacesGVR).Create(ctx, simpleNamespace("bar"), metav1.CreateOptions{}); err != nil {
+		t.Fatal("Failed to create the namespace:", err)
+	}
+	sent = waitSent(ce, 2)
+	if len(sent) != 2 {
+		t.Fatalf("Expected 2 events to be sent, got %d", len(sent))
+	}
+	if got, want := sent[1].Subject(), "/api/v1/namespaces/bar"; got != want {
+		t.Errorf("Expected subject %q, got %q", want, got)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Error("Did not expect an error, but got:", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(loaded) != 2 || loaded[0] != "first" || loaded[1] != "second" {
+		t.Errorf("Expected the kubeconfigs first and second to be loaded, got %v", loaded)
+	}
+}
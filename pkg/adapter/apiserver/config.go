@@ -17,6 +17,8 @@ package apiserver
 
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 )
 
@@ -28,6 +30,30 @@ type ResourceWatch struct {
 	// label selector.
 	// +optional
 	LabelSelector string `json:"selector,omitempty"`
+
+	// FieldSelector filters this source to objects to those resources pass the
+	// field selector.
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// ClassifyUpdates classifies the update events of the resource by the
+	// sections of the objects which changed.
+	// +optional
+	ClassifyUpdates bool `json:"classifyUpdates,omitempty"`
+
+	// Subresource is the subresource of the resource watched along with it.
+	// +optional
+	Subresource string `json:"subresource,omitempty"`
+
+	// EventTypeTemplate is the template of the types of the events of the
+	// resource, in place of their default types.
+	// +optional
+	EventTypeTemplate string `json:"eventTypeTemplate,omitempty"`
+
+	// Verbs are the verbs of the events of the resource which are sent. All
+	// the events are sent when it is empty.
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
 }
 
 type Config struct {
@@ -35,6 +61,16 @@ type Config struct {
 	// +required
 	Namespace string `json:"namespace"`
 
+	// Namespaces are the namespaces the namespaced resources are watched in,
+	// along with the ones NamespaceSelector selects, rather than Namespace.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects the namespaces the namespaced resources are
+	// watched in by their labels, rather than Namespace.
+	// +optional
+	NamespaceSelector string `json:"namespaceSelector,omitempty"`
+
 	// Resource is the resource this source will track and send related
 	// lifecycle events from the Kubernetes ApiServer.
 	// +required
@@ -46,10 +82,197 @@ type Config struct {
 	// +optional
 	ResourceOwner *v1.APIVersionKind `json:"owner,omitempty"`
 
+	// OwnerRefMode controls the attribution of the events to the owners of
+	// their resources. `Controller` attributes them to the top controller of
+	// their resources.
+	// +optional
+	OwnerRefMode string `json:"ownerRefMode,omitempty"`
+
+	// OwnerRefMaxDepth is the maximum number of owner references walked in
+	// the `Controller` OwnerRefMode.
+	// +optional
+	OwnerRefMaxDepth int32 `json:"ownerRefMaxDepth,omitempty"`
+
+	// OwnerRefDeduplicationWindow is the window the events of a same type
+	// attributed to a same controller are deduplicated within.
+	// +optional
+	OwnerRefDeduplicationWindow string `json:"ownerRefDeduplicationWindow,omitempty"`
+
+	// ExtensionExpressions are the CloudEvents extensions set on the events,
+	// by name, to the value the CEL expression evaluates to against their
+	// object.
+	// +optional
+	ExtensionExpressions map[string]string `json:"extensionExpressions,omitempty"`
+
+	// ResyncPeriod is the period the sync events of the last seen state of
+	// the watched resources are sent at. No sync event is sent when it is
+	// not set.
+	// +optional
+	ResyncPeriod string `json:"resyncPeriod,omitempty"`
+
 	// EventMode controls the format of the event.
 	// `Reference` sends a dataref event type for the resource under watch.
 	// `Resource` send the full resource lifecycle event.
+	// `Diff` sends the full resource lifecycle event for adds and deletions,
+	// and a JSON patch of the changes to the resource for updates.
 	// Defaults to `Reference`
 	// +optional
 	EventMode string `json:"mode,omitempty"`
+
+	// Format controls the shape of the data of the add, update and delete
+	// events. `KubernetesEvent` sends a Kubernetes Event of the change.
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// FieldsToDrop are the paths of the fields removed from the resources
+	// before they are sent.
+	// +optional
+	FieldsToDrop []string `json:"fieldsToDrop,omitempty"`
+
+	// DataContentType is the content type the data of the events is encoded
+	// in. Defaults to `application/json`
+	// +optional
+	DataContentType string `json:"dataContentType,omitempty"`
+
+	// DataSchema is the URI of the schema the data of the events adheres to.
+	// +optional
+	DataSchema string `json:"dataSchema,omitempty"`
+
+	// Batch configures the batching of the events. The events are sent one
+	// by one when it is not set.
+	// +optional
+	Batch *v1.ApiServerSourceBatch `json:"batch,omitempty"`
+
+	// Delivery configures the retries and the timeout of the delivery of the
+	// events.
+	// +optional
+	Delivery *duckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// DeadLetterSink is the resolved URI of the sink the events are sent to
+	// when their delivery fails.
+	// +optional
+	DeadLetterSink string `json:"deadLetterSink,omitempty"`
+
+	// DeadLetterSinkAudience is the OIDC audience of the dead letter sink.
+	// The events sent to it are authenticated with a token for that
+	// audience when it is set.
+	// +optional
+	DeadLetterSinkAudience string `json:"deadLetterSinkAudience,omitempty"`
+
+	// Concurrency is the number of workers the events are dispatched by,
+	// sharded by object. The events are dispatched one after the other when
+	// it is 0 or 1.
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+
+	// RateLimit configures the token bucket the events are sent through.
+	// The events are sent as fast as they come when it is not set.
+	// +optional
+	RateLimit *RateLimitConfig `json:"rateLimit,omitempty"`
+
+	// Checkpoint configures the ConfigMap the resourceVersion of the last
+	// delivered event of each resource is stored in. No checkpoint is kept
+	// when it is not set.
+	// +optional
+	Checkpoint *v1.ApiServerSourceCheckpoint `json:"checkpoint,omitempty"`
+
+	// Pause configures the ConfigMap the emission of the events is paused
+	// and resumed through. The events are always emitted when it is not set.
+	// +optional
+	Pause *PauseConfig `json:"pause,omitempty"`
+
+	// Acknowledgement configures the recording of the delivery of the events
+	// on the objects they are about. The deliveries are not recorded when it
+	// is not set.
+	// +optional
+	Acknowledgement *v1.ApiServerSourceAcknowledgement `json:"acknowledgement,omitempty"`
+
+	// Filters are evaluated on the events before they are sent. Only the
+	// events which pass all the filters are sent.
+	// +optional
+	Filters []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
+
+	// Sinks are the additional sinks the events are sent to.
+	// +optional
+	Sinks []SinkConfig `json:"sinks,omitempty"`
+
+	// Kafka is the Kafka topic the events are written to rather than sent
+	// to the sink over HTTP, when the sink is a KafkaSink.
+	// +optional
+	Kafka *KafkaSinkConfig `json:"kafka,omitempty"`
+
+	// Cluster is the remote cluster the resources are watched in. They are
+	// watched in the cluster of the adapter when it is not set.
+	// +optional
+	Cluster *ClusterConfig `json:"cluster,omitempty"`
+}
+
+// ClusterConfig is the remote cluster the resources are watched in.
+type ClusterConfig struct {
+	// Name identifies the cluster in the `cluster` extension of the events.
+	// +required
+	Name string `json:"name"`
+
+	// KubeconfigFile is the path of the kubeconfig of the cluster. It is
+	// loaded again when it changes.
+	// +required
+	KubeconfigFile string `json:"kubeconfigFile"`
+}
+
+// KafkaSinkConfig is the Kafka topic of a KafkaSink.
+type KafkaSinkConfig struct {
+	// BootstrapServers are the addresses of the brokers of the Kafka cluster.
+	// +required
+	BootstrapServers []string `json:"bootstrapServers"`
+
+	// Topic is the topic the events are written to.
+	// +required
+	Topic string `json:"topic"`
+
+	// ContentMode is the mode the events are written in, either `binary` or
+	// `structured`. Defaults to `binary`.
+	// +optional
+	ContentMode string `json:"contentMode,omitempty"`
+
+	// AuthPath is the directory the keys of the Secret holding the TLS and
+	// SASL configuration of the connection to the brokers are mounted in.
+	// The connection uses plaintext when it is not set.
+	// +optional
+	AuthPath string `json:"authPath,omitempty"`
+}
+
+// RateLimitConfig is the rate limit of the events.
+type RateLimitConfig struct {
+	v1.ApiServerSourceRateLimit `json:",inline"`
+
+	// ConfigMapName is the name of the ConfigMap the throttling of the
+	// events is reported in.
+	// +required
+	ConfigMapName string `json:"configMapName"`
+}
+
+// PauseConfig is the ConfigMap the emission of the events is paused and
+// resumed through.
+type PauseConfig struct {
+	// ConfigMapName is the name of the ConfigMap whose PausedKey pauses the
+	// emission of the events.
+	// +required
+	ConfigMapName string `json:"configMapName"`
+}
+
+// SinkConfig is an additional sink the events are sent to.
+type SinkConfig struct {
+	// URI is the resolved URI of the sink.
+	// +required
+	URI string `json:"uri"`
+
+	// Audience is the OIDC audience of the sink. The events sent to it are
+	// authenticated with a token for that audience when it is set.
+	// +optional
+	Audience string `json:"audience,omitempty"`
+
+	// Filters are evaluated on the events before they are sent to the sink.
+	// Only the events which pass all the filters are sent to it.
+	// +optional
+	Filters []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
 }
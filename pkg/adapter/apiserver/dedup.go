@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// defaultVersionCacheSize is the number of objects whose last emitted
+	// resourceVersion is kept to suppress the duplicate notifications.
+	defaultVersionCacheSize = 10000
+
+	// defaultVersionCacheTTL is how long the last emitted resourceVersion of
+	// an object is kept.
+	defaultVersionCacheTTL = time.Hour
+)
+
+// versionDeduplicator suppresses the notifications of the objects in a
+// resourceVersion their events were already emitted for, such as the ones
+// the informers send again after they relist. It keeps the last emitted
+// resourceVersion of the size most recently notified objects, for ttl.
+type versionDeduplicator struct {
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[types.UID]*list.Element
+}
+
+type versionEntry struct {
+	uid             types.UID
+	resourceVersion string
+	emitted         time.Time
+}
+
+func newVersionDeduplicator(size int, ttl time.Duration) *versionDeduplicator {
+	return &versionDeduplicator{
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: make(map[types.UID]*list.Element),
+	}
+}
+
+// duplicate returns whether the event of obj in its resourceVersion was
+// already emitted, and records it as emitted otherwise. Nothing is a
+// duplicate when d is nil, nor when obj has no UID or resourceVersion.
+func (d *versionDeduplicator) duplicate(obj interface{}) bool {
+	u, ok := obj.(*unstructured.Unstructured)
+	if d == nil || !ok || u == nil || u.GetUID() == "" || u.GetResourceVersion() == "" {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	if elem, ok := d.entries[u.GetUID()]; ok {
+		entry := elem.Value.(*versionEntry)
+		if entry.resourceVersion == u.GetResourceVersion() && now.Sub(entry.emitted) < d.ttl {
+			d.lru.MoveToFront(elem)
+			return true
+		}
+		entry.resourceVersion = u.GetResourceVersion()
+		entry.emitted = now
+		d.lru.MoveToFront(elem)
+		return false
+	}
+	d.entries[u.GetUID()] = d.lru.PushFront(&versionEntry{
+		uid:             u.GetUID(),
+		resourceVersion: u.GetResourceVersion(),
+		emitted:         now,
+	})
+	for d.lru.Len() > d.size {
+		d.remove(d.lru.Back())
+	}
+	return false
+}
+
+// forget drops the last emitted resourceVersion of obj, once it is deleted.
+func (d *versionDeduplicator) forget(obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if d == nil || !ok || u == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if elem, ok := d.entries[u.GetUID()]; ok {
+		d.remove(elem)
+	}
+}
+
+func (d *versionDeduplicator) remove(elem *list.Element) {
+	d.lru.Remove(elem)
+	delete(d.entries, elem.Value.(*versionEntry).uid)
+}
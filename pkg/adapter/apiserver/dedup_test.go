@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func identifiedPod(name, uid, resourceVersion string) *unstructured.Unstructured {
+	pod := simplePod(name, "test")
+	pod.SetUID(types.UID(uid))
+	pod.SetResourceVersion(resourceVersion)
+	return pod
+}
+
+func TestVersionDeduplicator(t *testing.T) {
+	now := time.Now()
+	d := newVersionDeduplicator(2, time.Minute)
+	d.now = func() time.Time { return now }
+
+	steps := []struct {
+		obj     interface{}
+		advance time.Duration
+		want    bool
+	}{
+		{obj: identifiedPod("a", "a", "1")},
+		{obj: identifiedPod("a", "a", "1"), want: true},
+		{obj: identifiedPod("a", "a", "2")},
+		{obj: identifiedPod("b", "b", "1")},
+		{obj: identifiedPod("a", "a", "2"), want: true},
+		// c evicts b, the least recently notified.
+		{obj: identifiedPod("c", "c", "1")},
+		{obj: identifiedPod("b", "b", "1")},
+		{obj: identifiedPod("b", "b", "1"), want: true},
+		// The emitted versions expire after the TTL.
+		{obj: identifiedPod("b", "b", "1"), advance: time.Minute},
+		// The objects without UID or resourceVersion are not deduplicated.
+		{obj: simplePod("d", "test")},
+		{obj: simplePod("d", "test")},
+		{obj: nil},
+	}
+	for i, step := range steps {
+		now = now.Add(step.advance)
+		if got := d.duplicate(step.obj); got != step.want {
+			t.Errorf("step %d: duplicate() = %t, want %t", i, got, step.want)
+		}
+	}
+
+	d.forget(identifiedPod("b", "b", "1"))
+	if d.duplicate(identifiedPod("b", "b", "1")) {
+		t.Error("duplicate() = true after forget(), want false")
+	}
+
+	var nilDeduplicator *versionDeduplicator
+	if nilDeduplicator.duplicate(identifiedPod("a", "a", "1")) {
+		t.Error("nil deduplicator duplicate() = true, want false")
+	}
+	nilDeduplicator.forget(identifiedPod("a", "a", "1"))
+}
+
+func TestResourceDuplicateSuppressed(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+	d.versions = newVersionDeduplicator(defaultVersionCacheSize, defaultVersionCacheTTL)
+
+	d.Add(identifiedPod("unit", "unit", "1"))
+	d.Add(identifiedPod("unit", "unit", "1"))
+	d.Update(identifiedPod("unit", "unit", "1"))
+	d.Update(identifiedPod("unit", "unit", "2"))
+	d.Delete(identifiedPod("unit", "unit", "3"))
+	d.Add(identifiedPod("unit", "unit", "3"))
+
+	want := []string{
+		sources.ApiServerSourceAddEventType,
+		sources.ApiServerSourceUpdateEventType,
+		sources.ApiServerSourceDeleteEventType,
+		sources.ApiServerSourceAddEventType,
+	}
+	sent := ce.Sent()
+	if len(sent) != len(want) {
+		t.Fatalf("Expected %d events to be sent, got %d", len(want), len(sent))
+	}
+	for i, event := range sent {
+		if event.Type() != want[i] {
+			t.Errorf("Expected event %d to be %q, got %q", i, want[i], event.Type())
+		}
+	}
+}
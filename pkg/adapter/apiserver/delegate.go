@@ -18,56 +18,424 @@ package apiserver
 
 import (
 	"context"
+	"net/http"
+	"sync"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cecontext "github.com/cloudevents/sdk-go/v2/context"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/channel/attributes"
+	"knative.dev/eventing/pkg/eventfilter"
+	"knative.dev/eventing/pkg/metrics/source"
+	"knative.dev/eventing/pkg/observability"
 )
 
+// noResponse is the error code set on the events sent to the dead letter sink
+// when the sink did not respond.
+const noResponse = -1
+
 type resourceDelegate struct {
 	ce                  cloudevents.Client
 	source              string
 	ref                 bool
 	apiServerSourceName string
+	opts                []events.EventOption
+
+	// objects holds the last seen state of the watched resources, so update
+	// events can be built with the previous state of their object. No state
+	// is kept when it is nil.
+	objects cache.Store
+
+	// resync sends the sync events of the last seen state of the watched
+	// resources on each resync of their reflector. Nothing is sent on resyncs
+	// when it is false.
+	resync bool
+
+	// timeout bounds the delivery of each event, its retries included. The
+	// delivery is not bounded when it is 0.
+	timeout time.Duration
+
+	// deadLetter sends the events whose delivery to sink failed to the dead
+	// letter sink. The events are dropped when it is nil.
+	deadLetter cloudevents.Client
+	sink       string
+
+	// sinks are the additional sinks the events are sent to, along with
+	// sink.
+	sinks []*sinkTarget
+
+	// checkpoint records the delivered events, and replays the ones missed
+	// while the adapter was not running. No checkpoint is kept when it is
+	// nil.
+	checkpoint *checkpointer
+
+	// owners attributes the events to the top controller of their object.
+	// The events are not attributed to owners when it is nil.
+	owners *ownerResolver
+
+	// dedup drops the events of a same type attributed to a same owner
+	// within its window. No event is dropped when it is nil.
+	dedup *ownerDeduplicator
+
+	// versions suppresses the notifications of the objects in a
+	// resourceVersion their events were already emitted for, which are
+	// reported to duplicates. No notification is suppressed when it is nil.
+	versions   *versionDeduplicator
+	duplicates source.StatsReporter
+
+	// filter drops the events which do not pass it before they are sent.
+	// All the events are sent when it is nil.
+	filter eventfilter.Filter
+
+	// limiter throttles the events before they are sent, and the ones
+	// above the rate limit it does not hold are dropped and reported to
+	// rateLimited, or sent to the dead letter sink. The events are sent as
+	// fast as they come when it is nil.
+	limiter     *rateLimiter
+	rateLimited source.StatsReporter
+
+	// batcher coalesces the events into batches before they are sent. The
+	// events are sent one by one when it is nil.
+	batcher *batcher
+
+	// resource is the label of the resources the events are about, in the
+	// metrics of the events reported to metrics. No such metrics are
+	// reported when metrics is nil.
+	resource string
+	metrics  source.ApiServerStatsReporter
+
+	// deliveries keeps track of the deliveries of the events in flight, which
+	// are drained on shutdown. They are not kept track of when it is nil.
+	deliveries *deliveryQueue
+
+	// scale sends the scale events of the changes of the replicas of the
+	// scale subresource of the objects. No scale event is sent when it is
+	// nil.
+	scale *scaleWatcher
+
+	// pause holds the events while their emission is paused, and records the
+	// objects missed meanwhile to replay them on resume. The events are never
+	// held when it is nil.
+	pause *pauser
+
+	// verbs are the verbs of the events which are sent, the state of the
+	// objects being kept for the others. All the events are sent when it is
+	// nil.
+	verbs sets.String
+
+	// acks records the delivery of the events on their objects, which are
+	// patched through res. The deliveries are not recorded when it is nil.
+	acks *acknowledger
+	res  dynamic.ResourceInterface
+
+	// workers dispatch the events of different objects in parallel. The
+	// events are dispatched one after the other when it is nil.
+	workers *workerPool
 
 	logger *zap.SugaredLogger
 }
 
 var _ cache.Store = (*resourceDelegate)(nil)
 
+// sinkTarget is an additional sink the events are sent to.
+type sinkTarget struct {
+	ce  cloudevents.Client
+	uri string
+
+	// filter drops the events which do not pass it before they are sent to
+	// the sink. All the events are sent to the sink when it is nil.
+	filter eventfilter.Filter
+}
+
 func (a *resourceDelegate) Add(obj interface{}) error {
-	ctx, event, err := events.MakeAddEvent(a.source, a.apiServerSourceName, obj, a.ref)
+	if !a.sends(v1.AddVerb) {
+		a.remember(obj)
+		a.scaled(obj)
+		return nil
+	}
+	ctx, event, err := events.MakeAddEvent(a.source, a.apiServerSourceName, obj, a.ref, a.withOwner(a.opts, obj)...)
 	if err != nil {
 		a.logger.Infow("event creation failed", zap.Error(err))
 		return err
 	}
-	a.sendCloudEvent(ctx, event)
+	if a.suppressed(ctx, obj, event) {
+		return nil
+	}
+	a.remember(obj)
+	a.pause.record(a, obj, false)
+	a.dispatch(a.withMarks(ctx, obj), event)
+	a.scaled(obj)
 	return nil
 }
 
 func (a *resourceDelegate) Update(obj interface{}) error {
-	ctx, event, err := events.MakeUpdateEvent(a.source, a.apiServerSourceName, obj, a.ref)
+	old := a.previous(obj)
+	if !a.sends(v1.UpdateVerb) || a.acks.echo(old, obj) {
+		a.remember(obj)
+		a.scaled(obj)
+		return nil
+	}
+	opts := a.withOwner(a.opts, obj)
+	if old != nil {
+		opts = append(opts[:len(opts):len(opts)], events.WithOldObject(old))
+	}
+	ctx, event, err := events.MakeUpdateEvent(a.source, a.apiServerSourceName, obj, a.ref, opts...)
 	if err != nil {
 		a.logger.Info("event creation failed", zap.Error(err))
 		return err
 	}
-	a.sendCloudEvent(ctx, event)
+	if a.suppressed(ctx, obj, event) {
+		return nil
+	}
+	a.remember(obj)
+	a.pause.record(a, obj, false)
+	a.dispatch(a.withMarks(ctx, obj), event)
+	a.scaled(obj)
 	return nil
 }
 
 func (a *resourceDelegate) Delete(obj interface{}) error {
-	ctx, event, err := events.MakeDeleteEvent(a.source, a.apiServerSourceName, obj, a.ref)
+	// The deletions missed are sent with the last seen state of their
+	// object, which is more complete than the one of the tombstone if any.
+	last := obj
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		if prev := a.previous(tombstone); prev != nil {
+			tombstone.Obj = prev
+		}
+		obj, last = tombstone, tombstone.Obj
+	}
+	if !a.sends(v1.DeleteVerb) {
+		a.deleted(last)
+		return nil
+	}
+	ctx, event, err := events.MakeDeleteEvent(a.source, a.apiServerSourceName, obj, a.ref, a.withOwner(a.opts, last)...)
 	if err != nil {
 		a.logger.Info("event creation failed", zap.Error(err))
 		return err
 	}
-	a.sendCloudEvent(ctx, event)
+	a.deleted(last)
+	a.pause.record(a, last, true)
+	a.dispatch(a.checkpoint.withMark(ctx, last), event)
 	return nil
 }
 
+// withMarks returns a context carrying the checkpoint mark of obj, and obj
+// as the target of the acknowledgement of its event.
+func (a *resourceDelegate) withMarks(ctx context.Context, obj interface{}) context.Context {
+	return a.acks.withTarget(a.checkpoint.withMark(ctx, obj), a.res, a.resource, obj)
+}
+
+// deleted drops the state kept about obj.
+func (a *resourceDelegate) deleted(obj interface{}) {
+	a.forget(obj)
+	a.versions.forget(obj)
+	a.scale.forget(obj)
+}
+
+// sends returns whether the events of verb are sent, which they all are
+// when no verbs are selected.
+func (a *resourceDelegate) sends(verb string) bool {
+	return a.verbs == nil || a.verbs.Has(verb)
+}
+
+// scaled sends the scale event of obj when the replicas of its scale
+// subresource changed.
+func (a *resourceDelegate) scaled(obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u == nil {
+		return
+	}
+	scale, changed := a.scale.changed(u)
+	if !changed {
+		return
+	}
+	ctx, event, err := events.MakeScaleEvent(a.source, a.apiServerSourceName, u, scale, a.withOwner(a.opts, obj)...)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.dispatch(ctx, event)
+}
+
+// suppressed returns whether the event of obj is a duplicate of an emitted
+// one, which is reported then.
+func (a *resourceDelegate) suppressed(ctx context.Context, obj interface{}, event cloudevents.Event) bool {
+	if !a.versions.duplicate(obj) {
+		return false
+	}
+	a.logger.Debugw("Suppressing duplicate event", zap.String("type", event.Type()), zap.String("subject", event.Subject()))
+	a.report(ctx, a.duplicates, event)
+	a.dropped(a.generated(ctx, event), event, source.DropReasonDuplicate)
+	return true
+}
+
+// report counts event, which is not sent, with reporter when there is one.
+func (a *resourceDelegate) report(ctx context.Context, reporter source.StatsReporter, event cloudevents.Event) {
+	if reporter == nil {
+		return
+	}
+	tags := kncloudevents.MetricTagFromContext(ctx)
+	args := &source.ReportArgs{
+		Namespace:     tags.Namespace,
+		EventSource:   event.Source(),
+		EventType:     event.Type(),
+		Name:          tags.Name,
+		ResourceGroup: tags.ResourceGroup,
+	}
+	if err := reporter.ReportEventCount(args, 0); err != nil {
+		a.logger.Warnw("Failed to report the event", zap.String("type", event.Type()), zap.Error(err))
+	}
+}
+
+// withOwner returns opts with the attribution of the event of obj to its top
+// controller, when the events are attributed to owners and obj has one.
+func (a *resourceDelegate) withOwner(opts []events.EventOption, obj interface{}) []events.EventOption {
+	if a.owners == nil {
+		return opts
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u == nil {
+		return opts
+	}
+	if owner := a.owners.top(u); owner != nil {
+		return append(opts[:len(opts):len(opts)], events.WithOwner(owner))
+	}
+	return opts
+}
+
+// remember records obj as the last seen state of its resource.
+func (a *resourceDelegate) remember(obj interface{}) {
+	if a.objects == nil {
+		return
+	}
+	if err := a.objects.Update(obj); err != nil {
+		a.logger.Debugw("failed to record object state", zap.Error(err))
+	}
+}
+
+// forget drops the last seen state of the resource of obj.
+func (a *resourceDelegate) forget(obj interface{}) {
+	if a.objects == nil {
+		return
+	}
+	if err := a.objects.Delete(obj); err != nil {
+		a.logger.Debugw("failed to drop object state", zap.Error(err))
+	}
+}
+
+// previous returns the last seen state of the resource of obj, or nil if it
+// is unknown.
+func (a *resourceDelegate) previous(obj interface{}) *unstructured.Unstructured {
+	if a.objects == nil || obj == nil {
+		return nil
+	}
+	old, exists, err := a.objects.Get(obj)
+	if err != nil || !exists {
+		return nil
+	}
+	u, _ := old.(*unstructured.Unstructured)
+	return u
+}
+
+// dispatch delivers event by the worker of its object, which is the worker
+// of its subject, when the events are dispatched by workers.
+func (a *resourceDelegate) dispatch(ctx context.Context, event cloudevents.Event) {
+	ctx = a.generated(ctx, event)
+	done := a.deliveries.enqueue(func() { a.dropped(ctx, event, source.DropReasonShutdown) })
+	a.workers.submit(event.Subject(), func() {
+		defer done()
+		a.deliver(ctx, event)
+	})
+}
+
+// deliver sends event, or adds it to its batch when the events are batched.
+// The events which do not pass the filter, and the duplicates of the events
+// of their owner, are dropped. The events are sent within the rate limit.
+// The events held while paused are dropped too, without moving the
+// checkpoint past them.
+func (a *resourceDelegate) deliver(ctx context.Context, event cloudevents.Event) {
+	if a.pause.holds() {
+		a.dropped(ctx, event, source.DropReasonPaused)
+		a.logger.Debugw("cloudevent held while paused", zap.String("type", event.Type()),
+			zap.String("source", event.Source()), zap.String("subject", event.Subject()))
+		return
+	}
+	if a.filter != nil && a.filter.Filter(ctx, event) == eventfilter.FailFilter {
+		a.dropped(ctx, event, source.DropReasonFiltered)
+		a.drop(ctx, event, "cloudevent filtered")
+		return
+	}
+	if a.dedup != nil && a.dedup.duplicate(event) {
+		a.dropped(ctx, event, source.DropReasonOwnerDuplicate)
+		a.drop(ctx, event, "cloudevent of the same owner already sent")
+		return
+	}
+	if !a.limiter.allow(ctx) {
+		a.overflow(ctx, event)
+		return
+	}
+	if a.batcher != nil {
+		a.batcher.add(ctx, event)
+		return
+	}
+	a.sendCloudEvent(ctx, event)
+}
+
+// sendBatch sends the batch event, as a delivery in flight.
+func (a *resourceDelegate) sendBatch(ctx context.Context, event cloudevents.Event) {
+	defer a.deliveries.enqueue(func() { a.dropped(ctx, event, source.DropReasonShutdown) })()
+	a.sendCloudEvent(ctx, event)
+}
+
+// drop drops event, which is not sent for reason.
+func (a *resourceDelegate) drop(ctx context.Context, event cloudevents.Event, reason string) {
+	a.logger.Debugw(reason, zap.String("type", event.Type()),
+		zap.String("source", event.Source()), zap.String("subject", event.Subject()))
+	// A batch of an earlier event of the resource may still be pending, so
+	// the checkpoint only moves past the dropped event when the events are
+	// not batched.
+	if a.batcher == nil {
+		a.checkpoint.delivered(ctx)
+	}
+}
+
+// overflow drops event, which is above the rate limit, or sends it to the
+// dead letter sink with the DeadLetter overflow policy.
+func (a *resourceDelegate) overflow(ctx context.Context, event cloudevents.Event) {
+	if a.limiter.policy != v1.OverflowPolicyDeadLetter || a.deadLetter == nil {
+		a.report(ctx, a.rateLimited, event)
+		a.dropped(ctx, event, source.DropReasonRateLimited)
+		a.drop(ctx, event, "cloudevent above the rate limit")
+		return
+	}
+	event.SetID(uuid.New().String())
+	result := cehttp.NewResult(http.StatusTooManyRequests, "above the rate limit")
+	if !a.sendToDeadLetterSink(ctx, event, a.sink, result) {
+		a.dropped(ctx, event, source.DropReasonRateLimited)
+		a.checkpoint.failed(ctx)
+		return
+	}
+	// Like the dropped events, the checkpoint only moves past the event when
+	// the events are not batched.
+	if a.batcher == nil {
+		a.checkpoint.delivered(ctx)
+	}
+}
+
 // sendCloudEvent sends a cloudevent everytime k8s api event is created, updated or deleted.
+// The event is sent to the sink and to the additional sinks whose filter it
+// passes concurrently.
 func (a *resourceDelegate) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
 	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
 	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
@@ -75,12 +443,121 @@ func (a *resourceDelegate) sendCloudEvent(ctx context.Context, event cloudevents
 	subject := event.Context.GetSubject()
 	a.logger.Debugf("sending cloudevent id: %s, source: %s, subject: %s", event.ID(), source, subject)
 
-	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
-		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("source", source),
-			zap.String("subject", subject), zap.String("id", event.ID()))
-	} else {
-		a.logger.Debugf("cloudevent sent id: %s, source: %s, subject: %s", event.ID(), source, subject)
+	var wg sync.WaitGroup
+	sent := make([]bool, len(a.sinks))
+	for i, sink := range a.sinks {
+		if sink.filter != nil && sink.filter.Filter(ctx, event) == eventfilter.FailFilter {
+			sent[i] = true
+			continue
+		}
+		wg.Add(1)
+		// Each sink gets its own copy of the event, as the sending sets its
+		// extensions.
+		go func(i int, sink *sinkTarget, event cloudevents.Event) {
+			defer wg.Done()
+			sent[i] = a.send(detachedContext(ctx), sink.ce, sink.uri, event)
+		}(i, sink, event.Clone())
 	}
+	delivered := a.send(ctx, a.ce, a.sink, event)
+	wg.Wait()
+
+	for _, ok := range sent {
+		delivered = delivered && ok
+	}
+	if !delivered {
+		a.checkpoint.failed(ctx)
+		return
+	}
+	a.checkpoint.delivered(ctx)
+}
+
+// send sends event to the sink at uri with ce, and to the dead letter sink
+// when its delivery fails. It reports whether the sink or the dead letter
+// sink received the event.
+func (a *resourceDelegate) send(ctx context.Context, ce cloudevents.Client, uri string, event cloudevents.Event) bool {
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+
+	result := ce.Send(ctx, event)
+	a.delivered(ctx, event, uri, result)
+	if !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("source", event.Source()),
+			zap.String("subject", event.Subject()), zap.String("id", event.ID()), zap.String("sink", uri))
+		if !a.sendToDeadLetterSink(ctx, event, uri, result) {
+			a.dropped(ctx, event, source.DropReasonUndeliverable)
+			return false
+		}
+		return true
+	}
+	a.logger.Debugf("cloudevent sent id: %s, source: %s, subject: %s, sink: %s", event.ID(), event.Source(), event.Subject(), uri)
+	// The additional sinks are sent the event with a detached context, so
+	// only the delivery to the sink is acknowledged.
+	a.acks.delivered(ctx, event.ID())
+	return true
+}
+
+// sendToDeadLetterSink sends event, whose delivery to the sink at uri failed
+// with result, to the dead letter sink when there is one. It reports whether
+// the dead letter sink received the event.
+func (a *resourceDelegate) sendToDeadLetterSink(ctx context.Context, event cloudevents.Event, uri string, result protocol.Result) bool {
+	if a.deadLetter == nil {
+		return false
+	}
+	event.SetExtension(attributes.KnativeErrorDestExtensionKey, uri)
+	event.SetExtension(attributes.KnativeErrorCodeExtensionKey, errorCode(result))
+
+	// The delivery to the sink may have used up the deadline of ctx, so the
+	// delivery to the dead letter sink starts afresh with the same retries.
+	dlsCtx := detachedContext(ctx)
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		dlsCtx, cancel = context.WithTimeout(dlsCtx, a.timeout)
+		defer cancel()
+	}
+
+	if result := a.deadLetter.Send(dlsCtx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent to the dead letter sink", zap.Error(result),
+			zap.String("source", event.Source()), zap.String("subject", event.Subject()), zap.String("id", event.ID()))
+		return false
+	}
+	a.logger.Debugf("cloudevent sent to the dead letter sink id: %s", event.ID())
+	return true
+}
+
+// detachedContext returns a context for a delivery of the event of ctx,
+// independent of the other deliveries of the event. It carries a copy of the
+// metric tag, the generation, the span data, the remote parent span and the
+// retry parameters of ctx, but none of its deadline.
+func detachedContext(ctx context.Context) context.Context {
+	tag := *kncloudevents.MetricTagFromContext(ctx)
+	detached := kncloudevents.ContextWithMetricTag(context.Background(), &tag)
+	if g, ok := ctx.Value(generationKey{}).(generation); ok {
+		detached = context.WithValue(detached, generationKey{}, g)
+	}
+	if sd := observability.SpanDataFromContext(ctx); sd != nil {
+		detached = observability.WithSpanData(detached, sd.Name, sd.Kind, sd.Attributes)
+	}
+	if parent, ok := observability.RemoteParentFromContext(ctx); ok {
+		detached = observability.WithRemoteParent(detached, parent)
+	}
+	return cecontext.WithRetryParams(detached, cecontext.RetriesFrom(ctx))
+}
+
+// errorCode returns the status code of the response to a failed delivery, or
+// noResponse when the sink did not respond.
+func errorCode(result protocol.Result) int {
+	var rres *cehttp.RetriesResult
+	if cloudevents.ResultAs(result, &rres) {
+		result = rres.Result
+	}
+	var res *cehttp.Result
+	if cloudevents.ResultAs(result, &res) {
+		return res.StatusCode
+	}
+	return noResponse
 }
 
 // Stub cache.Store impl
@@ -106,11 +583,76 @@ func (a *resourceDelegate) GetByKey(key string) (item interface{}, exists bool,
 }
 
 // Implements cache.Store
-func (a *resourceDelegate) Replace([]interface{}, string) error {
+// Replace is called with the initial list of the watched resources, which
+// seeds their last seen state, and replays the add events of the objects
+// which changed after the checkpoint. On the relists, it sends the delete
+// events of the objects deleted while the watch was down.
+func (a *resourceDelegate) Replace(list []interface{}, resourceVersion string) error {
+	var missed []interface{}
+	if a.objects != nil {
+		missed = a.deletedSince(list)
+		if err := a.objects.Replace(list, resourceVersion); err != nil {
+			return err
+		}
+	}
+	// The objects seen before, which are not listed anymore, were deleted
+	// while the watch was down.
+	for _, obj := range missed {
+		if err := a.Delete(obj); err != nil {
+			a.logger.Errorw("failed to send missed delete event", zap.Error(err))
+		}
+	}
+	// The initial scales are the baseline of the changes of the replicas.
+	for _, obj := range list {
+		a.scaled(obj)
+	}
+	if a.checkpoint == nil {
+		return nil
+	}
+	for _, obj := range a.checkpoint.missed(list) {
+		if err := a.Add(obj); err != nil {
+			a.logger.Errorw("failed to replay missed event", zap.Error(err))
+		}
+	}
 	return nil
 }
 
+// deletedSince returns the tombstones of the objects seen before which are
+// not in list, holding their last seen state.
+func (a *resourceDelegate) deletedSince(list []interface{}) []interface{} {
+	listed := make(map[string]struct{}, len(list))
+	for _, obj := range list {
+		if key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj); err == nil {
+			listed[key] = struct{}{}
+		}
+	}
+	var tombstones []interface{}
+	for _, obj := range a.objects.List() {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		if _, ok := listed[key]; !ok {
+			tombstones = append(tombstones, cache.DeletedFinalStateUnknown{Key: key, Obj: obj})
+		}
+	}
+	return tombstones
+}
+
 // Implements cache.Store
+// Resync is called on each resync period of the watched resources, and sends
+// the sync events of their last seen state when resyncs are enabled.
 func (a *resourceDelegate) Resync() error {
+	if !a.resync || a.objects == nil {
+		return nil
+	}
+	for _, obj := range a.objects.List() {
+		ctx, event, err := events.MakeSyncEvent(a.source, a.apiServerSourceName, obj, a.ref, a.withOwner(a.opts, obj)...)
+		if err != nil {
+			a.logger.Infow("event creation failed", zap.Error(err))
+			continue
+		}
+		a.dispatch(a.withMarks(ctx, obj), event)
+	}
 	return nil
 }
@@ -16,9 +16,21 @@ limitations under the License.
 package apiserver
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/sources"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
 )
 
 func TestResourceAddEvent(t *testing.T) {
@@ -57,6 +69,228 @@ func TestResourceDeleteEventNil(t *testing.T) {
 	validateNotSent(t, ce, sources.ApiServerSourceDeleteEventType)
 }
 
+func TestResourceUpdateEventPreviousState(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+
+	if err := d.Replace([]interface{}{restartedPod(1)}, "1"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+	d.Update(restartedPod(3))
+	d.Update(restartedPod(4))
+	d.Delete(restartedPod(4))
+	d.Update(restartedPod(0))
+
+	sent := ce.Sent()
+	if len(sent) != 4 {
+		t.Fatalf("Expected 4 events to be sent, got %d", len(sent))
+	}
+	want := []interface{}{int32(2), int32(1), nil, nil}
+	for i, event := range sent[:len(want)] {
+		if diff := cmp.Diff(want[i], event.Extensions()["newrestarts"]); diff != "" {
+			t.Errorf("unexpected newrestarts of event %d (-want, +got) = %s", i, diff)
+		}
+	}
+}
+
+func TestResourceDeleteEventFinalStateUnknown(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+
+	if err := d.Replace([]interface{}{restartedPod(1)}, "1"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+	stale := simplePod("unit", "test")
+	if err := d.Delete(cache.DeletedFinalStateUnknown{Key: "test/unit", Obj: stale}); err != nil {
+		t.Fatal("Delete() =", err)
+	}
+
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+	if got := sent[0].Extensions()["finalstateunknown"]; got != true {
+		t.Errorf("Expected the event to be flagged finalstateunknown, got %v", got)
+	}
+	want, err := json.Marshal(restartedPod(1).Object)
+	if err != nil {
+		t.Fatal("Marshal() =", err)
+	}
+	if diff := cmp.Diff(string(want), string(sent[0].Data())); diff != "" {
+		t.Error("Expected the last seen state of the object to be sent (-want, +got) =", diff)
+	}
+	if _, exists, _ := d.objects.Get(stale); exists {
+		t.Error("Expected the last seen state of the object to be dropped")
+	}
+}
+
+func TestResourceReplaceMissedDelete(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+
+	if err := d.Replace([]interface{}{simplePod("unit", "test"), simplePod("other", "test")}, "1"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+	if err := d.Replace([]interface{}{simplePod("other", "test")}, "2"); err != nil {
+		t.Fatal("Replace() =", err)
+	}
+
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+	if sent[0].Type() != sources.ApiServerSourceDeleteEventType || sent[0].Subject() != "/apis/v1/namespaces/test/pods/unit" {
+		t.Errorf("Expected the delete event of unit, got %s of %s", sent[0].Type(), sent[0].Subject())
+	}
+	if got := sent[0].Extensions()["finalstateunknown"]; got != true {
+		t.Errorf("Expected the event to be flagged finalstateunknown, got %v", got)
+	}
+}
+
+func TestResourceVerbs(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+	d.verbs = sets.NewString(v1.UpdateVerb)
+
+	d.Add(restartedPod(1))
+	d.Update(restartedPod(3))
+	d.Delete(restartedPod(3))
+
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+	if sent[0].Type() != sources.ApiServerSourceUpdateEventType {
+		t.Errorf("Expected the update event to be sent, got %s", sent[0].Type())
+	}
+	// The state of the objects is kept for the verbs which are not sent.
+	if diff := cmp.Diff(int32(2), sent[0].Extensions()["newrestarts"]); diff != "" {
+		t.Error("unexpected newrestarts (-want, +got) =", diff)
+	}
+	if keys := d.objects.ListKeys(); len(keys) != 0 {
+		t.Errorf("Expected the state of the deleted object to be dropped, got %v", keys)
+	}
+}
+
+func TestResourceSinks(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.sink = "http://sink.example.com"
+	all, failed := adaptertest.NewTestClient(), adaptertest.NewTestClient()
+	d.sinks = []*sinkTarget{{
+		ce:  all,
+		uri: "http://all.example.com",
+	}, {
+		ce:  failed,
+		uri: "http://failed.example.com",
+		filter: subscriptionsapi.CreateSubscriptionsAPIFilters(context.Background(), []eventingv1.SubscriptionsAPIFilter{{
+			Exact: map[string]string{"type": "unit.sendFail"},
+		}}),
+	}}
+	dls := adaptertest.NewTestClient()
+	d.deadLetter = dls
+
+	for _, typ := range []string{"unit.type", "unit.sendFail"} {
+		event := cloudevents.NewEvent()
+		event.SetType(typ)
+		event.SetSource("unit-test")
+		d.sendCloudEvent(context.Background(), event)
+	}
+
+	if got := len(ce.Sent()); got != 2 {
+		t.Error("Expected 2 events to be sent to the sink, got:", got)
+	}
+	if got := len(all.Sent()); got != 2 {
+		t.Error("Expected 2 events to be sent to the sink without filter, got:", got)
+	}
+	sent := failed.Sent()
+	if len(sent) != 1 || sent[0].Type() != "unit.sendFail" {
+		t.Errorf("Expected the unit.sendFail event to be sent to the filtered sink, got: %v", sent)
+	} else if got, want := sent[0].ID(), ce.Sent()[1].ID(); got != want {
+		t.Errorf("Expected the sinks to receive the same event id %q, got %q", want, got)
+	}
+
+	got := sets.NewString()
+	for _, event := range dls.Sent() {
+		got.Insert(event.Extensions()["knativeerrordest"].(string))
+	}
+	want := sets.NewString("http://sink.example.com", "http://all.example.com", "http://failed.example.com")
+	if !got.Equal(want) {
+		t.Errorf("Expected the failed deliveries to %v to be sent to the dead letter sink, got %v", want.List(), got.List())
+	}
+}
+
+func TestResourceFilter(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.filter = subscriptionsapi.CreateSubscriptionsAPIFilters(context.Background(), []eventingv1.SubscriptionsAPIFilter{{
+		Any: []eventingv1.SubscriptionsAPIFilter{{
+			Exact: map[string]string{"type": sources.ApiServerSourceAddEventType},
+		}, {
+			CESQL: "type = '" + sources.ApiServerSourceDeleteEventType + "'",
+		}},
+	}})
+
+	d.Add(simplePod("unit", "test"))
+	d.Update(simplePod("unit", "test"))
+	d.Delete(simplePod("unit", "test"))
+
+	sent := ce.Sent()
+	if len(sent) != 2 {
+		t.Fatalf("Expected 2 events to be sent, got %d", len(sent))
+	}
+	want := []string{sources.ApiServerSourceAddEventType, sources.ApiServerSourceDeleteEventType}
+	for i, event := range sent {
+		if got := event.Type(); got != want[i] {
+			t.Errorf("Expected event %d of type %q, got %q", i, want[i], got)
+		}
+	}
+}
+
+func restartedPod(restarts int64) *unstructured.Unstructured {
+	pod := simplePod("unit", "test")
+	pod.Object["status"] = map[string]interface{}{
+		"containerStatuses": []interface{}{
+			map[string]interface{}{"name": "app", "restartCount": restarts},
+		},
+	}
+	return pod
+}
+
+func TestResourceResync(t *testing.T) {
+	for _, ref := range []bool{false, true} {
+		d, ce := makeResourceAndTestingClient()
+		d.ref = ref
+		d.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+
+		if err := d.Replace([]interface{}{simplePod("unit", "test"), simplePod("other", "test")}, "1"); err != nil {
+			t.Fatal("Replace() =", err)
+		}
+		if err := d.Resync(); err != nil {
+			t.Fatal("Resync() =", err)
+		}
+		validateNotSent(t, ce, sources.ApiServerSourceSyncEventType)
+
+		d.resync = true
+		d.Delete(simplePod("other", "test"))
+		if err := d.Resync(); err != nil {
+			t.Fatal("Resync() =", err)
+		}
+		want := sources.ApiServerSourceSyncEventType
+		if ref {
+			want = sources.ApiServerSourceSyncRefEventType
+		}
+		sent := ce.Sent()
+		if len(sent) != 2 {
+			t.Fatalf("Expected 2 events to be sent, got %d", len(sent))
+		}
+		if got := sent[1].Type(); got != want {
+			t.Errorf("Expected %q event to be sent, got %q", want, got)
+		}
+		if got := sent[1].Extensions()["name"]; got != "unit" {
+			t.Errorf("Expected the sync event of %q, got %q", "unit", got)
+		}
+	}
+}
+
 // HACKHACKHACK For test coverage.
 func TestResourceStub(t *testing.T) {
 	d, _ := makeResourceAndTestingClient()
@@ -68,3 +302,37 @@ func TestResourceStub(t *testing.T) {
 	d.Replace(nil, "")
 	d.Resync()
 }
+
+func TestResourceDeadLetterSink(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	dls := adaptertest.NewTestClient()
+	d.deadLetter = dls
+	d.sink = "http://sink.example.com"
+
+	ok := cloudevents.NewEvent()
+	ok.SetType("unit.type")
+	ok.SetSource("unit-test")
+	d.sendCloudEvent(context.Background(), ok)
+
+	failed := cloudevents.NewEvent()
+	failed.SetType("unit.sendFail")
+	failed.SetSource("unit-test")
+	d.sendCloudEvent(context.Background(), failed)
+
+	if got := len(ce.Sent()); got != 2 {
+		t.Fatal("Expected 2 events to be sent to the sink, got:", got)
+	}
+	sent := dls.Sent()
+	if len(sent) != 1 {
+		t.Fatal("Expected 1 event to be sent to the dead letter sink, got:", len(sent))
+	}
+	if got := sent[0].Type(); got != "unit.sendFail" {
+		t.Errorf("Expected %q event to be sent to the dead letter sink, got %q", "unit.sendFail", got)
+	}
+	if got := sent[0].Extensions()["knativeerrordest"]; got != "http://sink.example.com" {
+		t.Errorf("Expected knativeerrordest %q, got %v", "http://sink.example.com", got)
+	}
+	if got := sent[0].Extensions()["knativeerrorcode"]; got != int32(400) {
+		t.Errorf("Expected knativeerrorcode 400, got %v", got)
+	}
+}
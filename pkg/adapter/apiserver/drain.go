@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"sync"
+	"time"
+)
+
+// deliveryQueue keeps track of the deliveries of the events in flight, from
+// their dispatch to the end of their last retry, so the adapter can wait for
+// them to complete before it exits. Like its other methods, enqueue does
+// nothing on a nil deliveryQueue.
+type deliveryQueue struct {
+	mu       sync.Mutex
+	next     uint64
+	inflight map[uint64]func()
+	idle     []chan struct{}
+}
+
+func newDeliveryQueue() *deliveryQueue {
+	return &deliveryQueue{inflight: make(map[uint64]func())}
+}
+
+// enqueue adds a delivery in flight to the queue, until done is called. drop,
+// if not nil, reports the delivery as dropped when the queue is not drained
+// before then.
+func (q *deliveryQueue) enqueue(drop func()) (done func()) {
+	if q == nil {
+		return func() {}
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	id := q.next
+	q.next++
+	q.inflight[id] = drop
+	return func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		if _, ok := q.inflight[id]; !ok {
+			return
+		}
+		delete(q.inflight, id)
+		if len(q.inflight) == 0 {
+			for _, ch := range q.idle {
+				close(ch)
+			}
+			q.idle = nil
+		}
+	}
+}
+
+// drain waits for the deliveries in flight to complete, for at most timeout
+// unless it is 0. The deliveries which do not complete by then are reported
+// as dropped, and their number is returned.
+func (q *deliveryQueue) drain(timeout time.Duration) int {
+	if q == nil {
+		return 0
+	}
+	q.mu.Lock()
+	if len(q.inflight) == 0 {
+		q.mu.Unlock()
+		return 0
+	}
+	idle := make(chan struct{})
+	q.idle = append(q.idle, idle)
+	q.mu.Unlock()
+
+	if timeout <= 0 {
+		<-idle
+		return 0
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-idle:
+		return 0
+	case <-timer.C:
+	}
+
+	q.mu.Lock()
+	remaining := q.inflight
+	q.inflight = make(map[uint64]func())
+	q.mu.Unlock()
+	for _, drop := range remaining {
+		if drop != nil {
+			drop()
+		}
+	}
+	return len(remaining)
+}
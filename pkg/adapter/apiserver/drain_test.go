@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/pkg/logging"
+	pkgtesting "knative.dev/pkg/reconciler/testing"
+
+	"knative.dev/eventing/pkg/apis/sources"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func TestDeliveryQueueDrain(t *testing.T) {
+	q := newDeliveryQueue()
+	if got := q.drain(time.Second); got != 0 {
+		t.Errorf("drain() of an empty queue = %d, want 0", got)
+	}
+
+	var dropped int32
+	drop := func() { atomic.AddInt32(&dropped, 1) }
+	done := q.enqueue(drop)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		done()
+	}()
+	if got := q.drain(time.Minute); got != 0 {
+		t.Errorf("drain() = %d, want 0 once the delivery completed", got)
+	}
+
+	q.enqueue(drop)
+	done = q.enqueue(drop)
+	done()
+	if got := q.drain(10 * time.Millisecond); got != 1 {
+		t.Errorf("drain() = %d, want the 1 delivery still in flight", got)
+	}
+	if got := atomic.LoadInt32(&dropped); got != 1 {
+		t.Errorf("Expected 1 delivery to be reported as dropped, got %d", got)
+	}
+}
+
+func TestDeliveryQueueNil(t *testing.T) {
+	var q *deliveryQueue
+	q.enqueue(nil)()
+	if got := q.drain(time.Second); got != 0 {
+		t.Errorf("drain() of a nil queue = %d, want 0", got)
+	}
+}
+
+func TestAdapter_StartDrainsBatches(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+
+	config := Config{
+		Namespace: "default",
+		Resources: []ResourceWatch{{
+			GVR: schema.GroupVersionResource{
+				Version:  "v1",
+				Resource: "pods",
+			},
+		}},
+		EventMode:    "Resource",
+		ResyncPeriod: "100ms",
+		// The batches are only sent on shutdown.
+		Batch: &v1.ApiServerSourceBatch{MaxSize: 100, Window: "1h"},
+	}
+	ctx, _ := pkgtesting.SetupFakeContext(t)
+
+	a := &apiServerAdapter{
+		ce:     ce,
+		logger: logging.FromContext(ctx),
+		config: config,
+
+		discover: makeDiscoveryClient(),
+		k8s:      makeDynamicClient(simplePod("foo", "default")),
+		source:   "unit-test",
+		name:     "unittest",
+
+		drainTimeout: time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		done <- a.Start(ctx)
+	}()
+
+	// Wait for a few resyncs of the pod to be batched.
+	time.Sleep(500 * time.Millisecond)
+	if got := len(ce.Sent()); got != 0 {
+		t.Fatal("Expected no batch to be sent before shutdown, got:", got)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Error("Did not expect an error, but got:", err)
+	}
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatal("Expected the pending batch to be sent on shutdown, got:", len(sent))
+	}
+	if got := sent[0].Type(); got != sources.ApiServerSourceBatchEventType {
+		t.Errorf("Expected %q event to be sent, got %q", sources.ApiServerSourceBatchEventType, got)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	sources "knative.dev/eventing/pkg/apis/sources"
+)
+
+// batchItem is an event of a batch.
+type batchItem struct {
+	Type    string          `json:"type"`
+	Subject string          `json:"subject,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// BatchKey returns the key of the batch event belongs to: the events of the
+// resources of a same kind are batched together.
+func BatchKey(event cloudevents.Event) string {
+	exts := event.Extensions()
+	return fmt.Sprintf("%v/%v/%v", exts["apigroup"], exts["apiversion"], exts["kind"])
+}
+
+// MakeBatchEvent returns a cloudevent coalescing batch, a list of events of
+// resources of a same kind, into a JSON array of their type, subject and
+// data.
+func MakeBatchEvent(batch []cloudevents.Event) (cloudevents.Event, error) {
+	if len(batch) == 0 {
+		return cloudevents.Event{}, fmt.Errorf("batch can not be empty")
+	}
+	first := batch[0]
+
+	items := make([]batchItem, 0, len(batch))
+	for _, e := range batch {
+		items = append(items, batchItem{
+			Type:    e.Type(),
+			Subject: e.Subject(),
+			Data:    e.Data(),
+		})
+	}
+
+	eventType := sources.ApiServerSourceBatchEventType
+	if strings.HasPrefix(first.Type(), "dev.knative.apiserver.ref.") {
+		eventType = sources.ApiServerSourceBatchRefEventType
+	}
+
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(eventType)
+	event.SetSource(first.Source())
+	for _, name := range []string{"kind", "apigroup", "apiversion"} {
+		if v, ok := first.Extensions()[name]; ok {
+			event.SetExtension(name, v)
+		}
+	}
+	event.SetExtension("batchsize", len(items))
+	if err := event.SetData(cloudevents.ApplicationJSON, items); err != nil {
+		return event, err
+	}
+	return event, nil
+}
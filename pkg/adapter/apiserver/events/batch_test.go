@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events_test
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func TestMakeBatchEvent(t *testing.T) {
+	_, add, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, simplePod("a", "test"), true)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	_, del, err := events.MakeDeleteEvent("unit-test", apiServerSourceNameTest, simplePod("b", "test"), true)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	got, err := events.MakeBatchEvent([]cloudevents.Event{add, del})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if got.Type() != sources.ApiServerSourceBatchRefEventType {
+		t.Errorf("unexpected type, want %q, got %q", sources.ApiServerSourceBatchRefEventType, got.Type())
+	}
+	if got.Source() != "unit-test" {
+		t.Errorf("unexpected source %q", got.Source())
+	}
+	wantExts := map[string]interface{}{
+		"kind":       "Pod",
+		"apigroup":   "v1",
+		"apiversion": "",
+		"batchsize":  int32(2),
+	}
+	if diff := cmp.Diff(wantExts, got.Extensions()); diff != "" {
+		t.Error("unexpected extensions (-want, +got) =", diff)
+	}
+	wantData := `[{"type":"dev.knative.apiserver.ref.add","subject":"/apis/v1/namespaces/test/pods/a","data":{"kind":"Pod","namespace":"test","name":"a","apiVersion":"v1"}},` +
+		`{"type":"dev.knative.apiserver.ref.delete","subject":"/apis/v1/namespaces/test/pods/b","data":{"kind":"Pod","namespace":"test","name":"b","apiVersion":"v1"}}]`
+	if diff := cmp.Diff(wantData, string(got.Data())); diff != "" {
+		t.Error("unexpected data (-want, +got) =", diff)
+	}
+
+	if events.BatchKey(add) != events.BatchKey(del) {
+		t.Error("expected the events of a same kind to share their batch key")
+	}
+}
+
+func TestMakeBatchEventEmpty(t *testing.T) {
+	if _, err := events.MakeBatchEvent(nil); err == nil {
+		t.Error("expected an error for an empty batch")
+	}
+}
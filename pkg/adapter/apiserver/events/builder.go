@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/eventing/pkg/apis/sources"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// extensionName matches the valid names of CloudEvents extensions.
+var extensionName = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// Builder builds the events an ApiServerSource sends for the changes of the
+// objects it watches. It lets third-party sources and tests build events
+// identical to the ones of the receive adapter.
+type Builder struct {
+	source              string
+	apiServerSourceName string
+	ref                 bool
+	extensions          map[string]string
+	opts                []EventOption
+}
+
+// BuilderOption configures a Builder.
+type BuilderOption func(*Builder) error
+
+// NewBuilder returns a Builder of the events of the ApiServerSource named
+// apiServerSourceName, with the source attribute source. The events carry the
+// objects themselves unless configured otherwise.
+func NewBuilder(source, apiServerSourceName string, opts ...BuilderOption) (*Builder, error) {
+	if source == "" {
+		return nil, fmt.Errorf("the source of the events can not be empty")
+	}
+	b := &Builder{
+		source:              source,
+		apiServerSourceName: apiServerSourceName,
+	}
+	for _, opt := range opts {
+		if err := opt(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// WithMode sets the EventMode of the events: whether they carry the objects,
+// in the Resource mode, or their references, in the Reference mode.
+func WithMode(mode string) BuilderOption {
+	return func(b *Builder) error {
+		switch mode {
+		case sourcesv1.ResourceMode:
+			b.ref = false
+		case sourcesv1.ReferenceMode:
+			b.ref = true
+		default:
+			return fmt.Errorf("unsupported event mode %q", mode)
+		}
+		return nil
+	}
+}
+
+// WithExtensions sets static extensions on every event. They do not override
+// the extensions the events are built with, e.g. `kind` or `name`.
+func WithExtensions(extensions map[string]string) BuilderOption {
+	return func(b *Builder) error {
+		for name := range extensions {
+			if !extensionName.MatchString(name) {
+				return fmt.Errorf("invalid extension name %q", name)
+			}
+		}
+		b.extensions = extensions
+		return nil
+	}
+}
+
+// WithContentType sets the content type the data of the events is encoded
+// in, one of application/json, application/protobuf or application/avro.
+func WithContentType(contentType string) BuilderOption {
+	return func(b *Builder) error {
+		encoder, err := NewDataEncoder(contentType)
+		if err != nil {
+			return err
+		}
+		b.opts = append(b.opts, WithDataEncoder(encoder))
+		return nil
+	}
+}
+
+// WithSubjects sets the function resolving the subjects of the events.
+func WithSubjects(subject SubjectFunc) BuilderOption {
+	return func(b *Builder) error {
+		b.opts = append(b.opts, WithSubjectFunc(subject))
+		return nil
+	}
+}
+
+// WithEventOptions sets the EventOptions every event is built with, before
+// the ones of each event.
+func WithEventOptions(opts ...EventOption) BuilderOption {
+	return func(b *Builder) error {
+		b.opts = append(b.opts, opts...)
+		return nil
+	}
+}
+
+// BuildError is returned when an event can not be built for an object.
+type BuildError struct {
+	// Verb is the change of the object the event was built for: add,
+	// update, delete, sync or scale.
+	Verb string
+	// Object refers to the object, when it is not nil.
+	Object *corev1.ObjectReference
+	// Err is the cause of the error.
+	Err error
+}
+
+func (e *BuildError) Error() string {
+	if e.Object == nil {
+		return fmt.Sprintf("failed to build the %s event: %v", e.Verb, e.Err)
+	}
+	return fmt.Sprintf("failed to build the %s event of %s %s %s/%s: %v",
+		e.Verb, e.Object.APIVersion, e.Object.Kind, e.Object.Namespace, e.Object.Name, e.Err)
+}
+
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
+
+// Add returns the event of the creation of obj.
+func (b *Builder) Add(obj *unstructured.Unstructured, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	return b.build(sourcesv1.AddVerb, obj, makeAddEvent, opts)
+}
+
+// Update returns the event of the update of obj from old, which may be nil
+// when the previous state of obj is unknown.
+func (b *Builder) Update(obj, old *unstructured.Unstructured, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	if old != nil {
+		opts = append([]EventOption{WithOldObject(old)}, opts...)
+	}
+	return b.build(sourcesv1.UpdateVerb, obj, makeUpdateEvent, opts)
+}
+
+// Delete returns the event of the deletion of obj, its last known state.
+func (b *Builder) Delete(obj *unstructured.Unstructured, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	return b.build(sourcesv1.DeleteVerb, obj, makeDeleteEvent, opts)
+}
+
+// Sync returns the event carrying the current state of obj when the objects
+// are resynced.
+func (b *Builder) Sync(obj *unstructured.Unstructured, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	return b.build("sync", obj, makeSyncEvent, opts)
+}
+
+// Scale returns the event of the change of the replicas of the scale
+// subresource of obj.
+func (b *Builder) Scale(obj *unstructured.Unstructured, scale Scale, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	return b.build("scale", obj, func(source, name string, obj *unstructured.Unstructured, _ bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+		return makeEvent(source, name, sources.ApiServerSourceScaleEventType, sources.ApiServerSourceScaleEventType, obj, scale, opts...)
+	}, opts)
+}
+
+type makeFunc func(source, apiServerSourceName string, obj *unstructured.Unstructured, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error)
+
+func (b *Builder) build(verb string, obj *unstructured.Unstructured, newEvent makeFunc, opts []EventOption) (context.Context, cloudevents.Event, error) {
+	if obj == nil {
+		return nil, cloudevents.Event{}, &BuildError{Verb: verb, Err: ErrNilObject}
+	}
+	all := make([]EventOption, 0, len(b.opts)+len(opts))
+	all = append(append(all, b.opts...), opts...)
+	ctx, event, err := newEvent(b.source, b.apiServerSourceName, obj, b.ref, all...)
+	if err == nil {
+		err = b.setExtensions(&event)
+	}
+	if err != nil {
+		ref := getRef(obj)
+		return nil, cloudevents.Event{}, &BuildError{Verb: verb, Object: &ref, Err: err}
+	}
+	return ctx, event, nil
+}
+
+func (b *Builder) setExtensions(event *cloudevents.Event) error {
+	extensions := event.Extensions()
+	for name, value := range b.extensions {
+		if _, ok := extensions[name]; ok {
+			continue
+		}
+		if err := event.Context.SetExtension(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func TestBuilderIdenticalEvents(t *testing.T) {
+	old := simplePod("unit", "test")
+	obj := simplePod("unit", "test")
+	obj.SetLabels(map[string]string{"app": "unit"})
+	scale := events.Scale{OldReplicas: 1, Replicas: 2}
+	opts := []events.EventOption{events.WithPartitionKey()}
+
+	for _, mode := range []string{sourcesv1.ResourceMode, sourcesv1.ReferenceMode} {
+		ref := mode == sourcesv1.ReferenceMode
+		b, err := events.NewBuilder("unit-test", apiServerSourceNameTest, events.WithMode(mode), events.WithEventOptions(opts...))
+		if err != nil {
+			t.Fatal("NewBuilder() =", err)
+		}
+		tests := map[string]struct {
+			build func() (cloudevents.Event, error)
+			make  func() (cloudevents.Event, error)
+		}{
+			"add": {
+				build: func() (cloudevents.Event, error) {
+					_, e, err := b.Add(obj)
+					return e, err
+				},
+				make: func() (cloudevents.Event, error) {
+					_, e, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, obj, ref, opts...)
+					return e, err
+				},
+			},
+			"update": {
+				build: func() (cloudevents.Event, error) {
+					_, e, err := b.Update(obj, old, events.WithUpdateClassification())
+					return e, err
+				},
+				make: func() (cloudevents.Event, error) {
+					_, e, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, obj, ref,
+						append(opts, events.WithOldObject(old), events.WithUpdateClassification())...)
+					return e, err
+				},
+			},
+			"delete": {
+				build: func() (cloudevents.Event, error) {
+					_, e, err := b.Delete(obj)
+					return e, err
+				},
+				make: func() (cloudevents.Event, error) {
+					_, e, err := events.MakeDeleteEvent("unit-test", apiServerSourceNameTest, obj, ref, opts...)
+					return e, err
+				},
+			},
+			"sync": {
+				build: func() (cloudevents.Event, error) {
+					_, e, err := b.Sync(obj)
+					return e, err
+				},
+				make: func() (cloudevents.Event, error) {
+					_, e, err := events.MakeSyncEvent("unit-test", apiServerSourceNameTest, obj, ref, opts...)
+					return e, err
+				},
+			},
+			"scale": {
+				build: func() (cloudevents.Event, error) {
+					_, e, err := b.Scale(obj, scale)
+					return e, err
+				},
+				make: func() (cloudevents.Event, error) {
+					_, e, err := events.MakeScaleEvent("unit-test", apiServerSourceNameTest, obj, scale, opts...)
+					return e, err
+				},
+			},
+		}
+		for n, tc := range tests {
+			t.Run(mode+" "+n, func(t *testing.T) {
+				want, err := tc.make()
+				if err != nil {
+					t.Fatal("Make event =", err)
+				}
+				got, err := tc.build()
+				if err != nil {
+					t.Fatal("Build event =", err)
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Error("unexpected event diff (-want, +got) =", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestNewBuilderErrors(t *testing.T) {
+	tests := map[string]struct {
+		source  string
+		opt     events.BuilderOption
+		wantErr string
+	}{
+		"empty source": {
+			opt:     events.WithMode(sourcesv1.ResourceMode),
+			wantErr: "the source of the events can not be empty",
+		},
+		"unsupported mode": {
+			source:  "unit-test",
+			opt:     events.WithMode(sourcesv1.DiffMode),
+			wantErr: `unsupported event mode "Diff"`,
+		},
+		"unsupported content type": {
+			source:  "unit-test",
+			opt:     events.WithContentType("text/plain"),
+			wantErr: `unsupported data content type "text/plain"`,
+		},
+		"invalid extension name": {
+			source:  "unit-test",
+			opt:     events.WithExtensions(map[string]string{"Team": "a"}),
+			wantErr: `invalid extension name "Team"`,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, err := events.NewBuilder(tc.source, apiServerSourceNameTest, tc.opt)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("NewBuilder() = %v, want %s", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	errUnresolved := errors.New("unresolved")
+	b, err := events.NewBuilder("unit-test", apiServerSourceNameTest,
+		events.WithSubjects(func(corev1.ObjectReference) (string, error) {
+			return "", errUnresolved
+		}))
+	if err != nil {
+		t.Fatal("NewBuilder() =", err)
+	}
+
+	_, _, err = b.Add(nil)
+	var buildErr *events.BuildError
+	if !errors.As(err, &buildErr) || buildErr.Verb != "add" || buildErr.Object != nil {
+		t.Errorf("Add(nil) = %v, want a BuildError of add", err)
+	}
+	if !errors.Is(err, events.ErrNilObject) {
+		t.Errorf("Add(nil) = %v, want %v", err, events.ErrNilObject)
+	}
+
+	_, _, err = b.Delete(simplePod("unit", "test"))
+	if !errors.As(err, &buildErr) || buildErr.Verb != "delete" {
+		t.Fatalf("Delete() = %v, want a BuildError of delete", err)
+	}
+	wantRef := &corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "test", Name: "unit"}
+	if diff := cmp.Diff(wantRef, buildErr.Object); diff != "" {
+		t.Error("unexpected object (-want, +got) =", diff)
+	}
+	if !errors.Is(err, errUnresolved) {
+		t.Errorf("Delete() = %v, want %v", err, errUnresolved)
+	}
+	want := "failed to build the delete event of v1 Pod test/unit: failed to resolve the subject: unresolved"
+	if err.Error() != want {
+		t.Errorf("Delete() = %v, want %s", err, want)
+	}
+}
+
+func TestBuilderOptions(t *testing.T) {
+	b, err := events.NewBuilder("unit-test", apiServerSourceNameTest,
+		events.WithContentType(events.ApplicationProtobuf),
+		events.WithExtensions(map[string]string{"team": "unit", "kind": "Other"}),
+		events.WithSubjects(func(ref corev1.ObjectReference) (string, error) {
+			return fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", ref.Namespace, ref.Name), nil
+		}))
+	if err != nil {
+		t.Fatal("NewBuilder() =", err)
+	}
+	_, event, err := b.Add(simplePod("unit", "test"))
+	if err != nil {
+		t.Fatal("Add() =", err)
+	}
+	if got := event.DataContentType(); got != events.ApplicationProtobuf {
+		t.Errorf("DataContentType() = %s, want %s", got, events.ApplicationProtobuf)
+	}
+	if got, want := event.Subject(), "/api/v1/namespaces/test/pods/unit"; got != want {
+		t.Errorf("Subject() = %s, want %s", got, want)
+	}
+	if got := event.Extensions()["team"]; got != "unit" {
+		t.Errorf("team extension = %v, want unit", got)
+	}
+	if got := event.Extensions()["kind"]; got != "Pod" {
+		t.Errorf("kind extension = %v, want Pod", got)
+	}
+}
+
+func TestMakeEventWrongType(t *testing.T) {
+	var nilObj *unstructured.Unstructured
+	tests := map[string]struct {
+		obj     interface{}
+		wantErr string
+	}{
+		"typed nil": {
+			obj:     nilObj,
+			wantErr: "resource can not be nil",
+		},
+		"not unstructured": {
+			obj:     &corev1.Pod{},
+			wantErr: "resource of type *v1.Pod is not unstructured",
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, _, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, tc.obj, false)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("MakeAddEvent() = %v, want %s", err, tc.wantErr)
+			}
+			_, _, err = events.MakeScaleEvent("unit-test", apiServerSourceNameTest, tc.obj, events.Scale{})
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("MakeScaleEvent() = %v, want %s", err, tc.wantErr)
+			}
+		})
+	}
+}
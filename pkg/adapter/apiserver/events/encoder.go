@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// ApplicationProtobuf is the content type of the protobuf encoded data.
+	ApplicationProtobuf = "application/protobuf"
+	// ApplicationAvro is the content type of the Avro encoded data.
+	ApplicationAvro = "application/avro"
+
+	// AvroSchema is the Avro schema of the data of the events encoded in
+	// Avro. It can hold any JSON value, so every resource is encoded with it.
+	AvroSchema = `{"type":"record","name":"Value","namespace":"dev.knative.apiserver","fields":[{"name":"value","type":["null","boolean","long","double","string",{"type":"array","items":"Value"},{"type":"map","values":"Value"}]}]}`
+)
+
+// DataEncoder encodes the data of the events.
+type DataEncoder interface {
+	// ContentType returns the content type of the encoded data.
+	ContentType() string
+	// Encode returns the encoding of data.
+	Encode(data interface{}) ([]byte, error)
+}
+
+// NewDataEncoder returns the encoder of contentType. Data is encoded in JSON
+// when contentType is empty.
+func NewDataEncoder(contentType string) (DataEncoder, error) {
+	switch contentType {
+	case "", cloudevents.ApplicationJSON:
+		return jsonEncoder{}, nil
+	case ApplicationProtobuf:
+		return protobufEncoder{}, nil
+	case ApplicationAvro:
+		return avroEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data content type %q", contentType)
+	}
+}
+
+type jsonEncoder struct{}
+
+func (jsonEncoder) ContentType() string {
+	return cloudevents.ApplicationJSON
+}
+
+func (jsonEncoder) Encode(data interface{}) ([]byte, error) {
+	return json.Marshal(data)
+}
+
+// protobufEncoder encodes data as a google.protobuf.Value message.
+type protobufEncoder struct{}
+
+func (protobufEncoder) ContentType() string {
+	return ApplicationProtobuf
+}
+
+func (protobufEncoder) Encode(data interface{}) ([]byte, error) {
+	v, err := toJSONValue(data)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := structpb.NewValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// avroEncoder encodes data in the Avro binary encoding, with AvroSchema.
+type avroEncoder struct{}
+
+func (avroEncoder) ContentType() string {
+	return ApplicationAvro
+}
+
+func (avroEncoder) Encode(data interface{}) ([]byte, error) {
+	v, err := toJSONValue(data)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := writeAvroValue(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// toJSONValue returns the generic JSON representation of data, made of maps,
+// slices, strings, booleans, json.Numbers and nils.
+func toJSONValue(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return resolveNumbers(v), nil
+}
+
+// resolveNumbers replaces the json.Numbers of v with int64s or float64s.
+func resolveNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case []interface{}:
+		for i := range t {
+			t[i] = resolveNumbers(t[i])
+		}
+	case map[string]interface{}:
+		for k := range t {
+			t[k] = resolveNumbers(t[k])
+		}
+	}
+	return v
+}
+
+// Indexes of the branches of the union of AvroSchema.
+const (
+	avroNull = iota
+	avroBoolean
+	avroLong
+	avroDouble
+	avroString
+	avroArray
+	avroMap
+)
+
+func writeAvroValue(buf *bytes.Buffer, v interface{}) error {
+	switch t := v.(type) {
+	case nil:
+		writeAvroLong(buf, avroNull)
+	case bool:
+		writeAvroLong(buf, avroBoolean)
+		if t {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case int64:
+		writeAvroLong(buf, avroLong)
+		writeAvroLong(buf, t)
+	case float64:
+		writeAvroLong(buf, avroDouble)
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(t))
+		buf.Write(b[:])
+	case string:
+		writeAvroLong(buf, avroString)
+		writeAvroString(buf, t)
+	case []interface{}:
+		writeAvroLong(buf, avroArray)
+		if len(t) > 0 {
+			writeAvroLong(buf, int64(len(t)))
+			for _, item := range t {
+				if err := writeAvroValue(buf, item); err != nil {
+					return err
+				}
+			}
+		}
+		writeAvroLong(buf, 0)
+	case map[string]interface{}:
+		writeAvroLong(buf, avroMap)
+		if len(t) > 0 {
+			keys := make([]string, 0, len(t))
+			for k := range t {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			writeAvroLong(buf, int64(len(t)))
+			for _, k := range keys {
+				writeAvroString(buf, k)
+				if err := writeAvroValue(buf, t[k]); err != nil {
+					return err
+				}
+			}
+		}
+		writeAvroLong(buf, 0)
+	default:
+		return fmt.Errorf("unsupported value type %T", v)
+	}
+	return nil
+}
+
+func writeAvroLong(buf *bytes.Buffer, n int64) {
+	var b [binary.MaxVarintLen64]byte
+	buf.Write(b[:binary.PutVarint(b[:], n)])
+}
+
+func writeAvroString(buf *bytes.Buffer, s string) {
+	writeAvroLong(buf, int64(len(s)))
+	buf.WriteString(s)
+}
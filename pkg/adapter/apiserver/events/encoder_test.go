@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events_test
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+)
+
+func TestNewDataEncoder(t *testing.T) {
+	for _, contentType := range []string{"", cloudevents.ApplicationJSON, events.ApplicationProtobuf, events.ApplicationAvro} {
+		encoder, err := events.NewDataEncoder(contentType)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", contentType, err)
+			continue
+		}
+		want := contentType
+		if want == "" {
+			want = cloudevents.ApplicationJSON
+		}
+		if got := encoder.ContentType(); got != want {
+			t.Errorf("unexpected content type, want %q, got %q", want, got)
+		}
+	}
+
+	if _, err := events.NewDataEncoder("application/xml"); err == nil {
+		t.Error("expected an error for an unsupported content type")
+	}
+}
+
+func TestProtobufEncoder(t *testing.T) {
+	encoder, _ := events.NewDataEncoder(events.ApplicationProtobuf)
+
+	b, err := encoder.Encode(simplePod("unit", "test"))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	var got structpb.Value
+	if err := proto.Unmarshal(b, &got); err != nil {
+		t.Fatal("failed to unmarshal the data:", err)
+	}
+
+	want := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "unit",
+			"namespace": "test",
+		},
+	}
+	if diff := cmp.Diff(want, got.AsInterface()); diff != "" {
+		t.Error("unexpected data (-want, +got) =", diff)
+	}
+}
+
+func TestAvroEncoder(t *testing.T) {
+	encoder, _ := events.NewDataEncoder(events.ApplicationAvro)
+
+	testCases := map[string]struct {
+		data interface{}
+		want []byte
+	}{
+		"null": {
+			data: nil,
+			want: []byte{0x00},
+		},
+		"boolean": {
+			data: true,
+			want: []byte{0x02, 0x01},
+		},
+		"long": {
+			data: -3,
+			want: []byte{0x04, 0x05},
+		},
+		"double": {
+			data: 0.5,
+			want: []byte{0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f},
+		},
+		"string": {
+			data: "ab",
+			want: []byte{0x08, 0x04, 'a', 'b'},
+		},
+		"empty array": {
+			data: []interface{}{},
+			want: []byte{0x0a, 0x00},
+		},
+		"array": {
+			data: []interface{}{"a", int64(1)},
+			want: []byte{0x0a, 0x04, 0x08, 0x02, 'a', 0x04, 0x02, 0x00},
+		},
+		"map": {
+			data: map[string]interface{}{"b": false, "a": int64(1)},
+			want: []byte{0x0c, 0x04, 0x02, 'a', 0x04, 0x02, 0x02, 'b', 0x02, 0x00, 0x00},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			got, err := encoder.Encode(tc.data)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error("unexpected encoding (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeEventDataEncoder(t *testing.T) {
+	encoder, _ := events.NewDataEncoder(events.ApplicationAvro)
+
+	_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), false,
+		events.WithDataEncoder(encoder), events.WithDataSchema("https://registry.example.com/schemas/ids/1"))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got.DataContentType() != events.ApplicationAvro {
+		t.Errorf("unexpected datacontenttype, want %q, got %q", events.ApplicationAvro, got.DataContentType())
+	}
+	if got.DataSchema() != "https://registry.example.com/schemas/ids/1" {
+		t.Errorf("unexpected dataschema %q", got.DataSchema())
+	}
+	got.SetID("unit-test") // set by the adapter when sending the event.
+	if err := got.Validate(); err != nil {
+		t.Error("invalid event:", err)
+	}
+
+	want, _ := encoder.Encode(simplePod("unit", "test"))
+	if diff := cmp.Diff(want, got.Data()); diff != "" {
+		t.Error("unexpected data (-want, +got) =", diff)
+	}
+}
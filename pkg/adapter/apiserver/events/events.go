@@ -18,31 +18,274 @@ package events
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	ceobs "github.com/cloudevents/sdk-go/v2/observability"
+	"github.com/google/cel-go/cel"
 	"go.opentelemetry.io/otel/trace"
+	"gomodules.xyz/jsonpatch/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
 	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	sources "knative.dev/eventing/pkg/apis/sources"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/eventing/pkg/observability"
 )
 
 const (
 	resourceGroup = "apiserversources.sources.knative.dev"
+
+	peerAuthenticationDefaultName = "default"
+	peerAuthenticationModeUnset   = "UNSET"
+
+	// DefaultRetries is the number of times the delivery of an event is
+	// retried when no retry policy is set.
+	DefaultRetries = 5
+
+	// DefaultBackoffDelay is the delay before the first retry of the delivery
+	// of an event when no retry policy is set.
+	DefaultBackoffDelay = 50 * time.Millisecond
+
+	// networksStatusAnnotation is set by Multus on the Pods it attaches to
+	// secondary networks.
+	networksStatusAnnotation = "k8s.v1.cni.cncf.io/networks-status"
+
+	// partitionKeyExtension is the extension of the CloudEvents
+	// partitioning extension, which Kafka sinks key their records by.
+	partitionKeyExtension = "partitionkey"
+
+	// finalStateUnknownExtension flags the delete events of the objects
+	// whose deletion was missed, e.g. while the watch was down. Their data
+	// is the last known state of the object, which may be stale.
+	finalStateUnknownExtension = "finalstateunknown"
+
+	// clusterExtension is the name of the remote cluster the object of the
+	// events is in.
+	clusterExtension = "cluster"
+
+	// traceParentAnnotation and traceStateAnnotation carry the W3C Trace
+	// Context of the change of an object, e.g. as set by the controller which
+	// created it. They are also the names of the CloudEvents distributed
+	// tracing extensions.
+	traceParentAnnotation = "traceparent"
+	traceStateAnnotation  = "tracestate"
 )
 
-// MakeAddEvent returns a cloudevent when a k8s api event is created.
-func MakeAddEvent(source string, apiServerSourceName string, obj interface{}, ref bool) (context.Context, cloudevents.Event, error) {
+// ErrNilObject is returned when an event is built for a nil object.
+var ErrNilObject = errors.New("resource can not be nil")
+
+// EventOption configures the optional enrichment of the events built by this
+// package.
+type EventOption func(*eventOptions)
+
+type eventOptions struct {
+	peerAuthLister cache.GenericLister
+	oldObj         *unstructured.Unstructured
+	diff           bool
+	fieldsToDrop   [][]string
+	encoder        DataEncoder
+	dataSchema     string
+	retries        int
+	backoffPolicy  duckv1.BackoffPolicyType
+	backoffDelay   time.Duration
+	owner          *corev1.ObjectReference
+	extensions     map[string]cel.Program
+	classify       bool
+	partitionKey   bool
+	cluster        string
+
+	kubernetesEvent bool
+
+	eventTypeTemplate string
+
+	subject SubjectFunc
+}
+
+func newEventOptions(opts []EventOption) *eventOptions {
+	options := &eventOptions{
+		retries:       DefaultRetries,
+		backoffPolicy: duckv1.BackoffPolicyExponential,
+		backoffDelay:  DefaultBackoffDelay,
+		subject:       selfLinkSubject,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithPeerAuthenticationLister sets the lister used to look up the Istio
+// PeerAuthentication policy of the Namespace objects events are built for.
+func WithPeerAuthenticationLister(lister cache.GenericLister) EventOption {
+	return func(o *eventOptions) {
+		o.peerAuthLister = lister
+	}
+}
+
+// WithUpdateClassification classifies the update events by the top level
+// sections of their object which changed. It has no effect when the previous
+// state of the object is unknown.
+func WithUpdateClassification() EventOption {
+	return func(o *eventOptions) {
+		o.classify = true
+	}
+}
+
+// WithExtensionExpressions sets the CloudEvents extensions set on the events,
+// by name, to the value the compiled CEL expression evaluates to against their
+// object. The values which are not strings are formatted as strings.
+func WithExtensionExpressions(extensions map[string]cel.Program) EventOption {
+	return func(o *eventOptions) {
+		o.extensions = extensions
+	}
+}
+
+// WithPartitionKey sets the partitionkey extension of the events to the
+// namespace and the name of their object, so the events of an object are
+// kept in order by the sinks partitioning their events by it.
+func WithPartitionKey() EventOption {
+	return func(o *eventOptions) {
+		o.partitionKey = true
+	}
+}
+
+// WithCluster sets the cluster extension of the events to the name of the
+// remote cluster their objects are in.
+func WithCluster(name string) EventOption {
+	return func(o *eventOptions) {
+		o.cluster = name
+	}
+}
+
+// WithKubernetesEventFormat makes add, update and delete events carry a
+// Kubernetes Event of the change of their object, rather than the object or
+// its reference.
+func WithKubernetesEventFormat() EventOption {
+	return func(o *eventOptions) {
+		o.kubernetesEvent = true
+	}
+}
+
+// WithEventTypeTemplate sets the EventTypeTemplate the types of the events
+// are expanded from, in place of their default types.
+func WithEventTypeTemplate(tmpl string) EventOption {
+	return func(o *eventOptions) {
+		o.eventTypeTemplate = tmpl
+	}
+}
+
+// WithOldObject sets the previous state of the object update events are
+// built for.
+func WithOldObject(obj *unstructured.Unstructured) EventOption {
+	return func(o *eventOptions) {
+		o.oldObj = obj
+	}
+}
+
+// WithDiff makes update events carry a JSON patch from the previous state of
+// their object to its new state, rather than the new state. It has no effect
+// on reference events, nor when the previous state of the object is unknown.
+func WithDiff() EventOption {
+	return func(o *eventOptions) {
+		o.diff = true
+	}
+}
+
+// WithFieldsToDrop sets the paths of the fields removed from the objects
+// before they are set as the data of the events. Paths go through every item
+// of the lists they meet, and a "*" field matches every field of an object.
+func WithFieldsToDrop(paths [][]string) EventOption {
+	return func(o *eventOptions) {
+		o.fieldsToDrop = paths
+	}
+}
+
+// WithDataEncoder sets the encoder of the data of the events, which is
+// encoded in JSON otherwise.
+func WithDataEncoder(encoder DataEncoder) EventOption {
+	return func(o *eventOptions) {
+		o.encoder = encoder
+	}
+}
+
+// WithRetries sets the number of times the delivery of an event is retried,
+// and the policy and the delay of the backoff between the retries.
+func WithRetries(retries int, policy duckv1.BackoffPolicyType, delay time.Duration) EventOption {
+	return func(o *eventOptions) {
+		o.retries = retries
+		o.backoffPolicy = policy
+		o.backoffDelay = delay
+	}
+}
+
+// WithOwner attributes the events to owner, the top controller of their
+// object, which becomes their subject and `owner` extension.
+func WithOwner(owner *corev1.ObjectReference) EventOption {
+	return func(o *eventOptions) {
+		o.owner = owner
+	}
+}
+
+// WithDataSchema sets the URI of the schema the data of the events adheres
+// to.
+func WithDataSchema(uri string) EventOption {
+	return func(o *eventOptions) {
+		o.dataSchema = uri
+	}
+}
+
+// SubjectFunc returns the subject of the events of the object, or of the
+// owner, ref refers to.
+type SubjectFunc func(ref corev1.ObjectReference) (string, error)
+
+// WithSubjectFunc sets the function resolving the subjects of the events and
+// their `owner` extension, which are guessed from the kinds of the objects
+// otherwise.
+func WithSubjectFunc(subject SubjectFunc) EventOption {
+	return func(o *eventOptions) {
+		if subject != nil {
+			o.subject = subject
+		}
+	}
+}
+
+// asUnstructured returns obj as an unstructured object, or an error when it is
+// nil or of another type.
+func asUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
 	if obj == nil {
-		return nil, cloudevents.Event{}, fmt.Errorf("resource can not be nil")
+		return nil, ErrNilObject
+	}
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("resource of type %T is not unstructured", obj)
 	}
-	object := obj.(*unstructured.Unstructured)
+	if object == nil {
+		return nil, ErrNilObject
+	}
+	return object, nil
+}
+
+// MakeAddEvent returns a cloudevent when a k8s api event is created.
+func MakeAddEvent(source string, apiServerSourceName string, obj interface{}, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	object, err := asUnstructured(obj)
+	if err != nil {
+		return nil, cloudevents.Event{}, err
+	}
+	return makeAddEvent(source, apiServerSourceName, object, ref, opts...)
+}
+
+func makeAddEvent(source string, apiServerSourceName string, object *unstructured.Unstructured, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	options := newEventOptions(opts)
 
 	var data interface{}
 	var eventType string
@@ -50,39 +293,145 @@ func MakeAddEvent(source string, apiServerSourceName string, obj interface{}, re
 		data = getRef(object)
 		eventType = sources.ApiServerSourceAddRefEventType
 	} else {
-		data = object
+		data = dropFields(object, options.fieldsToDrop)
 		eventType = sources.ApiServerSourceAddEventType
 	}
+	if options.kubernetesEvent {
+		data = makeKubernetesEvent(apiServerSourceName, object, kubernetesEventReasonAdded, nil)
+	}
 
-	return makeEvent(source, apiServerSourceName, eventType, object, data)
+	return makeEvent(source, apiServerSourceName, eventType, eventType, object, data, opts...)
 }
 
 // MakeUpdateEvent returns a cloudevent when a k8s api event is updated.
-func MakeUpdateEvent(source string, apiServerSourceName string, obj interface{}, ref bool) (context.Context, cloudevents.Event, error) {
-	if obj == nil {
-		return nil, cloudevents.Event{}, fmt.Errorf("resource can not be nil")
+func MakeUpdateEvent(source string, apiServerSourceName string, obj interface{}, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	object, err := asUnstructured(obj)
+	if err != nil {
+		return nil, cloudevents.Event{}, err
 	}
-	object := obj.(*unstructured.Unstructured)
+	return makeUpdateEvent(source, apiServerSourceName, object, ref, opts...)
+}
+
+func makeUpdateEvent(source string, apiServerSourceName string, object *unstructured.Unstructured, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	options := newEventOptions(opts)
 
 	var data interface{}
 	var eventType string
 	if ref {
 		data = getRef(object)
 		eventType = sources.ApiServerSourceUpdateRefEventType
+	} else if options.diff && options.oldObj != nil {
+		patch, err := makePatch(dropFields(options.oldObj, options.fieldsToDrop), dropFields(object, options.fieldsToDrop))
+		if err != nil {
+			return nil, cloudevents.Event{}, err
+		}
+		data = patch
+		eventType = sources.ApiServerSourceUpdateDiffEventType
 	} else {
-		data = object
+		data = dropFields(object, options.fieldsToDrop)
 		eventType = sources.ApiServerSourceUpdateEventType
 	}
 
-	return makeEvent(source, apiServerSourceName, eventType, object, data)
+	var sections []string
+	classifiedType := eventType
+	if options.classify && options.oldObj != nil {
+		sections = changedSections(options.oldObj, object)
+		if len(sections) == 1 {
+			classifiedType = sectionUpdateEventType(eventType, sections[0])
+		}
+	}
+	if options.kubernetesEvent {
+		data = makeKubernetesEvent(apiServerSourceName, object, kubernetesEventReasonUpdated, sections)
+	}
+
+	ctx, event, err := makeEvent(source, apiServerSourceName, classifiedType, eventType, object, data, opts...)
+	if err == nil && eventType == sources.ApiServerSourceUpdateDiffEventType {
+		event.SetExtension("previousresourceversion", options.oldObj.GetResourceVersion())
+	}
+	if err == nil && len(sections) > 0 {
+		event.SetExtension("changedsection", strings.Join(sections, ","))
+	}
+	return ctx, event, err
+}
+
+// changedSections returns the sorted top level sections of obj which changed
+// from old. The fields of the metadata the API server sets on each change are
+// ignored.
+func changedSections(old, obj *unstructured.Unstructured) []string {
+	var sections []string
+	for name := range obj.Object {
+		if !equality.Semantic.DeepEqual(sectionOf(old, name), sectionOf(obj, name)) {
+			sections = append(sections, name)
+		}
+	}
+	for name := range old.Object {
+		if _, ok := obj.Object[name]; !ok {
+			sections = append(sections, name)
+		}
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+// sectionOf returns the top level section of obj with the name, without the
+// fields of the metadata the API server sets on each change.
+func sectionOf(obj *unstructured.Unstructured, name string) interface{} {
+	section := obj.Object[name]
+	metadata, ok := section.(map[string]interface{})
+	if name != "metadata" || !ok {
+		return section
+	}
+	kept := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		switch k {
+		case "resourceVersion", "managedFields", "generation":
+		default:
+			kept[k] = v
+		}
+	}
+	return kept
+}
+
+// sectionUpdateEventType returns the event type of the updates of the
+// section only, or eventType when they have none.
+func sectionUpdateEventType(eventType, section string) string {
+	switch {
+	case eventType == sources.ApiServerSourceUpdateEventType && section == "status":
+		return sources.ApiServerSourceUpdateStatusEventType
+	case eventType == sources.ApiServerSourceUpdateEventType && section == "spec":
+		return sources.ApiServerSourceUpdateSpecEventType
+	case eventType == sources.ApiServerSourceUpdateRefEventType && section == "status":
+		return sources.ApiServerSourceUpdateStatusRefEventType
+	case eventType == sources.ApiServerSourceUpdateRefEventType && section == "spec":
+		return sources.ApiServerSourceUpdateSpecRefEventType
+	}
+	return eventType
 }
 
 // MakeDeleteEvent returns a cloudevent when a k8s api event is deleted.
-func MakeDeleteEvent(source string, apiServerSourceName string, obj interface{}, ref bool) (context.Context, cloudevents.Event, error) {
+// The last known state of the object is unwrapped from obj when its deletion
+// was missed, and the event is flagged by the finalstateunknown extension.
+func MakeDeleteEvent(source string, apiServerSourceName string, obj interface{}, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
 	if obj == nil {
-		return nil, cloudevents.Event{}, fmt.Errorf("resource can not be nil")
+		return nil, cloudevents.Event{}, ErrNilObject
+	}
+	tombstone, finalStateUnknown := obj.(cache.DeletedFinalStateUnknown)
+	if finalStateUnknown {
+		obj = tombstone.Obj
+	}
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok || object == nil {
+		return nil, cloudevents.Event{}, fmt.Errorf("the last known state of the deleted resource is unknown")
+	}
+	ctx, event, err := makeDeleteEvent(source, apiServerSourceName, object, ref, opts...)
+	if err == nil && finalStateUnknown {
+		event.SetExtension(finalStateUnknownExtension, true)
 	}
-	object := obj.(*unstructured.Unstructured)
+	return ctx, event, err
+}
+
+func makeDeleteEvent(source string, apiServerSourceName string, object *unstructured.Unstructured, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	options := newEventOptions(opts)
 	var data interface{}
 	var eventType string
 
@@ -90,11 +439,152 @@ func MakeDeleteEvent(source string, apiServerSourceName string, obj interface{},
 		data = getRef(object)
 		eventType = sources.ApiServerSourceDeleteRefEventType
 	} else {
-		data = object
+		data = dropFields(object, options.fieldsToDrop)
 		eventType = sources.ApiServerSourceDeleteEventType
 	}
+	if options.kubernetesEvent {
+		data = makeKubernetesEvent(apiServerSourceName, object, kubernetesEventReasonDeleted, nil)
+	}
 
-	return makeEvent(source, apiServerSourceName, eventType, object, data)
+	return makeEvent(source, apiServerSourceName, eventType, eventType, object, data, opts...)
+}
+
+// MakeSyncEvent returns a cloudevent carrying the current state of a k8s
+// object when the objects are resynced.
+func MakeSyncEvent(source string, apiServerSourceName string, obj interface{}, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	object, err := asUnstructured(obj)
+	if err != nil {
+		return nil, cloudevents.Event{}, err
+	}
+	return makeSyncEvent(source, apiServerSourceName, object, ref, opts...)
+}
+
+func makeSyncEvent(source string, apiServerSourceName string, object *unstructured.Unstructured, ref bool, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	options := newEventOptions(opts)
+
+	var data interface{}
+	var eventType string
+	if ref {
+		data = getRef(object)
+		eventType = sources.ApiServerSourceSyncRefEventType
+	} else {
+		data = dropFields(object, options.fieldsToDrop)
+		eventType = sources.ApiServerSourceSyncEventType
+	}
+
+	return makeEvent(source, apiServerSourceName, eventType, eventType, object, data, opts...)
+}
+
+// Scale is the data of the scale events: the change of the replicas of the
+// scale subresource of an object.
+type Scale struct {
+	// OldReplicas is the desired number of replicas before the change.
+	OldReplicas int32 `json:"oldReplicas"`
+	// Replicas is the desired number of replicas after the change.
+	Replicas int32 `json:"replicas"`
+	// StatusReplicas is the actual number of replicas at the time of the
+	// change.
+	StatusReplicas int32 `json:"statusReplicas"`
+	// Selector is the label selector of the replicas, if any.
+	Selector string `json:"selector,omitempty"`
+}
+
+// MakeScaleEvent returns a cloudevent when the replicas of the scale
+// subresource of a k8s object change.
+func MakeScaleEvent(source string, apiServerSourceName string, obj interface{}, scale Scale, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	object, err := asUnstructured(obj)
+	if err != nil {
+		return nil, cloudevents.Event{}, err
+	}
+	return makeEvent(source, apiServerSourceName, sources.ApiServerSourceScaleEventType, sources.ApiServerSourceScaleEventType, object, scale, opts...)
+}
+
+// partitionKey returns the namespace and the name of obj, or its name when it
+// is cluster scoped.
+func partitionKey(obj *unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return ns + "/" + obj.GetName()
+	}
+	return obj.GetName()
+}
+
+// setExpressionExtensions sets the extensions of the event to the value their
+// expression evaluates to against obj, when it is a scalar. An extension is
+// not set when its expression fails to evaluate, e.g. as it selects a field
+// obj does not have.
+func setExpressionExtensions(event *cloudevents.Event, obj *unstructured.Unstructured, extensions map[string]cel.Program) {
+	if len(extensions) == 0 {
+		return
+	}
+	vars := sourcesv1.ExtensionExpressionVariables(obj.Object)
+	for name, program := range extensions {
+		out, _, err := program.Eval(vars)
+		if err != nil {
+			continue
+		}
+		switch v := out.Value().(type) {
+		case string:
+			event.SetExtension(name, v)
+		case bool, int64, uint64, float64:
+			event.SetExtension(name, fmt.Sprint(v))
+		}
+	}
+}
+
+// dropFields returns a copy of obj without the fields of paths, or obj itself
+// when there are no paths.
+func dropFields(obj *unstructured.Unstructured, paths [][]string) *unstructured.Unstructured {
+	if len(paths) == 0 {
+		return obj
+	}
+	obj = obj.DeepCopy()
+	for _, path := range paths {
+		dropField(obj.Object, path)
+	}
+	return obj
+}
+
+func dropField(obj interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			if path[0] == "*" {
+				for k := range o {
+					delete(o, k)
+				}
+			} else {
+				delete(o, path[0])
+			}
+			return
+		}
+		if path[0] == "*" {
+			for _, v := range o {
+				dropField(v, path[1:])
+			}
+		} else if v, ok := o[path[0]]; ok {
+			dropField(v, path[1:])
+		}
+	case []interface{}:
+		for _, v := range o {
+			dropField(v, path)
+		}
+	}
+}
+
+// makePatch returns the JSON patch turning old into obj.
+func makePatch(old, obj *unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+	before, err := old.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	after, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreatePatch(before, after)
 }
 
 func getRef(object *unstructured.Unstructured) corev1.ObjectReference {
@@ -106,26 +596,98 @@ func getRef(object *unstructured.Unstructured) corev1.ObjectReference {
 	}
 }
 
-func makeEvent(source, apiServerSourceName, eventType string, obj *unstructured.Unstructured, data interface{}) (context.Context, cloudevents.Event, error) {
+// isUpdateEventType returns whether eventType is the type of update events,
+// before they are classified.
+func isUpdateEventType(eventType string) bool {
+	switch eventType {
+	case sources.ApiServerSourceUpdateEventType, sources.ApiServerSourceUpdateRefEventType, sources.ApiServerSourceUpdateDiffEventType:
+		return true
+	}
+	return false
+}
+
+// makeEvent returns the event of eventType for obj. baseType is the type of
+// the event before the update events are classified by the sections which
+// changed, which the enrichments of the events depend on.
+func makeEvent(source, apiServerSourceName, eventType, baseType string, obj *unstructured.Unstructured, data interface{}, opts ...EventOption) (context.Context, cloudevents.Event, error) {
+	options := newEventOptions(opts)
+
 	resourceName := obj.GetName()
 	kind := obj.GetKind()
 	namespace := obj.GetNamespace()
-	subject := createSelfLink(corev1.ObjectReference{
+	subject, err := options.subject(corev1.ObjectReference{
 		APIVersion: obj.GetAPIVersion(),
 		Kind:       kind,
 		Name:       resourceName,
 		Namespace:  namespace,
 	})
+	if err != nil {
+		return nil, cloudevents.Event{}, fmt.Errorf("failed to resolve the subject: %w", err)
+	}
+
+	// The enrichments of the events depend on their default type, whatever
+	// the type they are sent with.
+	ceType := eventType
+	if options.eventTypeTemplate != "" {
+		t, err := sourcesv1.ExpandEventTypeTemplate(options.eventTypeTemplate, eventType, obj.GetAPIVersion(), kind)
+		if err != nil {
+			return nil, cloudevents.Event{}, fmt.Errorf("failed to expand the event type template: %w", err)
+		}
+		ceType = t
+	}
 
 	event := cloudevents.NewEvent(cloudevents.VersionV1)
-	event.SetType(eventType)
+	event.SetType(ceType)
 	event.SetSource(source)
+	if options.owner != nil {
+		subject, err = options.subject(*options.owner)
+		if err != nil {
+			return nil, cloudevents.Event{}, fmt.Errorf("failed to resolve the subject of the owner: %w", err)
+		}
+		event.SetExtension("owner", subject)
+	}
 	event.SetSubject(subject)
 	// We copy the resource kind, name and namespace as extensions so that triggers can do the filter based on these attributes
 	event.SetExtension("kind", kind)
 	event.SetExtension("name", resourceName)
 	event.SetExtension("namespace", namespace)
-	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+	// The components of the APIVersion of the resource, before and after its
+	// "/", are copied too so that the events of resources of different types
+	// can be routed apart. The APIVersion of the core API types has no "/", so
+	// their apigroup is "v1" and their apiversion is empty.
+	group, version := obj.GetAPIVersion(), ""
+	if i := strings.Index(group, "/"); i >= 0 {
+		group, version = group[:i], group[i+1:]
+	}
+	event.SetExtension("apigroup", group)
+	event.SetExtension("apiversion", version)
+	setGenerationExtensions(&event, obj)
+	if isCoreKind(obj, "Pod") {
+		setNetworkAttachmentExtensions(&event, obj)
+		if isUpdateEventType(baseType) {
+			setRestartExtensions(&event, obj, options.oldObj)
+		}
+	}
+	if isCoreKind(obj, "Node") {
+		setVolumeExtensions(&event, obj)
+	}
+	if isCoreKind(obj, "Service") {
+		setExternalNameExtensions(&event, obj)
+	}
+	if isIngress(obj) && isIngress(options.oldObj) && isUpdateEventType(baseType) {
+		setAnnotationChangeExtensions(&event, obj, options.oldObj)
+	}
+	if isCoreKind(obj, "Namespace") && options.peerAuthLister != nil {
+		setPeerAuthenticationExtensions(&event, options.peerAuthLister, resourceName)
+	}
+	setExpressionExtensions(&event, obj, options.extensions)
+	if options.partitionKey {
+		event.SetExtension(partitionKeyExtension, partitionKey(obj))
+	}
+	if options.cluster != "" {
+		event.SetExtension(clusterExtension, options.cluster)
+	}
+	if err := setData(&event, options, data); err != nil {
 		return nil, event, err
 	}
 
@@ -140,12 +702,205 @@ func makeEvent(source, apiServerSourceName, eventType string, obj *unstructured.
 	ctx = observability.WithSpanData(ctx, spanName, int(trace.SpanKindProducer),
 		observability.K8sAttributes(apiServerSourceName, namespace, resourceGroup))
 
+	ctx = setTraceParent(ctx, &event, obj)
+
 	ctx = kncloudevents.ContextWithMetricTag(ctx, metricTag)
-	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+	if options.backoffPolicy == duckv1.BackoffPolicyLinear {
+		ctx = cloudevents.ContextWithRetriesLinearBackoff(ctx, options.backoffDelay, options.retries)
+	} else {
+		ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, options.backoffDelay, options.retries)
+	}
 
 	return ctx, event, nil
 }
 
+// setTraceParent continues the trace of the traceparent annotation of obj, if
+// valid, in ctx and stamps it and the tracestate annotation on event as their
+// distributed tracing extensions.
+func setTraceParent(ctx context.Context, event *cloudevents.Event, obj *unstructured.Unstructured) context.Context {
+	annotations := obj.GetAnnotations()
+	traceParent := annotations[traceParentAnnotation]
+	if traceParent == "" {
+		return ctx
+	}
+	traceState := annotations[traceStateAnnotation]
+	parent, ok := observability.SpanContextFromTraceParent(traceParent, traceState)
+	if !ok {
+		return ctx
+	}
+	event.SetExtension(traceParentAnnotation, traceParent)
+	if traceState != "" {
+		event.SetExtension(traceStateAnnotation, traceState)
+	}
+	return observability.WithRemoteParent(ctx, parent)
+}
+
+// setData sets data, encoded with the encoder of options, as the data of
+// event.
+func setData(event *cloudevents.Event, options *eventOptions, data interface{}) error {
+	if options.dataSchema != "" {
+		event.SetDataSchema(options.dataSchema)
+	}
+	if options.encoder == nil {
+		return event.SetData(cloudevents.ApplicationJSON, data)
+	}
+	b, err := options.encoder.Encode(data)
+	if err != nil {
+		return err
+	}
+	return event.SetData(options.encoder.ContentType(), b)
+}
+
+// setGenerationExtensions sets the generation last processed by the
+// controller of the resource, and how many generations it is behind, for the
+// resources reporting a status.observedGeneration.
+func setGenerationExtensions(event *cloudevents.Event, obj *unstructured.Unstructured) {
+	observed, found, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if !found || err != nil {
+		return
+	}
+	event.SetExtension("observedgeneration", observed)
+	event.SetExtension("generationlag", obj.GetGeneration()-observed)
+}
+
+// setExternalNameExtensions sets the DNS name ExternalName Services alias.
+func setExternalNameExtensions(event *cloudevents.Event, obj *unstructured.Unstructured) {
+	serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+	if serviceType != string(corev1.ServiceTypeExternalName) {
+		return
+	}
+	externalName, _, _ := unstructured.NestedString(obj.Object, "spec", "externalName")
+	event.SetExtension("externalname", externalName)
+	event.SetExtension("isexternal", "true")
+}
+
+// networkStatus is an entry of the networks-status annotation.
+type networkStatus struct {
+	Name      string `json:"name"`
+	Interface string `json:"interface,omitempty"`
+}
+
+// setNetworkAttachmentExtensions sets the number and the names of the network
+// interfaces Multus reports for the Pod.
+func setNetworkAttachmentExtensions(event *cloudevents.Event, obj *unstructured.Unstructured) {
+	annotation, ok := obj.GetAnnotations()[networksStatusAnnotation]
+	if !ok {
+		return
+	}
+	var statuses []networkStatus
+	if err := json.Unmarshal([]byte(annotation), &statuses); err != nil {
+		return
+	}
+
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		if s.Interface != "" {
+			names = append(names, s.Interface)
+		} else {
+			names = append(names, s.Name)
+		}
+	}
+	event.SetExtension("networkattachmentcount", len(statuses))
+	event.SetExtension("networkattachments", strings.Join(names, ","))
+}
+
+// setRestartExtensions sets the total number of container restarts of the
+// Pod, and how many happened since its previous state when it is known. Pods
+// not reporting container statuses yet are skipped.
+func setRestartExtensions(event *cloudevents.Event, obj, old *unstructured.Unstructured) {
+	total, found := totalRestarts(obj)
+	if !found {
+		return
+	}
+	event.SetExtension("totalrestarts", total)
+	if old != nil {
+		previous, _ := totalRestarts(old)
+		event.SetExtension("newrestarts", total-previous)
+	}
+}
+
+func totalRestarts(obj *unstructured.Unstructured) (int64, bool) {
+	statuses, found, err := unstructured.NestedSlice(obj.Object, "status", "containerStatuses")
+	if !found || err != nil {
+		return 0, false
+	}
+	var total int64
+	for _, s := range statuses {
+		status, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if count, found, err := unstructured.NestedInt64(status, "restartCount"); found && err == nil {
+			total += count
+		}
+	}
+	return total, true
+}
+
+// setVolumeExtensions sets the number of volumes attached to the Node and in
+// use by its Pods.
+func setVolumeExtensions(event *cloudevents.Event, obj *unstructured.Unstructured) {
+	attached, _, _ := unstructured.NestedSlice(obj.Object, "status", "volumesAttached")
+	inUse, _, _ := unstructured.NestedStringSlice(obj.Object, "status", "volumesInUse")
+	event.SetExtension("volumesattached", len(attached))
+	event.SetExtension("volumesinuse", len(inUse))
+}
+
+// setAnnotationChangeExtensions sets the keys of the annotations added to, and
+// of the annotations whose value changed on, the object since its previous
+// state. Only the keys are set, the values may hold secrets.
+func setAnnotationChangeExtensions(event *cloudevents.Event, obj, old *unstructured.Unstructured) {
+	previous := old.GetAnnotations()
+	var added, changed []string
+	for k, v := range obj.GetAnnotations() {
+		if p, ok := previous[k]; !ok {
+			added = append(added, k)
+		} else if p != v {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(changed)
+	event.SetExtension("addedannotations", strings.Join(added, ","))
+	event.SetExtension("changedannotations", strings.Join(changed, ","))
+}
+
+func isIngress(obj *unstructured.Unstructured) bool {
+	if obj == nil || obj.GetKind() != "Ingress" {
+		return false
+	}
+	group := obj.GroupVersionKind().Group
+	return group == "networking.k8s.io" || group == "extensions"
+}
+
+func isCoreKind(obj *unstructured.Unstructured, kind string) bool {
+	return obj.GetAPIVersion() == "v1" && obj.GetKind() == kind
+}
+
+// setPeerAuthenticationExtensions sets the mTLS mode of the namespace wide
+// Istio PeerAuthentication policy, which by convention is named "default".
+func setPeerAuthenticationExtensions(event *cloudevents.Event, lister cache.GenericLister, namespace string) {
+	policy, err := lister.ByNamespace(namespace).Get(peerAuthenticationDefaultName)
+	if err != nil {
+		event.SetExtension("istiopeerauthexists", "false")
+		return
+	}
+	event.SetExtension("istiopeerauthexists", "true")
+
+	mode := peerAuthenticationModeUnset
+	if u, ok := policy.(*unstructured.Unstructured); ok {
+		if m, found, _ := unstructured.NestedString(u.Object, "spec", "mtls", "mode"); found && m != "" {
+			mode = m
+		}
+	}
+	event.SetExtension("istiopeerauth", mode)
+}
+
+// selfLinkSubject is the SubjectFunc of the events by default.
+func selfLinkSubject(o corev1.ObjectReference) (string, error) {
+	return createSelfLink(o), nil
+}
+
 // Creates a URI of the form found in object metadata selfLinks
 // Format looks like: /apis/feeds.knative.dev/v1alpha1/namespaces/default/feeds/k8s-events-example
 // KNOWN ISSUES:
@@ -153,7 +908,7 @@ func makeEvent(source, apiServerSourceName, eventType string, obj *unstructured.
 // * ObjectReference does not have enough information to create the pluaralized list type (e.g. "revisions" from kind: Revision)
 //
 // Track these issues at https://github.com/kubernetes/kubernetes/issues/66313
-// We could possibly work around this by adding a lister for the resources referenced by these events.
+// A SubjectResolver resolves the accurate paths from the discovery of the API server.
 func createSelfLink(o corev1.ObjectReference) string {
 	gvr, _ := meta.UnsafeGuessKindToResource(o.GroupVersionKind())
 	versionNameHack := o.APIVersion
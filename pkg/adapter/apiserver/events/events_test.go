@@ -17,16 +17,28 @@ limitations under the License.
 package events_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cecontext "github.com/cloudevents/sdk-go/v2/context"
+	"github.com/google/cel-go/cel"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 
 	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/sources"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/observability"
 )
 
 var contentType = "application/json"
@@ -75,9 +87,11 @@ func TestMakeAddEvent(t *testing.T) {
 					Subject:         simpleSubject("unit", "test"),
 					DataContentType: &contentType,
 					Extensions: map[string]interface{}{
-						"kind":      "Pod",
-						"name":      "unit",
-						"namespace": "test",
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
 					},
 				}.AsV1(),
 			},
@@ -116,9 +130,11 @@ func TestMakeUpdateEvent(t *testing.T) {
 					Subject:         simpleSubject("unit", "test"),
 					DataContentType: &contentType,
 					Extensions: map[string]interface{}{
-						"kind":      "Pod",
-						"name":      "unit",
-						"namespace": "test",
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
 					},
 				}.AsV1(),
 			},
@@ -157,14 +173,42 @@ func TestMakeDeleteEvent(t *testing.T) {
 					Subject:         simpleSubject("unit", "test"),
 					DataContentType: &contentType,
 					Extensions: map[string]interface{}{
-						"kind":      "Pod",
-						"name":      "unit",
-						"namespace": "test",
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
+					},
+				}.AsV1(),
+			},
+			wantData: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"unit","namespace":"test"}}`,
+		},
+		"final state unknown": {
+			source: "unit-test",
+			obj:    cache.DeletedFinalStateUnknown{Key: "test/unit", Obj: simplePod("unit", "test")},
+			want: &cloudevents.Event{
+				Context: cloudevents.EventContextV1{
+					Type:            "dev.knative.apiserver.resource.delete",
+					Source:          *cloudevents.ParseURIRef("unit-test"),
+					Subject:         simpleSubject("unit", "test"),
+					DataContentType: &contentType,
+					Extensions: map[string]interface{}{
+						"kind":              "Pod",
+						"name":              "unit",
+						"namespace":         "test",
+						"apigroup":          "v1",
+						"apiversion":        "",
+						"finalstateunknown": true,
 					},
 				}.AsV1(),
 			},
 			wantData: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"unit","namespace":"test"}}`,
 		},
+		"final state unknown without state": {
+			source:  "unit-test",
+			obj:     cache.DeletedFinalStateUnknown{Key: "test/unit"},
+			wantErr: "the last known state of the deleted resource is unknown",
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
@@ -198,9 +242,11 @@ func TestMakeAddRefEvent(t *testing.T) {
 					Subject:         simpleSubject("unit", "test"),
 					DataContentType: &contentType,
 					Extensions: map[string]interface{}{
-						"kind":      "Pod",
-						"name":      "unit",
-						"namespace": "test",
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
 					},
 				}.AsV1(),
 			},
@@ -239,9 +285,11 @@ func TestMakeUpdateRefEvent(t *testing.T) {
 					Subject:         simpleSubject("unit", "test"),
 					DataContentType: &contentType,
 					Extensions: map[string]interface{}{
-						"kind":      "Pod",
-						"name":      "unit",
-						"namespace": "test",
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
 					},
 				}.AsV1(),
 			},
@@ -280,9 +328,11 @@ func TestMakeDeleteRefEvent(t *testing.T) {
 					Subject:         simpleSubject("unit", "test"),
 					DataContentType: &contentType,
 					Extensions: map[string]interface{}{
-						"kind":      "Pod",
-						"name":      "unit",
-						"namespace": "test",
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
 					},
 				}.AsV1(),
 			},
@@ -297,6 +347,1135 @@ func TestMakeDeleteRefEvent(t *testing.T) {
 	}
 }
 
+func TestMakeSyncEvent(t *testing.T) {
+	testCases := map[string]struct {
+		obj    interface{}
+		source string
+		ref    bool
+
+		want     *cloudevents.Event
+		wantData string
+		wantErr  string
+	}{
+		"nil object": {
+			source:  "unit-test",
+			want:    nil,
+			wantErr: "resource can not be nil",
+		},
+		"simple pod": {
+			source: "unit-test",
+			obj:    simplePod("unit", "test"),
+			want: &cloudevents.Event{
+				Context: cloudevents.EventContextV1{
+					Type:            "dev.knative.apiserver.resource.sync",
+					Source:          *cloudevents.ParseURIRef("unit-test"),
+					Subject:         simpleSubject("unit", "test"),
+					DataContentType: &contentType,
+					Extensions: map[string]interface{}{
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
+					},
+				}.AsV1(),
+			},
+			wantData: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"unit","namespace":"test"}}`,
+		},
+		"simple pod ref": {
+			source: "unit-test",
+			obj:    simplePod("unit", "test"),
+			ref:    true,
+			want: &cloudevents.Event{
+				Context: cloudevents.EventContextV1{
+					Type:            "dev.knative.apiserver.ref.sync",
+					Source:          *cloudevents.ParseURIRef("unit-test"),
+					Subject:         simpleSubject("unit", "test"),
+					DataContentType: &contentType,
+					Extensions: map[string]interface{}{
+						"kind":       "Pod",
+						"name":       "unit",
+						"namespace":  "test",
+						"apigroup":   "v1",
+						"apiversion": "",
+					},
+				}.AsV1(),
+			},
+			wantData: `{"kind":"Pod","namespace":"test","name":"unit","apiVersion":"v1"}`,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, got, err := events.MakeSyncEvent(tc.source, apiServerSourceNameTest, tc.obj, tc.ref)
+			validate(t, got, err, tc.want, tc.wantData, tc.wantErr)
+		})
+	}
+}
+
+func simpleNamespace(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+		},
+	}
+}
+
+func simplePeerAuthentication(namespace, name, mode string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "security.istio.io/v1beta1",
+			"kind":       "PeerAuthentication",
+			"metadata": map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+			},
+		},
+	}
+	if mode != "" {
+		_ = unstructured.SetNestedField(u.Object, mode, "spec", "mtls", "mode")
+	}
+	return u
+}
+
+func TestMakeEventPeerAuthentication(t *testing.T) {
+	testCases := map[string]struct {
+		obj      interface{}
+		policies []*unstructured.Unstructured
+		noLister bool
+
+		wantExists string
+		wantMode   string
+	}{
+		"no lister": {
+			obj:      simpleNamespace("test"),
+			noLister: true,
+		},
+		"not a namespace": {
+			obj:      simplePod("unit", "test"),
+			policies: []*unstructured.Unstructured{simplePeerAuthentication("unit", "default", "STRICT")},
+		},
+		"no policy": {
+			obj:        simpleNamespace("test"),
+			policies:   []*unstructured.Unstructured{simplePeerAuthentication("other", "default", "STRICT")},
+			wantExists: "false",
+		},
+		"policy not named default": {
+			obj:        simpleNamespace("test"),
+			policies:   []*unstructured.Unstructured{simplePeerAuthentication("test", "workload", "STRICT")},
+			wantExists: "false",
+		},
+		"strict policy": {
+			obj:        simpleNamespace("test"),
+			policies:   []*unstructured.Unstructured{simplePeerAuthentication("test", "default", "STRICT")},
+			wantExists: "true",
+			wantMode:   "STRICT",
+		},
+		"permissive policy": {
+			obj:        simpleNamespace("test"),
+			policies:   []*unstructured.Unstructured{simplePeerAuthentication("test", "default", "PERMISSIVE")},
+			wantExists: "true",
+			wantMode:   "PERMISSIVE",
+		},
+		"policy without mode": {
+			obj:        simpleNamespace("test"),
+			policies:   []*unstructured.Unstructured{simplePeerAuthentication("test", "default", "")},
+			wantExists: "true",
+			wantMode:   "UNSET",
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			var opts []events.EventOption
+			if !tc.noLister {
+				indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+				for _, p := range tc.policies {
+					if err := indexer.Add(p); err != nil {
+						t.Fatal("failed to add policy:", err)
+					}
+				}
+				gr := schema.GroupResource{Group: "security.istio.io", Resource: "peerauthentications"}
+				opts = append(opts, events.WithPeerAuthenticationLister(cache.NewGenericLister(indexer, gr)))
+			}
+
+			_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, tc.obj, false, opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantExists, stringExtension(exts, "istiopeerauthexists")); diff != "" {
+				t.Error("unexpected istiopeerauthexists (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantMode, stringExtension(exts, "istiopeerauth")); diff != "" {
+				t.Error("unexpected istiopeerauth (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeEventGeneration(t *testing.T) {
+	testCases := map[string]struct {
+		generation int64
+		status     map[string]interface{}
+
+		wantObserved interface{}
+		wantLag      interface{}
+	}{
+		"no status": {
+			generation: 2,
+		},
+		"status without observed generation": {
+			generation: 2,
+			status:     map[string]interface{}{"phase": "Running"},
+		},
+		"up to date": {
+			generation:   3,
+			status:       map[string]interface{}{"observedGeneration": int64(3)},
+			wantObserved: int32(3),
+			wantLag:      int32(0),
+		},
+		"lagging": {
+			generation:   5,
+			status:       map[string]interface{}{"observedGeneration": int64(3)},
+			wantObserved: int32(3),
+			wantLag:      int32(2),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			obj := simplePod("unit", "test")
+			obj.SetGeneration(tc.generation)
+			if tc.status != nil {
+				obj.Object["status"] = tc.status
+			}
+
+			_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, obj, false)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantObserved, exts["observedgeneration"]); diff != "" {
+				t.Error("unexpected observedgeneration (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantLag, exts["generationlag"]); diff != "" {
+				t.Error("unexpected generationlag (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeEventExternalName(t *testing.T) {
+	service := func(spec map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"namespace": "test",
+					"name":      "unit",
+				},
+				"spec": spec,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		obj interface{}
+
+		wantExternalName string
+		wantIsExternal   string
+	}{
+		"cluster ip service": {
+			obj: service(map[string]interface{}{"type": "ClusterIP"}),
+		},
+		"external name service": {
+			obj:              service(map[string]interface{}{"type": "ExternalName", "externalName": "db.example.com"}),
+			wantExternalName: "db.example.com",
+			wantIsExternal:   "true",
+		},
+		"not a service": {
+			obj: simplePod("unit", "test"),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, tc.obj, false)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantExternalName, stringExtension(exts, "externalname")); diff != "" {
+				t.Error("unexpected externalname (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantIsExternal, stringExtension(exts, "isexternal")); diff != "" {
+				t.Error("unexpected isexternal (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeEventNetworkAttachments(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+
+		wantCount       interface{}
+		wantAttachments interface{}
+	}{
+		"no annotation": {},
+		"invalid annotation": {
+			annotations: map[string]string{"k8s.v1.cni.cncf.io/networks-status": "not json"},
+		},
+		"secondary networks": {
+			annotations: map[string]string{"k8s.v1.cni.cncf.io/networks-status": `[
+				{"name": "cbr0", "ips": ["10.244.1.73"], "default": true},
+				{"name": "default/macvlan-conf", "interface": "net1", "ips": ["192.168.1.200"]},
+				{"name": "default/sriov-conf", "interface": "net2"}
+			]`},
+			wantCount:       int32(3),
+			wantAttachments: "cbr0,net1,net2",
+		},
+		"no networks": {
+			annotations:     map[string]string{"k8s.v1.cni.cncf.io/networks-status": "[]"},
+			wantCount:       int32(0),
+			wantAttachments: "",
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			obj := simplePod("unit", "test")
+			obj.SetAnnotations(tc.annotations)
+
+			_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, obj, false)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantCount, exts["networkattachmentcount"]); diff != "" {
+				t.Error("unexpected networkattachmentcount (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantAttachments, exts["networkattachments"]); diff != "" {
+				t.Error("unexpected networkattachments (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeUpdateEventRestarts(t *testing.T) {
+	pod := func(restarts ...int64) *unstructured.Unstructured {
+		statuses := make([]interface{}, 0, len(restarts))
+		for _, r := range restarts {
+			statuses = append(statuses, map[string]interface{}{"restartCount": r})
+		}
+		obj := simplePod("unit", "test")
+		obj.Object["status"] = map[string]interface{}{"containerStatuses": statuses}
+		return obj
+	}
+
+	testCases := map[string]struct {
+		obj interface{}
+		old *unstructured.Unstructured
+
+		wantTotal interface{}
+		wantNew   interface{}
+	}{
+		"unknown previous state": {
+			obj:       pod(1, 2),
+			wantTotal: int32(3),
+		},
+		"new restarts": {
+			obj:       pod(2, 4),
+			old:       pod(1, 2),
+			wantTotal: int32(6),
+			wantNew:   int32(3),
+		},
+		"no new restarts": {
+			obj:       pod(1, 2),
+			old:       pod(1, 2),
+			wantTotal: int32(3),
+			wantNew:   int32(0),
+		},
+		"no container statuses": {
+			obj: simplePod("unit", "test"),
+			old: simplePod("unit", "test"),
+		},
+		"first container statuses": {
+			obj:       pod(0),
+			old:       simplePod("unit", "test"),
+			wantTotal: int32(0),
+			wantNew:   int32(0),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			var opts []events.EventOption
+			if tc.old != nil {
+				opts = append(opts, events.WithOldObject(tc.old))
+			}
+			_, got, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, tc.obj, false, opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantTotal, exts["totalrestarts"]); diff != "" {
+				t.Error("unexpected totalrestarts (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantNew, exts["newrestarts"]); diff != "" {
+				t.Error("unexpected newrestarts (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeAddEventNoRestarts(t *testing.T) {
+	_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), false)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if _, ok := got.Extensions()["totalrestarts"]; ok {
+		t.Error("unexpected totalrestarts extension on add event")
+	}
+}
+
+func TestMakeEventVolumes(t *testing.T) {
+	node := func(status map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Node",
+				"metadata": map[string]interface{}{
+					"name": "node-1",
+				},
+				"status": status,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		obj interface{}
+
+		wantAttached interface{}
+		wantInUse    interface{}
+	}{
+		"no volumes": {
+			obj:          node(map[string]interface{}{}),
+			wantAttached: int32(0),
+			wantInUse:    int32(0),
+		},
+		"volumes": {
+			obj: node(map[string]interface{}{
+				"volumesAttached": []interface{}{
+					map[string]interface{}{"name": "kubernetes.io/csi/ebs.csi.aws.com^vol-1", "devicePath": ""},
+					map[string]interface{}{"name": "kubernetes.io/csi/ebs.csi.aws.com^vol-2", "devicePath": ""},
+				},
+				"volumesInUse": []interface{}{"kubernetes.io/csi/ebs.csi.aws.com^vol-1"},
+			}),
+			wantAttached: int32(2),
+			wantInUse:    int32(1),
+		},
+		"not a node": {
+			obj: simplePod("unit", "test"),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, tc.obj, false)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantAttached, exts["volumesattached"]); diff != "" {
+				t.Error("unexpected volumesattached (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantInUse, exts["volumesinuse"]); diff != "" {
+				t.Error("unexpected volumesinuse (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeUpdateEventAnnotationChanges(t *testing.T) {
+	ingress := func(annotations map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "Ingress",
+				"metadata": map[string]interface{}{
+					"name":        "web",
+					"namespace":   "test",
+					"annotations": annotations,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		obj interface{}
+		old *unstructured.Unstructured
+
+		wantAdded   interface{}
+		wantChanged interface{}
+	}{
+		"added and changed": {
+			obj: ingress(map[string]interface{}{
+				"nginx.ingress.kubernetes.io/rewrite-target": "/v2",
+				"nginx.ingress.kubernetes.io/ssl-redirect":   "true",
+				"nginx.ingress.kubernetes.io/auth-secret":    "basic-auth",
+				"team": "web",
+			}),
+			old: ingress(map[string]interface{}{
+				"nginx.ingress.kubernetes.io/rewrite-target": "/",
+				"team": "web",
+			}),
+			wantAdded:   "nginx.ingress.kubernetes.io/auth-secret,nginx.ingress.kubernetes.io/ssl-redirect",
+			wantChanged: "nginx.ingress.kubernetes.io/rewrite-target",
+		},
+		"removed only": {
+			obj:         ingress(nil),
+			old:         ingress(map[string]interface{}{"team": "web"}),
+			wantAdded:   "",
+			wantChanged: "",
+		},
+		"unknown previous state": {
+			obj: ingress(map[string]interface{}{"team": "web"}),
+		},
+		"not an ingress": {
+			obj: simplePod("unit", "test"),
+			old: simplePod("unit", "test"),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			var opts []events.EventOption
+			if tc.old != nil {
+				opts = append(opts, events.WithOldObject(tc.old))
+			}
+			_, got, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, tc.obj, false, opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantAdded, exts["addedannotations"]); diff != "" {
+				t.Error("unexpected addedannotations (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantChanged, exts["changedannotations"]); diff != "" {
+				t.Error("unexpected changedannotations (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeEventAPIGroup(t *testing.T) {
+	testCases := map[string]struct {
+		apiVersion string
+
+		wantGroup   interface{}
+		wantVersion interface{}
+	}{
+		"core": {
+			apiVersion:  "v1",
+			wantGroup:   "v1",
+			wantVersion: "",
+		},
+		"custom resource": {
+			apiVersion:  "serving.knative.dev/v1",
+			wantGroup:   "serving.knative.dev",
+			wantVersion: "v1",
+		},
+		"custom resource prerelease": {
+			apiVersion:  "sources.knative.dev/v1beta2",
+			wantGroup:   "sources.knative.dev",
+			wantVersion: "v1beta2",
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": tc.apiVersion,
+					"kind":       "Service",
+					"metadata": map[string]interface{}{
+						"name":      "unit",
+						"namespace": "test",
+					},
+				},
+			}
+			_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, obj, false)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			exts := got.Extensions()
+			if diff := cmp.Diff(tc.wantGroup, exts["apigroup"]); diff != "" {
+				t.Error("unexpected apigroup (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantVersion, exts["apiversion"]); diff != "" {
+				t.Error("unexpected apiversion (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeUpdateEventDiff(t *testing.T) {
+	pod := func(resourceVersion, image string) *unstructured.Unstructured {
+		obj := simplePod("unit", "test")
+		obj.SetResourceVersion(resourceVersion)
+		obj.Object["spec"] = map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": image},
+			},
+		}
+		return obj
+	}
+
+	testCases := map[string]struct {
+		old  *unstructured.Unstructured
+		opts []events.EventOption
+		ref  bool
+
+		wantType    string
+		wantPatch   []jsonpatch.Operation
+		wantVersion interface{}
+	}{
+		"diff": {
+			old:      pod("1", "app:v1"),
+			opts:     []events.EventOption{events.WithDiff()},
+			wantType: sources.ApiServerSourceUpdateDiffEventType,
+			wantPatch: []jsonpatch.Operation{
+				jsonpatch.NewOperation("replace", "/metadata/resourceVersion", "2"),
+				jsonpatch.NewOperation("replace", "/spec/containers/0/image", "app:v2"),
+			},
+			wantVersion: "1",
+		},
+		"unknown previous state": {
+			opts:     []events.EventOption{events.WithDiff()},
+			wantType: sources.ApiServerSourceUpdateEventType,
+		},
+		"reference": {
+			old:      pod("1", "app:v1"),
+			opts:     []events.EventOption{events.WithDiff()},
+			ref:      true,
+			wantType: sources.ApiServerSourceUpdateRefEventType,
+		},
+		"not diff": {
+			old:      pod("1", "app:v1"),
+			wantType: sources.ApiServerSourceUpdateEventType,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			opts := tc.opts
+			if tc.old != nil {
+				opts = append(opts, events.WithOldObject(tc.old))
+			}
+			_, got, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, pod("2", "app:v2"), tc.ref, opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			if got.Type() != tc.wantType {
+				t.Errorf("unexpected type, want %q, got %q", tc.wantType, got.Type())
+			}
+			if diff := cmp.Diff(tc.wantVersion, got.Extensions()["previousresourceversion"]); diff != "" {
+				t.Error("unexpected previousresourceversion (-want, +got) =", diff)
+			}
+			if tc.wantPatch == nil {
+				return
+			}
+			var patch []jsonpatch.Operation
+			if err := json.Unmarshal(got.Data(), &patch); err != nil {
+				t.Fatal("failed to unmarshal the patch:", err)
+			}
+			byPath := func(a, b jsonpatch.Operation) bool { return a.Path < b.Path }
+			if diff := cmp.Diff(tc.wantPatch, patch, cmpopts.SortSlices(byPath)); diff != "" {
+				t.Error("unexpected patch (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeUpdateEventDiffEnrichments(t *testing.T) {
+	pod := func(resourceVersion string, restarts int64) *unstructured.Unstructured {
+		obj := simplePod("unit", "test")
+		obj.SetResourceVersion(resourceVersion)
+		obj.Object["status"] = map[string]interface{}{
+			"containerStatuses": []interface{}{map[string]interface{}{"restartCount": restarts}},
+		}
+		return obj
+	}
+	ingress := func(resourceVersion string, annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "Ingress",
+				"metadata": map[string]interface{}{
+					"name":      "web",
+					"namespace": "test",
+				},
+			},
+		}
+		obj.SetResourceVersion(resourceVersion)
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+
+	tests := map[string]struct {
+		old, obj *unstructured.Unstructured
+		wantExts map[string]interface{}
+	}{
+		"pod restart": {
+			old:      pod("1", 1),
+			obj:      pod("2", 3),
+			wantExts: map[string]interface{}{"totalrestarts": int32(3), "newrestarts": int32(2)},
+		},
+		"ingress annotation change": {
+			old:      ingress("1", map[string]string{"team": "web"}),
+			obj:      ingress("2", map[string]string{"team": "api", "owner": "unit"}),
+			wantExts: map[string]interface{}{"addedannotations": "owner", "changedannotations": "team"},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, tc.obj, false, events.WithDiff(), events.WithOldObject(tc.old))
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := event.Type(); got != sources.ApiServerSourceUpdateDiffEventType {
+				t.Errorf("Type() = %q, want %q", got, sources.ApiServerSourceUpdateDiffEventType)
+			}
+			for name, want := range tc.wantExts {
+				if diff := cmp.Diff(want, event.Extensions()[name]); diff != "" {
+					t.Errorf("unexpected %s (-want, +got) = %v", name, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMakeEventFieldsToDrop(t *testing.T) {
+	secret := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata": map[string]interface{}{
+					"name":      "db",
+					"namespace": "test",
+					"annotations": map[string]interface{}{
+						"kubectl.kubernetes.io/last-applied-configuration": "{}",
+						"team": "payments",
+					},
+					"managedFields": []interface{}{
+						map[string]interface{}{"manager": "kubectl"},
+					},
+				},
+				"data": map[string]interface{}{
+					"password": "c2VjcmV0",
+				},
+			},
+		}
+	}
+	paths := [][]string{
+		{"metadata", "managedFields"},
+		{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+		{"data", "*"},
+	}
+	wantData := `{"apiVersion":"v1","data":{},"kind":"Secret","metadata":{"annotations":{"team":"payments"},"name":"db","namespace":"test"}}`
+
+	obj := secret()
+	_, got, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, obj, false, events.WithFieldsToDrop(paths))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if diff := cmp.Diff(wantData, string(got.Data())); diff != "" {
+		t.Error("unexpected data diff (-want, +got) =", diff)
+	}
+	if diff := cmp.Diff(secret(), obj); diff != "" {
+		t.Error("unexpected change of the object (-want, +got) =", diff)
+	}
+
+	_, got, err = events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, secret(), false, events.WithFieldsToDrop(paths))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if diff := cmp.Diff(wantData, string(got.Data())); diff != "" {
+		t.Error("unexpected update data diff (-want, +got) =", diff)
+	}
+
+	// The dropped fields are left out of the patches too.
+	old, updated := secret(), secret()
+	updated.Object["data"] = map[string]interface{}{"password": "bmV3"}
+	_, got, err = events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, updated, false,
+		events.WithFieldsToDrop(paths), events.WithDiff(), events.WithOldObject(old))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if diff := cmp.Diff("[]", string(got.Data())); diff != "" {
+		t.Error("unexpected patch (-want, +got) =", diff)
+	}
+}
+
+func TestMakeEventFieldsToDropLists(t *testing.T) {
+	pod := simplePod("unit", "test")
+	pod.Object["spec"] = map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{"name": "app", "env": []interface{}{map[string]interface{}{"name": "TOKEN", "value": "secret"}}},
+			map[string]interface{}{"name": "sidecar"},
+		},
+	}
+
+	_, got, err := events.MakeDeleteEvent("unit-test", apiServerSourceNameTest, pod, false,
+		events.WithFieldsToDrop([][]string{{"spec", "containers", "env"}}))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	want := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"unit","namespace":"test"},"spec":{"containers":[{"name":"app"},{"name":"sidecar"}]}}`
+	if diff := cmp.Diff(want, string(got.Data())); diff != "" {
+		t.Error("unexpected data diff (-want, +got) =", diff)
+	}
+}
+
+func TestMakeEventRetries(t *testing.T) {
+	tests := map[string]struct {
+		opts []events.EventOption
+		want cecontext.RetryParams
+	}{
+		"default": {
+			want: cecontext.RetryParams{Strategy: cecontext.BackoffStrategyExponential, MaxTries: 5, Period: 50 * time.Millisecond},
+		},
+		"exponential": {
+			opts: []events.EventOption{events.WithRetries(3, duckv1.BackoffPolicyExponential, time.Second)},
+			want: cecontext.RetryParams{Strategy: cecontext.BackoffStrategyExponential, MaxTries: 3, Period: time.Second},
+		},
+		"linear": {
+			opts: []events.EventOption{events.WithRetries(2, duckv1.BackoffPolicyLinear, 10*time.Millisecond)},
+			want: cecontext.RetryParams{Strategy: cecontext.BackoffStrategyLinear, MaxTries: 2, Period: 10 * time.Millisecond},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			ctx, _, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), false, tc.opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if diff := cmp.Diff(tc.want, *cecontext.RetriesFrom(ctx), cmpopts.IgnoreUnexported(cecontext.RetryParams{})); diff != "" {
+				t.Error("unexpected retries diff (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestMakeEventOwner(t *testing.T) {
+	owner := &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Namespace: "test"}
+	const want = "/apis/apps/v1/namespaces/test/deployments/app"
+
+	_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), false, events.WithOwner(owner))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got := event.Subject(); got != want {
+		t.Errorf("Subject() = %q, want %q", got, want)
+	}
+	if got := stringExtension(event.Extensions(), "owner"); got != want {
+		t.Errorf("owner extension = %q, want %q", got, want)
+	}
+	if got := stringExtension(event.Extensions(), "name"); got != "unit" {
+		t.Errorf("name extension = %q, want %q", got, "unit")
+	}
+
+	_, event, err = events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), false)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got, ok := event.Extensions()["owner"]; ok {
+		t.Errorf("Unexpected owner extension %v without owner", got)
+	}
+}
+
+func TestMakeEventExtensionExpressions(t *testing.T) {
+	pod := simplePod("unit", "test")
+	pod.SetLabels(map[string]string{"app": "web"})
+	_ = unstructured.SetNestedField(pod.Object, "Running", "status", "phase")
+	_ = unstructured.SetNestedField(pod.Object, int64(2), "spec", "priority")
+	_ = unstructured.SetNestedSlice(pod.Object, []interface{}{
+		map[string]interface{}{"type": "Ready", "status": "True"},
+	}, "status", "conditions")
+
+	extensions := make(map[string]cel.Program)
+	for name, expr := range map[string]string{
+		"app":       "labels['app']",
+		"phase":     "status.phase",
+		"priority":  "spec.priority",
+		"condition": "status.conditions[0].type",
+		"ready":     "status.conditions.exists(c, c.type == 'Ready' && c.status == 'True')",
+		"missing":   "status.conditions[1].type",
+		"status":    "status",
+	} {
+		program, err := sourcesv1.CompileExtensionExpression(expr)
+		if err != nil {
+			t.Fatalf("CompileExtensionExpression(%q) = %v", expr, err)
+		}
+		extensions[name] = program
+	}
+
+	_, event, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, pod, false, events.WithExtensionExpressions(extensions))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	want := map[string]string{
+		"app":       "web",
+		"phase":     "Running",
+		"priority":  "2",
+		"condition": "Ready",
+		"ready":     "true",
+	}
+	for name, value := range want {
+		if got := stringExtension(event.Extensions(), name); got != value {
+			t.Errorf("%s extension = %q, want %q", name, got, value)
+		}
+	}
+	for _, name := range []string{"missing", "status"} {
+		if got, ok := event.Extensions()[name]; ok {
+			t.Errorf("Unexpected %s extension %v", name, got)
+		}
+	}
+}
+
+func TestMakeEventPartitionKey(t *testing.T) {
+	tests := map[string]struct {
+		obj  *unstructured.Unstructured
+		opts []events.EventOption
+		want string
+	}{
+		"namespaced": {
+			obj:  simplePod("unit", "test"),
+			opts: []events.EventOption{events.WithPartitionKey()},
+			want: "test/unit",
+		},
+		"cluster scoped": {
+			obj:  simpleNamespace("unit"),
+			opts: []events.EventOption{events.WithPartitionKey()},
+			want: "unit",
+		},
+		"not set": {
+			obj: simplePod("unit", "test"),
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, event, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, tc.obj, false, tc.opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := stringExtension(event.Extensions(), "partitionkey"); got != tc.want {
+				t.Errorf("partitionkey extension = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMakeEventCluster(t *testing.T) {
+	tests := map[string]struct {
+		opts []events.EventOption
+		want string
+	}{
+		"remote cluster": {
+			opts: []events.EventOption{events.WithCluster("remote")},
+			want: "remote",
+		},
+		"not set": {},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, event, err := events.MakeDeleteEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), false, tc.opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := stringExtension(event.Extensions(), "cluster"); got != tc.want {
+				t.Errorf("cluster extension = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMakeUpdateEventClassification(t *testing.T) {
+	withPhase := func(resourceVersion, phase string) *unstructured.Unstructured {
+		pod := simplePod("unit", "test")
+		pod.SetResourceVersion(resourceVersion)
+		_ = unstructured.SetNestedField(pod.Object, phase, "status", "phase")
+		return pod
+	}
+	withNode := func(pod *unstructured.Unstructured, node string) *unstructured.Unstructured {
+		_ = unstructured.SetNestedField(pod.Object, node, "spec", "nodeName")
+		return pod
+	}
+
+	tests := map[string]struct {
+		old, obj    *unstructured.Unstructured
+		ref         bool
+		wantType    string
+		wantSection string
+	}{
+		"status only": {
+			old:         withPhase("1", "Pending"),
+			obj:         withPhase("2", "Running"),
+			wantType:    sources.ApiServerSourceUpdateStatusEventType,
+			wantSection: "status",
+		},
+		"status only ref": {
+			old:         withPhase("1", "Pending"),
+			obj:         withPhase("2", "Running"),
+			ref:         true,
+			wantType:    sources.ApiServerSourceUpdateStatusRefEventType,
+			wantSection: "status",
+		},
+		"spec only": {
+			old:         withNode(withPhase("1", "Pending"), "a"),
+			obj:         withNode(withPhase("2", "Pending"), "b"),
+			wantType:    sources.ApiServerSourceUpdateSpecEventType,
+			wantSection: "spec",
+		},
+		"spec and status": {
+			old:         withPhase("1", "Pending"),
+			obj:         withNode(withPhase("2", "Running"), "b"),
+			wantType:    sources.ApiServerSourceUpdateEventType,
+			wantSection: "spec,status",
+		},
+		"metadata": {
+			old: withPhase("1", "Pending"),
+			obj: func() *unstructured.Unstructured {
+				pod := withPhase("2", "Pending")
+				pod.SetLabels(map[string]string{"app": "web"})
+				return pod
+			}(),
+			wantType:    sources.ApiServerSourceUpdateEventType,
+			wantSection: "metadata",
+		},
+		"no previous state": {
+			obj:      withPhase("2", "Running"),
+			wantType: sources.ApiServerSourceUpdateEventType,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			opts := []events.EventOption{events.WithUpdateClassification()}
+			if tc.old != nil {
+				opts = append(opts, events.WithOldObject(tc.old))
+			}
+			_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, tc.obj, tc.ref, opts...)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := event.Type(); got != tc.wantType {
+				t.Errorf("Type() = %q, want %q", got, tc.wantType)
+			}
+			if got := stringExtension(event.Extensions(), "changedsection"); got != tc.wantSection {
+				t.Errorf("changedsection extension = %q, want %q", got, tc.wantSection)
+			}
+		})
+	}
+
+	_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, withPhase("2", "Running"), false, events.WithOldObject(withPhase("1", "Pending")))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got := event.Type(); got != sources.ApiServerSourceUpdateEventType {
+		t.Errorf("Type() = %q without classification, want %q", got, sources.ApiServerSourceUpdateEventType)
+	}
+}
+
+func TestMakeUpdateEventClassificationEnrichments(t *testing.T) {
+	pod := func(resourceVersion string, restarts int64) *unstructured.Unstructured {
+		obj := simplePod("unit", "test")
+		obj.SetResourceVersion(resourceVersion)
+		obj.Object["status"] = map[string]interface{}{
+			"containerStatuses": []interface{}{map[string]interface{}{"restartCount": restarts}},
+		}
+		return obj
+	}
+	ingress := func(resourceVersion string, annotations map[string]string, ip string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "Ingress",
+				"metadata": map[string]interface{}{
+					"name":      "web",
+					"namespace": "test",
+				},
+			},
+		}
+		obj.SetResourceVersion(resourceVersion)
+		obj.SetAnnotations(annotations)
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{"ip": ip}}, "status", "loadBalancer", "ingress")
+		return obj
+	}
+
+	tests := map[string]struct {
+		old, obj *unstructured.Unstructured
+		ref      bool
+		wantType string
+		wantExts map[string]interface{}
+	}{
+		"pod restart": {
+			old:      pod("1", 1),
+			obj:      pod("2", 3),
+			wantType: sources.ApiServerSourceUpdateStatusEventType,
+			wantExts: map[string]interface{}{"totalrestarts": int32(3), "newrestarts": int32(2)},
+		},
+		"pod restart ref": {
+			old:      pod("1", 1),
+			obj:      pod("2", 3),
+			ref:      true,
+			wantType: sources.ApiServerSourceUpdateStatusRefEventType,
+			wantExts: map[string]interface{}{"totalrestarts": int32(3), "newrestarts": int32(2)},
+		},
+		"ingress status": {
+			old:      ingress("1", map[string]string{"team": "web"}, "10.0.0.1"),
+			obj:      ingress("2", map[string]string{"team": "web"}, "10.0.0.2"),
+			wantType: sources.ApiServerSourceUpdateStatusEventType,
+			wantExts: map[string]interface{}{"addedannotations": "", "changedannotations": ""},
+		},
+		"ingress annotation change": {
+			old:      ingress("1", map[string]string{"team": "web"}, "10.0.0.1"),
+			obj:      ingress("2", map[string]string{"team": "api", "owner": "unit"}, "10.0.0.1"),
+			wantType: sources.ApiServerSourceUpdateEventType,
+			wantExts: map[string]interface{}{"addedannotations": "owner", "changedannotations": "team"},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, tc.obj, tc.ref, events.WithUpdateClassification(), events.WithOldObject(tc.old))
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := event.Type(); got != tc.wantType {
+				t.Errorf("Type() = %q, want %q", got, tc.wantType)
+			}
+			for name, want := range tc.wantExts {
+				if diff := cmp.Diff(want, event.Extensions()[name]); diff != "" {
+					t.Errorf("unexpected %s (-want, +got) = %v", name, diff)
+				}
+			}
+		})
+	}
+}
+
+func stringExtension(exts map[string]interface{}, name string) string {
+	if v, ok := exts[name]; ok {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
 func validate(t *testing.T, got cloudevents.Event, err error, want *cloudevents.Event, wantData, wantErr string) {
 	if wantErr != "" || err != nil {
 		var gotErr string
@@ -319,3 +1498,198 @@ func validate(t *testing.T, got cloudevents.Event, err error, want *cloudevents.
 		t.Error("unexpected data diff (-want, +got) =", diff)
 	}
 }
+
+func TestMakeEventTraceParent(t *testing.T) {
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	tests := map[string]struct {
+		annotations    map[string]string
+		wantParent     string
+		wantState      string
+		wantRemoteSpan bool
+	}{
+		"traceparent": {
+			annotations:    map[string]string{"traceparent": traceParent},
+			wantParent:     traceParent,
+			wantRemoteSpan: true,
+		},
+		"traceparent and tracestate": {
+			annotations:    map[string]string{"traceparent": traceParent, "tracestate": "vendor=value"},
+			wantParent:     traceParent,
+			wantState:      "vendor=value",
+			wantRemoteSpan: true,
+		},
+		"invalid traceparent": {
+			annotations: map[string]string{"traceparent": "garbage", "tracestate": "vendor=value"},
+		},
+		"no traceparent": {
+			annotations: map[string]string{"tracestate": "vendor=value"},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			pod := simplePod("unit", "test")
+			pod.SetAnnotations(tc.annotations)
+			ctx, event, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, pod, false)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := stringExtension(event.Extensions(), "traceparent"); got != tc.wantParent {
+				t.Errorf("traceparent extension = %q, want %q", got, tc.wantParent)
+			}
+			if got := stringExtension(event.Extensions(), "tracestate"); got != tc.wantState {
+				t.Errorf("tracestate extension = %q, want %q", got, tc.wantState)
+			}
+			parent, ok := observability.RemoteParentFromContext(ctx)
+			if ok != tc.wantRemoteSpan {
+				t.Fatalf("RemoteParentFromContext() found = %v, want %v", ok, tc.wantRemoteSpan)
+			}
+			if ok && parent.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+				t.Errorf("remote parent TraceID = %s, want 4bf92f3577b34da6a3ce929d0e0e4736", parent.TraceID)
+			}
+		})
+	}
+}
+
+func TestMakeScaleEvent(t *testing.T) {
+	if _, _, err := events.MakeScaleEvent("unit-test", apiServerSourceNameTest, nil, events.Scale{}); err == nil || err.Error() != "resource can not be nil" {
+		t.Errorf("Expected the error of a nil object, got %v", err)
+	}
+
+	scale := events.Scale{OldReplicas: 1, Replicas: 3, StatusReplicas: 1, Selector: "app=web"}
+	_, event, err := events.MakeScaleEvent("unit-test", apiServerSourceNameTest, simplePod("unit", "test"), scale)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got := event.Type(); got != sources.ApiServerSourceScaleEventType {
+		t.Errorf("Type() = %q, want %q", got, sources.ApiServerSourceScaleEventType)
+	}
+	if got, want := event.Subject(), *simpleSubject("unit", "test"); got != want {
+		t.Errorf("Subject() = %q, want %q", got, want)
+	}
+	const want = `{"oldReplicas":1,"replicas":3,"statusReplicas":1,"selector":"app=web"}`
+	if got := string(event.Data()); got != want {
+		t.Errorf("Data() = %s, want %s", got, want)
+	}
+}
+
+func TestMakeEventKubernetesEventFormat(t *testing.T) {
+	pod := simplePod("unit", "test")
+	pod.SetUID("1234")
+	pod.SetResourceVersion("7")
+	old := pod.DeepCopy()
+	_ = unstructured.SetNestedField(pod.Object, "Running", "status", "phase")
+
+	tests := map[string]struct {
+		make        func(opts ...events.EventOption) (cloudevents.Event, error)
+		wantType    string
+		wantReason  string
+		wantMessage string
+	}{
+		"add": {
+			make: func(opts ...events.EventOption) (cloudevents.Event, error) {
+				_, event, err := events.MakeAddEvent("unit-test", apiServerSourceNameTest, pod, false, opts...)
+				return event, err
+			},
+			wantType:    sources.ApiServerSourceAddEventType,
+			wantReason:  "Added",
+			wantMessage: "Pod test/unit was added",
+		},
+		"update": {
+			make: func(opts ...events.EventOption) (cloudevents.Event, error) {
+				opts = append(opts, events.WithOldObject(old), events.WithUpdateClassification())
+				_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, pod, false, opts...)
+				return event, err
+			},
+			wantType:    sources.ApiServerSourceUpdateStatusEventType,
+			wantReason:  "Updated",
+			wantMessage: "Pod test/unit was updated: status",
+		},
+		"ref delete": {
+			make: func(opts ...events.EventOption) (cloudevents.Event, error) {
+				_, event, err := events.MakeDeleteEvent("unit-test", apiServerSourceNameTest, pod, true, opts...)
+				return event, err
+			},
+			wantType:    sources.ApiServerSourceDeleteRefEventType,
+			wantReason:  "Deleted",
+			wantMessage: "Pod test/unit was deleted",
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			event, err := tc.make(events.WithKubernetesEventFormat())
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got := event.Type(); got != tc.wantType {
+				t.Errorf("Type() = %q, want %q", got, tc.wantType)
+			}
+			if got, want := event.Subject(), *simpleSubject("unit", "test"); got != want {
+				t.Errorf("Subject() = %q, want %q", got, want)
+			}
+			var got corev1.Event
+			if err := json.Unmarshal(event.Data(), &got); err != nil {
+				t.Fatal("Failed to decode the Kubernetes Event:", err)
+			}
+			want := corev1.Event{
+				InvolvedObject: corev1.ObjectReference{
+					APIVersion:      "v1",
+					Kind:            "Pod",
+					Namespace:       "test",
+					Name:            "unit",
+					UID:             "1234",
+					ResourceVersion: "7",
+				},
+				Reason:              tc.wantReason,
+				Message:             tc.wantMessage,
+				Source:              corev1.EventSource{Component: apiServerSourceNameTest},
+				Count:               1,
+				Type:                corev1.EventTypeNormal,
+				ReportingController: "sources.knative.dev/apiserversource",
+				ReportingInstance:   apiServerSourceNameTest,
+			}
+			if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(corev1.Event{}, "TypeMeta", "ObjectMeta", "FirstTimestamp", "LastTimestamp")); diff != "" {
+				t.Error("unexpected Kubernetes Event (-want, +got) =", diff)
+			}
+			if got.Kind != "Event" || got.Namespace != "test" || !strings.HasPrefix(got.Name, "unit.") {
+				t.Errorf("Unexpected Kubernetes Event kind %q, namespace %q or name %q", got.Kind, got.Namespace, got.Name)
+			}
+			if got.FirstTimestamp.IsZero() {
+				t.Error("Expected the Kubernetes Event to have a timestamp")
+			}
+		})
+	}
+}
+
+func TestMakeEventTypeTemplate(t *testing.T) {
+	pod := simplePod("unit", "test")
+	pod.SetResourceVersion("2")
+	_ = unstructured.SetNestedSlice(pod.Object, []interface{}{
+		map[string]interface{}{"name": "app", "restartCount": int64(1)},
+	}, "status", "containerStatuses")
+	old := pod.DeepCopy()
+	old.SetResourceVersion("1")
+	_ = unstructured.SetNestedSlice(old.Object, []interface{}{
+		map[string]interface{}{"name": "app", "restartCount": int64(0)},
+	}, "status", "containerStatuses")
+
+	opts := []events.EventOption{events.WithEventTypeTemplate("com.mycorp.{group}.{kind}.{verb}"), events.WithOldObject(old)}
+	_, event, err := events.MakeUpdateEvent("unit-test", apiServerSourceNameTest, pod, true, opts...)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got, want := event.Type(), "com.mycorp.core.pod.update"; got != want {
+		t.Errorf("Type() = %q, want %q", got, want)
+	}
+	// The enrichments of the events of their default type are kept.
+	if _, ok := event.Extensions()["newrestarts"]; !ok {
+		t.Errorf("Expected the newrestarts extension, got extensions %v", event.Extensions())
+	}
+
+	_, event, err = events.MakeAddEvent("unit-test", apiServerSourceNameTest, pod, false, opts...)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got, want := event.Type(), "com.mycorp.core.pod.add"; got != want {
+		t.Errorf("Type() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// The reasons of the Kubernetes Events of the changes of the objects.
+	kubernetesEventReasonAdded   = "Added"
+	kubernetesEventReasonUpdated = "Updated"
+	kubernetesEventReasonDeleted = "Deleted"
+
+	// kubernetesEventReportingController is the controller reporting the
+	// Kubernetes Events, which their reporting instance is an ApiServerSource
+	// of.
+	kubernetesEventReportingController = "sources.knative.dev/apiserversource"
+)
+
+// makeKubernetesEvent returns the Kubernetes Event of the change of obj for
+// the reason, as the ApiServerSource with the name reports it. The changed
+// sections of an update, if known, are mentioned in its message.
+func makeKubernetesEvent(apiServerSourceName string, obj *unstructured.Unstructured, reason string, sections []string) *corev1.Event {
+	now := time.Now()
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		// Like the events of cluster scoped objects recorded by client-go.
+		namespace = metav1.NamespaceDefault
+	}
+	return &corev1.Event{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta: metav1.ObjectMeta{
+			// The names of the events recorded by client-go.
+			Name:      fmt.Sprintf("%v.%x", obj.GetName(), now.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      obj.GetAPIVersion(),
+			Kind:            obj.GetKind(),
+			Namespace:       obj.GetNamespace(),
+			Name:            obj.GetName(),
+			UID:             obj.GetUID(),
+			ResourceVersion: obj.GetResourceVersion(),
+		},
+		Reason:              reason,
+		Message:             kubernetesEventMessage(obj, reason, sections),
+		Source:              corev1.EventSource{Component: apiServerSourceName},
+		FirstTimestamp:      metav1.NewTime(now),
+		LastTimestamp:       metav1.NewTime(now),
+		Count:               1,
+		Type:                corev1.EventTypeNormal,
+		ReportingController: kubernetesEventReportingController,
+		ReportingInstance:   apiServerSourceName,
+	}
+}
+
+// kubernetesEventMessage returns the human readable message of the change of
+// obj for the reason, e.g. `Pod default/web-1 was updated: status`.
+func kubernetesEventMessage(obj *unstructured.Unstructured, reason string, sections []string) string {
+	name := obj.GetName()
+	if ns := obj.GetNamespace(); ns != "" {
+		name = ns + "/" + name
+	}
+	message := fmt.Sprintf("%s %s was %s", obj.GetKind(), name, strings.ToLower(reason))
+	if len(sections) > 0 {
+		message += ": " + strings.Join(sections, ", ")
+	}
+	return message
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+// DefaultSubjectRefreshInterval is the minimum interval between the refreshes
+// of the mappings of a SubjectResolver on the kinds it does not know.
+const DefaultSubjectRefreshInterval = 30 * time.Second
+
+// SubjectResolver resolves the subjects of the events to the paths of their
+// objects on the API server, from the resources a RESTMapper maps their kinds
+// to, e.g. /apis/apps/v1/namespaces/default/deployments/web or
+// /api/v1/nodes/node-1.
+type SubjectResolver struct {
+	// mapper is nil in the offline mode, where the resources are guessed.
+	mapper   meta.RESTMapper
+	fallback bool
+	interval time.Duration
+	now      func() time.Time
+
+	mu          sync.Mutex
+	lastRefresh time.Time
+}
+
+// SubjectResolverOption configures a SubjectResolver.
+type SubjectResolverOption func(*SubjectResolver)
+
+// WithGuessFallback makes the resolver guess the resources of the kinds its
+// mapper fails to map, e.g. while the API server cannot be reached, rather
+// than failing to resolve their subjects.
+func WithGuessFallback() SubjectResolverOption {
+	return func(r *SubjectResolver) {
+		r.fallback = true
+	}
+}
+
+// WithRefreshInterval sets the minimum interval between the refreshes of the
+// mappings on the kinds the mapper does not know.
+func WithRefreshInterval(interval time.Duration) SubjectResolverOption {
+	return func(r *SubjectResolver) {
+		r.interval = interval
+	}
+}
+
+// NewSubjectResolver returns a resolver of the subjects mapped by mapper. The
+// mappings of mapper are refreshed on the kinds it does not know when it is
+// a meta.ResettableRESTMapper. The resolver is offline when mapper is nil: it
+// guesses the resources of every kind, and their scopes from the namespaces
+// of the objects, which suits tests without an API server.
+func NewSubjectResolver(mapper meta.RESTMapper, opts ...SubjectResolverOption) *SubjectResolver {
+	r := &SubjectResolver{
+		mapper:   mapper,
+		interval: DefaultSubjectRefreshInterval,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// NewDiscoverySubjectResolver returns a resolver of the subjects mapped by
+// the discovery of the API server of client. The discovery is cached, and
+// refreshed on the kinds it does not know.
+func NewDiscoverySubjectResolver(client discovery.DiscoveryInterface, opts ...SubjectResolverOption) *SubjectResolver {
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client))
+	return NewSubjectResolver(mapper, opts...)
+}
+
+// Subject returns the path of the object ref refers to. The preferred
+// version of its kind is used when the APIVersion of ref has no version.
+// It is a SubjectFunc.
+func (r *SubjectResolver) Subject(ref corev1.ObjectReference) (string, error) {
+	gk, version, err := groupKindVersion(ref)
+	if err != nil {
+		return "", err
+	}
+	if r.mapper == nil {
+		return guessedPath(gk, version, ref), nil
+	}
+	mapping, err := r.mapping(gk, version)
+	if err != nil {
+		if r.fallback {
+			return guessedPath(gk, version, ref), nil
+		}
+		return "", fmt.Errorf("failed to map the kind %s: %w", gk, err)
+	}
+	namespace := ref.Namespace
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespace = ""
+	}
+	return resourcePath(mapping.Resource, namespace, ref.Name), nil
+}
+
+// mapping returns the mapping of the kind, refreshing the mappings of the
+// mapper at most once per interval when it does not know the kind.
+func (r *SubjectResolver) mapping(gk schema.GroupKind, version string) (*meta.RESTMapping, error) {
+	var versions []string
+	if version != "" {
+		versions = []string{version}
+	}
+	mapping, err := r.mapper.RESTMapping(gk, versions...)
+	if err == nil || !meta.IsNoMatchError(err) {
+		return mapping, err
+	}
+	resettable, ok := r.mapper.(meta.ResettableRESTMapper)
+	if !ok || !r.refresh() {
+		return nil, err
+	}
+	resettable.Reset()
+	return r.mapper.RESTMapping(gk, versions...)
+}
+
+// refresh returns whether the mappings may be refreshed, and records their
+// refresh when they may.
+func (r *SubjectResolver) refresh() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if !r.lastRefresh.IsZero() && now.Sub(r.lastRefresh) < r.interval {
+		return false
+	}
+	r.lastRefresh = now
+	return true
+}
+
+// groupKindVersion returns the group kind and the version of ref. The
+// version is empty when the APIVersion of ref is only a group, e.g.
+// serving.knative.dev.
+func groupKindVersion(ref corev1.ObjectReference) (schema.GroupKind, string, error) {
+	if ref.Kind == "" {
+		return schema.GroupKind{}, "", fmt.Errorf("the kind of %q is empty", ref.Name)
+	}
+	if !strings.Contains(ref.APIVersion, "/") && strings.Contains(ref.APIVersion, ".") {
+		return schema.GroupKind{Group: ref.APIVersion, Kind: ref.Kind}, "", nil
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupKind{}, "", err
+	}
+	return gv.WithKind(ref.Kind).GroupKind(), gv.Version, nil
+}
+
+// guessedPath returns the path of the object ref refers to, guessing its
+// resource from its kind, and its scope from its namespace.
+func guessedPath(gk schema.GroupKind, version string, ref corev1.ObjectReference) string {
+	gvr, _ := meta.UnsafeGuessKindToResource(gk.WithVersion(version))
+	return resourcePath(gvr, ref.Namespace, ref.Name)
+}
+
+// resourcePath returns the path of the object of the resource. The objects of
+// the core group are under /api, the others under /apis.
+func resourcePath(gvr schema.GroupVersionResource, namespace, name string) string {
+	var b strings.Builder
+	if gvr.Group == "" {
+		b.WriteString("/api")
+	} else {
+		b.WriteString("/apis/")
+		b.WriteString(gvr.Group)
+	}
+	if gvr.Version != "" {
+		b.WriteString("/")
+		b.WriteString(gvr.Version)
+	}
+	if namespace != "" {
+		b.WriteString("/namespaces/")
+		b.WriteString(namespace)
+	}
+	b.WriteString("/")
+	b.WriteString(gvr.Resource)
+	b.WriteString("/")
+	b.WriteString(name)
+	return b.String()
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+)
+
+func testRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Version: "v1"}, {Group: "apps", Version: "v1"}, {Group: "chaos.example.com", Version: "v1"}})
+	mapper.AddSpecific(schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+		schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		schema.GroupVersionResource{Version: "v1", Resource: "pod"}, meta.RESTScopeNamespace)
+	mapper.AddSpecific(schema.GroupVersionKind{Version: "v1", Kind: "Node"},
+		schema.GroupVersionResource{Version: "v1", Resource: "nodes"},
+		schema.GroupVersionResource{Version: "v1", Resource: "node"}, meta.RESTScopeRoot)
+	mapper.AddSpecific(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployment"}, meta.RESTScopeNamespace)
+	mapper.AddSpecific(schema.GroupVersionKind{Group: "chaos.example.com", Version: "v1", Kind: "Chaos"},
+		schema.GroupVersionResource{Group: "chaos.example.com", Version: "v1", Resource: "chaos"},
+		schema.GroupVersionResource{Group: "chaos.example.com", Version: "v1", Resource: "chaos"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+func TestSubjectResolver(t *testing.T) {
+	tests := map[string]struct {
+		ref     corev1.ObjectReference
+		opts    []events.SubjectResolverOption
+		offline bool
+		want    string
+		wantErr bool
+	}{
+		"core namespaced": {
+			ref:  corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "test", Name: "unit"},
+			want: "/api/v1/namespaces/test/pods/unit",
+		},
+		"core cluster scoped": {
+			ref:  corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Namespace: "test", Name: "node-1"},
+			want: "/api/v1/nodes/node-1",
+		},
+		"group": {
+			ref:  corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "test", Name: "web"},
+			want: "/apis/apps/v1/namespaces/test/deployments/web",
+		},
+		"irregular plural": {
+			ref:  corev1.ObjectReference{APIVersion: "chaos.example.com/v1", Kind: "Chaos", Namespace: "test", Name: "c"},
+			want: "/apis/chaos.example.com/v1/namespaces/test/chaos/c",
+		},
+		"group only": {
+			ref:  corev1.ObjectReference{APIVersion: "chaos.example.com", Kind: "Chaos", Namespace: "test", Name: "c"},
+			want: "/apis/chaos.example.com/v1/namespaces/test/chaos/c",
+		},
+		"unknown kind": {
+			ref:     corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "test", Name: "w"},
+			wantErr: true,
+		},
+		"unknown kind with fallback": {
+			ref:  corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "test", Name: "w"},
+			opts: []events.SubjectResolverOption{events.WithGuessFallback()},
+			want: "/apis/example.com/v1/namespaces/test/widgets/w",
+		},
+		"empty kind": {
+			ref:     corev1.ObjectReference{APIVersion: "v1", Name: "unit"},
+			wantErr: true,
+		},
+		"offline": {
+			ref:     corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "test", Name: "unit"},
+			offline: true,
+			want:    "/api/v1/namespaces/test/pods/unit",
+		},
+		"offline cluster scoped": {
+			ref:     corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-1"},
+			offline: true,
+			want:    "/api/v1/nodes/node-1",
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			mapper := testRESTMapper()
+			if tc.offline {
+				mapper = nil
+			}
+			got, err := events.NewSubjectResolver(mapper, tc.opts...).Subject(tc.ref)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Subject() = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("Subject() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiscoverySubjectResolverRefresh(t *testing.T) {
+	discovery := &discoveryfake.FakeDiscovery{
+		Fake: &kubetesting.Fake{
+			Resources: []*metav1.APIResourceList{{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "pods", Namespaced: true, Kind: "Pod"},
+				},
+			}},
+		},
+	}
+	widget := corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "test", Name: "w"}
+
+	resolver := events.NewDiscoverySubjectResolver(discovery, events.WithRefreshInterval(time.Hour))
+	if got, err := resolver.Subject(corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "test", Name: "unit"}); err != nil || got != "/api/v1/namespaces/test/pods/unit" {
+		t.Errorf("Subject() = %s, %v, want /api/v1/namespaces/test/pods/unit", got, err)
+	}
+
+	// The kind is installed after the discovery is cached.
+	discovery.Resources = append(discovery.Resources, &metav1.APIResourceList{
+		GroupVersion: "example.com/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "widgetries", Namespaced: true, Kind: "Widget"},
+		},
+	})
+	want := "/apis/example.com/v1/namespaces/test/widgetries/w"
+	if got, err := resolver.Subject(widget); err != nil || got != want {
+		t.Errorf("Subject() = %s, %v, want %s", got, err, want)
+	}
+
+	// The refreshes on the unknown kinds are bounded by the interval.
+	gadget := corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Gadget", Namespace: "test", Name: "g"}
+	discovery.Resources[1].APIResources = append(discovery.Resources[1].APIResources,
+		metav1.APIResource{Name: "gadgets", Namespaced: true, Kind: "Gadget"})
+	if got, err := resolver.Subject(gadget); err == nil {
+		t.Errorf("Subject() = %s, want an error until the next refresh", got)
+	}
+}
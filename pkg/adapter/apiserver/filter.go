@@ -58,7 +58,13 @@ func (c *controllerFilter) Delete(obj interface{}) error {
 }
 
 func (c *controllerFilter) filtered(obj interface{}) bool {
-	u := obj.(*unstructured.Unstructured)
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u == nil {
+		return true
+	}
 	controller := metav1.GetControllerOf(u)
 	return controller == nil || (c.apiVersion != "" && c.apiVersion != controller.APIVersion) ||
 		(c.kind != "" && c.kind != controller.Kind)
@@ -87,11 +93,17 @@ func (c *controllerFilter) GetByKey(key string) (item interface{}, exists bool,
 }
 
 // Implements cache.Store
-func (c *controllerFilter) Replace([]interface{}, string) error {
-	return nil
+func (c *controllerFilter) Replace(list []interface{}, resourceVersion string) error {
+	kept := make([]interface{}, 0, len(list))
+	for _, obj := range list {
+		if !c.filtered(obj) {
+			kept = append(kept, obj)
+		}
+	}
+	return c.delegate.Replace(kept, resourceVersion)
 }
 
 // Implements cache.Store
 func (c *controllerFilter) Resync() error {
-	return nil
+	return c.delegate.Resync()
 }
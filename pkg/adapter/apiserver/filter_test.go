@@ -19,6 +19,8 @@ package apiserver
 import (
 	"testing"
 
+	"k8s.io/client-go/tools/cache"
+
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	sources "knative.dev/eventing/pkg/apis/sources"
 )
@@ -95,6 +97,12 @@ func TestControllerDeleteEventWithGoodControllerNoAPIVersion(t *testing.T) {
 	validateSent(t, tc, sources.ApiServerSourceDeleteRefEventType)
 }
 
+func TestControllerDeleteEventFinalStateUnknown(t *testing.T) {
+	c, tc := makeController("apps/v1", "ReplicaSet")
+	c.Delete(cache.DeletedFinalStateUnknown{Key: "test/unit", Obj: simpleOwnedPod("unit", "test")})
+	validateSent(t, tc, sources.ApiServerSourceDeleteRefEventType)
+}
+
 func makeController(apiVersion, kind string) (*controllerFilter, *adaptertest.TestCloudEventsClient) {
 	delegate, tc := makeRefAndTestingClient()
 	return &controllerFilter{
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+var peerAuthenticationGVR = schema.GroupVersionResource{
+	Group:    "security.istio.io",
+	Version:  "v1beta1",
+	Resource: "peerauthentications",
+}
+
+// watchesNamespaces returns true when one of the configured resources is the
+// core Namespace resource.
+func (a *apiServerAdapter) watchesNamespaces() bool {
+	for _, configRes := range a.config.Resources {
+		if configRes.GVR.Group == "" && configRes.GVR.Resource == "namespaces" {
+			return true
+		}
+	}
+	return false
+}
+
+// peerAuthenticationLister starts an informer on Istio PeerAuthentication
+// policies and returns a lister backed by it. It returns nil when Istio is not
+// installed in the cluster or the policies can not be read, in which case
+// Namespace events are not enriched.
+func (a *apiServerAdapter) peerAuthenticationLister(ctx context.Context, stopCh <-chan struct{}) cache.GenericLister {
+	resources, err := a.discover.ServerResourcesForGroupVersion(peerAuthenticationGVR.GroupVersion().String())
+	if err != nil {
+		a.logger.Debugf("Istio PeerAuthentication is not available: %s", err.Error())
+		return nil
+	}
+
+	found := false
+	for _, apires := range resources.APIResources {
+		if apires.Name == peerAuthenticationGVR.Resource {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	res := a.k8s.Resource(peerAuthenticationGVR)
+	if _, err := res.List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		a.logger.Warnf("Could not list Istio PeerAuthentication policies: %s", err.Error())
+		return nil
+	}
+
+	lw := &cache.ListWatch{
+		ListFunc:  asUnstructuredLister(ctx, res.List, "", ""),
+		WatchFunc: asUnstructuredWatcher(ctx, res.Watch, "", ""),
+	}
+	informer := cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	go informer.Run(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		return nil
+	}
+	return cache.NewGenericLister(informer.GetIndexer(), peerAuthenticationGVR.GroupResource())
+}
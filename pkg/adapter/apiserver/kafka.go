@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	ceclient "github.com/cloudevents/sdk-go/v2/client"
+	"github.com/xdg-go/scram"
+	"knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/metrics/source"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// The keys of the Secret holding the TLS and SASL configuration of the
+// connection to the Kafka brokers, as KafkaSinks define them.
+const (
+	kafkaProtocolKey      = "protocol"
+	kafkaSASLMechanismKey = "sasl.mechanism"
+	kafkaCACertKey        = "ca.crt"
+	kafkaUserCertKey      = "user.crt"
+	kafkaUserKeyKey       = "user.key"
+	kafkaUserKey          = "user"
+	kafkaPasswordKey      = "password"
+)
+
+// The security protocols of the connection to the Kafka brokers.
+const (
+	kafkaProtocolPlaintext     = "PLAINTEXT"
+	kafkaProtocolSSL           = "SSL"
+	kafkaProtocolSASLPlaintext = "SASL_PLAINTEXT"
+	kafkaProtocolSASLSSL       = "SASL_SSL"
+)
+
+// The content modes of the events written to the Kafka topic.
+const (
+	kafkaContentModeBinary     = "binary"
+	kafkaContentModeStructured = "structured"
+)
+
+// newKafkaClient returns a client writing the events to the Kafka topic of
+// cfg, which applies the ceOverrides to them and reports them to reporter.
+// The partition of the events is picked by their partitionkey extension.
+func newKafkaClient(cfg *KafkaSinkConfig, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter) (cloudevents.Client, error) {
+	var opts []ceclient.Option
+	switch cfg.ContentMode {
+	case "", kafkaContentModeBinary:
+		opts = append(opts, ceclient.WithForceBinary())
+	case kafkaContentModeStructured:
+		opts = append(opts, ceclient.WithForceStructured())
+	default:
+		return nil, fmt.Errorf("unsupported Kafka content mode %q", cfg.ContentMode)
+	}
+
+	config, err := kafkaConfig(cfg.AuthPath)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := kafka_sarama.NewSender(cfg.BootstrapServers, config, cfg.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Kafka producer: %w", err)
+	}
+	return adapter.NewCloudEventsClientWithSender(sender, ceOverrides, reporter, opts...)
+}
+
+// kafkaConfig returns the configuration of a producer connecting to the
+// brokers with the TLS and SASL configuration whose keys are mounted in
+// authPath. The connection uses plaintext when authPath is empty.
+func kafkaConfig(authPath string) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	// The CloudEvents attributes of the events in binary mode are written
+	// in the headers of the records, which need Kafka 0.11 at least.
+	config.Version = sarama.V2_0_0_0
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	if authPath == "" {
+		return config, nil
+	}
+	protocol, err := readKafkaAuthKey(authPath, kafkaProtocolKey)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.TrimSpace(protocol) {
+	case "", kafkaProtocolPlaintext:
+	case kafkaProtocolSSL:
+		err = setKafkaTLS(config, authPath)
+	case kafkaProtocolSASLPlaintext:
+		err = setKafkaSASL(config, authPath)
+	case kafkaProtocolSASLSSL:
+		if err = setKafkaTLS(config, authPath); err == nil {
+			err = setKafkaSASL(config, authPath)
+		}
+	default:
+		err = fmt.Errorf("unsupported Kafka security protocol %q", protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// setKafkaTLS enables TLS on the connection to the brokers, trusting the CA
+// certificate and presenting the client certificate mounted in authPath when
+// there are.
+func setKafkaTLS(config *sarama.Config, authPath string) error {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	ca, err := readKafkaAuthKey(authPath, kafkaCACertKey)
+	if err != nil {
+		return err
+	}
+	if ca != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return fmt.Errorf("failed to parse the Kafka CA certificate %s", kafkaCACertKey)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	cert, err := readKafkaAuthKey(authPath, kafkaUserCertKey)
+	if err != nil {
+		return err
+	}
+	key, err := readKafkaAuthKey(authPath, kafkaUserKeyKey)
+	if err != nil {
+		return err
+	}
+	if cert != "" || key != "" {
+		pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return fmt.Errorf("failed to parse the Kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+
+	config.Net.TLS.Enable = true
+	config.Net.TLS.Config = tlsConfig
+	return nil
+}
+
+// setKafkaSASL enables the SASL authentication of the producer to the
+// brokers, with the mechanism and the credentials mounted in authPath. The
+// mechanism defaults to PLAIN.
+func setKafkaSASL(config *sarama.Config, authPath string) error {
+	mechanism, err := readKafkaAuthKey(authPath, kafkaSASLMechanismKey)
+	if err != nil {
+		return err
+	}
+	user, err := readKafkaAuthKey(authPath, kafkaUserKey)
+	if err != nil {
+		return err
+	}
+	password, err := readKafkaAuthKey(authPath, kafkaPasswordKey)
+	if err != nil {
+		return err
+	}
+	if user == "" {
+		return fmt.Errorf("the Kafka SASL %s is missing", kafkaUserKey)
+	}
+
+	switch strings.TrimSpace(mechanism) {
+	case "", sarama.SASLTypePlaintext:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case sarama.SASLTypeSCRAMSHA256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hash: scram.HashGeneratorFcn(sha256.New)}
+		}
+	case sarama.SASLTypeSCRAMSHA512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hash: scram.HashGeneratorFcn(sha512.New)}
+		}
+	default:
+		return fmt.Errorf("unsupported Kafka SASL mechanism %q", mechanism)
+	}
+	config.Net.SASL.Enable = true
+	config.Net.SASL.Handshake = true
+	config.Net.SASL.User = user
+	config.Net.SASL.Password = password
+	return nil
+}
+
+// readKafkaAuthKey returns the value of the key of the Secret mounted in
+// authPath, or "" when the Secret has no such key.
+func readKafkaAuthKey(authPath, key string) (string, error) {
+	b, err := os.ReadFile(filepath.Join(authPath, key))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read the Kafka %s: %w", key, err)
+	}
+	return string(b), nil
+}
+
+// scramClient implements sarama.SCRAMClient.
+type scramClient struct {
+	hash         scram.HashGeneratorFcn
+	conversation *scram.ClientConversation
+}
+
+var _ sarama.SCRAMClient = (*scramClient)(nil)
+
+func (c *scramClient) Begin(user, password, authzID string) error {
+	client, err := c.hash.NewClient(user, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conversation = client.NewConversation()
+	return nil
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.conversation.Step(challenge)
+}
+
+func (c *scramClient) Done() bool {
+	return c.conversation.Done()
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Shopify/sarama"
+)
+
+func TestKafkaConfig(t *testing.T) {
+	tests := map[string]struct {
+		keys          map[string]string
+		noAuth        bool
+		wantTLS       bool
+		wantSASL      bool
+		wantMechanism sarama.SASLMechanism
+		wantErr       bool
+	}{
+		"no auth": {
+			noAuth: true,
+		},
+		"plaintext": {
+			keys: map[string]string{kafkaProtocolKey: "PLAINTEXT"},
+		},
+		"no protocol": {
+			keys: map[string]string{},
+		},
+		"ssl": {
+			keys:    map[string]string{kafkaProtocolKey: "SSL"},
+			wantTLS: true,
+		},
+		"ssl with invalid CA certificate": {
+			keys:    map[string]string{kafkaProtocolKey: "SSL", kafkaCACertKey: "not a certificate"},
+			wantErr: true,
+		},
+		"ssl with invalid client certificate": {
+			keys:    map[string]string{kafkaProtocolKey: "SSL", kafkaUserCertKey: "not a certificate"},
+			wantErr: true,
+		},
+		"sasl plaintext": {
+			keys:          map[string]string{kafkaProtocolKey: "SASL_PLAINTEXT", kafkaUserKey: "user", kafkaPasswordKey: "password"},
+			wantSASL:      true,
+			wantMechanism: sarama.SASLTypePlaintext,
+		},
+		"sasl ssl with scram": {
+			keys: map[string]string{
+				kafkaProtocolKey:      "SASL_SSL\n",
+				kafkaSASLMechanismKey: "SCRAM-SHA-512",
+				kafkaUserKey:          "user",
+				kafkaPasswordKey:      "password",
+			},
+			wantTLS:       true,
+			wantSASL:      true,
+			wantMechanism: sarama.SASLTypeSCRAMSHA512,
+		},
+		"sasl without user": {
+			keys:    map[string]string{kafkaProtocolKey: "SASL_PLAINTEXT", kafkaPasswordKey: "password"},
+			wantErr: true,
+		},
+		"unsupported sasl mechanism": {
+			keys:    map[string]string{kafkaProtocolKey: "SASL_PLAINTEXT", kafkaSASLMechanismKey: "GSSAPI", kafkaUserKey: "user"},
+			wantErr: true,
+		},
+		"unsupported protocol": {
+			keys:    map[string]string{kafkaProtocolKey: "TLS"},
+			wantErr: true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			authPath := ""
+			if !tc.noAuth {
+				authPath = t.TempDir()
+				for key, value := range tc.keys {
+					if err := os.WriteFile(filepath.Join(authPath, key), []byte(value), 0600); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			config, err := kafkaConfig(authPath)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			if err := config.Validate(); err != nil {
+				t.Error("Invalid config:", err)
+			}
+			if config.Net.TLS.Enable != tc.wantTLS {
+				t.Errorf("TLS enabled = %v, want %v", config.Net.TLS.Enable, tc.wantTLS)
+			}
+			if config.Net.SASL.Enable != tc.wantSASL {
+				t.Errorf("SASL enabled = %v, want %v", config.Net.SASL.Enable, tc.wantSASL)
+			}
+			if tc.wantSASL && config.Net.SASL.Mechanism != tc.wantMechanism {
+				t.Errorf("SASL mechanism = %q, want %q", config.Net.SASL.Mechanism, tc.wantMechanism)
+			}
+		})
+	}
+}
+
+func TestNewKafkaClientUnsupportedContentMode(t *testing.T) {
+	_, err := newKafkaClient(&KafkaSinkConfig{
+		BootstrapServers: []string{"localhost:9092"},
+		Topic:            "events",
+		ContentMode:      "batched",
+	}, nil, nil)
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+}
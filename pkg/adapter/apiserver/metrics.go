@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"path"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/metrics/source"
+)
+
+// generationKey is the key of the generation of the event of a context.
+type generationKey struct{}
+
+// generation is the resource an event is about and the time it was
+// generated at, which label the metrics of its deliveries.
+type generation struct {
+	resource string
+	at       time.Time
+}
+
+// resourceLabel returns the label of the metrics of the events of the
+// resources gvr, e.g. `apps/v1/deployments`, or `v1/pods` for the core
+// resources.
+func resourceLabel(gvr schema.GroupVersionResource) string {
+	return path.Join(gvr.Group, gvr.Version, gvr.Resource)
+}
+
+// generated reports event, generated from a change of a resource, and
+// returns its context carrying its generation.
+func (a *resourceDelegate) generated(ctx context.Context, event cloudevents.Event) context.Context {
+	if a.metrics == nil {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, generationKey{}, generation{resource: a.resource, at: time.Now()})
+	if err := a.metrics.ReportGenerated(a.metricArgs(ctx, event, "")); err != nil {
+		a.logger.Warnw("Failed to report the generated event", zap.String("type", event.Type()), zap.Error(err))
+	}
+	return ctx
+}
+
+// dropped reports event, which is not delivered for reason.
+func (a *resourceDelegate) dropped(ctx context.Context, event cloudevents.Event, reason string) {
+	if a.metrics == nil {
+		return
+	}
+	if err := a.metrics.ReportDropped(a.metricArgs(ctx, event, ""), reason); err != nil {
+		a.logger.Warnw("Failed to report the dropped event", zap.String("type", event.Type()), zap.Error(err))
+	}
+}
+
+// delivered reports the delivery of event to the sink at uri, which ended
+// with result, and its retries.
+func (a *resourceDelegate) delivered(ctx context.Context, event cloudevents.Event, uri string, result protocol.Result) {
+	if a.metrics == nil {
+		return
+	}
+	args := a.metricArgs(ctx, event, uri)
+	// The deliveries without a response, or to the sinks of the egress
+	// bindings, are reported without a response code.
+	code := errorCode(result)
+	if code == noResponse {
+		code = 0
+	}
+	var latency time.Duration
+	if g, ok := ctx.Value(generationKey{}).(generation); ok {
+		latency = time.Since(g.at)
+	}
+	if err := a.metrics.ReportDelivered(args, code, latency); err != nil {
+		a.logger.Warnw("Failed to report the delivered event", zap.String("type", event.Type()), zap.Error(err))
+	}
+	var rres *cehttp.RetriesResult
+	if cloudevents.ResultAs(result, &rres) {
+		if err := a.metrics.ReportRetried(args, rres.Retries); err != nil {
+			a.logger.Warnw("Failed to report the retried event", zap.String("type", event.Type()), zap.Error(err))
+		}
+	}
+}
+
+// metricArgs returns the arguments of the metrics of event, delivered to
+// the sink at uri, labeled with the resource of its generation.
+func (a *resourceDelegate) metricArgs(ctx context.Context, event cloudevents.Event, uri string) *source.ApiServerReportArgs {
+	tags := kncloudevents.MetricTagFromContext(ctx)
+	resource := a.resource
+	if g, ok := ctx.Value(generationKey{}).(generation); ok {
+		resource = g.resource
+	}
+	return &source.ApiServerReportArgs{
+		Namespace: tags.Namespace,
+		Name:      tags.Name,
+		Resource:  resource,
+		EventType: event.Type(),
+		Sink:      uri,
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/metrics/source"
+)
+
+// recordedMetric is a metric reported to a recordingMetrics.
+type recordedMetric struct {
+	metric    string
+	resource  string
+	eventType string
+	sink      string
+	code      int
+	retries   int
+	reason    string
+}
+
+// recordingMetrics records the metrics reported to it.
+type recordingMetrics struct {
+	mu       sync.Mutex
+	recorded []recordedMetric
+	latency  []time.Duration
+}
+
+var _ source.ApiServerStatsReporter = (*recordingMetrics)(nil)
+
+func (r *recordingMetrics) record(m recordedMetric, args *source.ApiServerReportArgs) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m.resource, m.eventType, m.sink = args.Resource, args.EventType, args.Sink
+	r.recorded = append(r.recorded, m)
+	return nil
+}
+
+func (r *recordingMetrics) ReportGenerated(args *source.ApiServerReportArgs) error {
+	return r.record(recordedMetric{metric: "generated"}, args)
+}
+
+func (r *recordingMetrics) ReportDelivered(args *source.ApiServerReportArgs, code int, latency time.Duration) error {
+	r.mu.Lock()
+	r.latency = append(r.latency, latency)
+	r.mu.Unlock()
+	return r.record(recordedMetric{metric: "delivered", code: code}, args)
+}
+
+func (r *recordingMetrics) ReportRetried(args *source.ApiServerReportArgs, retries int) error {
+	return r.record(recordedMetric{metric: "retried", retries: retries}, args)
+}
+
+func (r *recordingMetrics) ReportDropped(args *source.ApiServerReportArgs, reason string) error {
+	return r.record(recordedMetric{metric: "dropped", reason: reason}, args)
+}
+
+func TestResourceLabel(t *testing.T) {
+	for gvr, want := range map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "pods"}:                       "v1/pods",
+		{Group: "apps", Version: "v1", Resource: "deployments"}: "apps/v1/deployments",
+	} {
+		if got := resourceLabel(gvr); got != want {
+			t.Errorf("resourceLabel(%v) = %s, want %s", gvr, got, want)
+		}
+	}
+}
+
+func TestResourceMetrics(t *testing.T) {
+	const addType = "dev.knative.apiserver.resource.add"
+	tests := map[string]struct {
+		dispatch func(d *resourceDelegate)
+		want     []recordedMetric
+	}{
+		"delivered": {
+			dispatch: func(d *resourceDelegate) {
+				d.Add(simplePod("unit", "test"))
+			},
+			want: []recordedMetric{
+				{metric: "generated", resource: "v1/pods", eventType: addType},
+				{metric: "delivered", resource: "v1/pods", eventType: addType, sink: "http://sink.example.com", code: 200},
+			},
+		},
+		"retried": {
+			dispatch: func(d *resourceDelegate) {
+				d.dispatch(context.Background(), testMetricEvent("unit.retries"))
+			},
+			want: []recordedMetric{
+				{metric: "generated", resource: "v1/pods", eventType: "unit.retries"},
+				{metric: "delivered", resource: "v1/pods", eventType: "unit.retries", sink: "http://sink.example.com", code: 200},
+				{metric: "retried", resource: "v1/pods", eventType: "unit.retries", sink: "http://sink.example.com", retries: 1},
+			},
+		},
+		"undeliverable": {
+			dispatch: func(d *resourceDelegate) {
+				d.dispatch(context.Background(), testMetricEvent("unit.sendFail"))
+			},
+			want: []recordedMetric{
+				{metric: "generated", resource: "v1/pods", eventType: "unit.sendFail"},
+				{metric: "delivered", resource: "v1/pods", eventType: "unit.sendFail", sink: "http://sink.example.com", code: 400},
+				{metric: "dropped", resource: "v1/pods", eventType: "unit.sendFail", reason: source.DropReasonUndeliverable},
+			},
+		},
+		"filtered": {
+			dispatch: func(d *resourceDelegate) {
+				d.filter = subscriptionsapi.CreateSubscriptionsAPIFilters(context.Background(), []eventingv1.SubscriptionsAPIFilter{{
+					Exact: map[string]string{"type": "unit.type"},
+				}})
+				d.Add(simplePod("unit", "test"))
+			},
+			want: []recordedMetric{
+				{metric: "generated", resource: "v1/pods", eventType: addType},
+				{metric: "dropped", resource: "v1/pods", eventType: addType, reason: source.DropReasonFiltered},
+			},
+		},
+		"rate limited": {
+			dispatch: func(d *resourceDelegate) {
+				d.limiter = makeTestRateLimiter(1, 1, "Drop")
+				d.Add(simplePod("a", "test"))
+				d.Add(simplePod("b", "test"))
+			},
+			want: []recordedMetric{
+				{metric: "generated", resource: "v1/pods", eventType: addType},
+				{metric: "delivered", resource: "v1/pods", eventType: addType, sink: "http://sink.example.com", code: 200},
+				{metric: "generated", resource: "v1/pods", eventType: addType},
+				{metric: "dropped", resource: "v1/pods", eventType: addType, reason: source.DropReasonRateLimited},
+			},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			d, _ := makeResourceAndTestingClient()
+			metrics := &recordingMetrics{}
+			d.metrics = metrics
+			d.resource = "v1/pods"
+			d.sink = "http://sink.example.com"
+
+			tc.dispatch(d)
+
+			if diff := cmp.Diff(tc.want, metrics.recorded, cmp.AllowUnexported(recordedMetric{})); diff != "" {
+				t.Error("Unexpected metrics (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMetricsBatched(t *testing.T) {
+	d, _ := makeResourceAndTestingClient()
+	metrics := &recordingMetrics{}
+	d.metrics = metrics
+	d.resource = "v1/pods"
+	d.sink = "http://sink.example.com"
+	// The batches are sent by the delegate of no resource, as in the
+	// adapter.
+	sender := *d
+	sender.resource = ""
+	d.batcher = newBatcher(2, time.Hour, sender.sendCloudEvent, d.logger)
+
+	d.Add(simplePod("a", "test"))
+	time.Sleep(10 * time.Millisecond)
+	d.Add(simplePod("b", "test"))
+
+	var delivered *recordedMetric
+	for i, m := range metrics.recorded {
+		if m.metric == "delivered" {
+			delivered = &metrics.recorded[i]
+		}
+	}
+	if delivered == nil {
+		t.Fatal("Expected the batch to be delivered")
+	}
+	if delivered.resource != "v1/pods" {
+		t.Errorf("Expected the batch to be labeled with the resource of its events, got %q", delivered.resource)
+	}
+	if got := metrics.latency[0]; got < 10*time.Millisecond {
+		t.Errorf("Expected the latency of the batch to start at its first event, got %v", got)
+	}
+}
+
+func testMetricEvent(eventType string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetType(eventType)
+	event.SetSource("unit-test")
+	return event
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+)
+
+var namespacesGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// namespaceWatcher watches the namespaced resources in a changing set of
+// namespaces: the pinned ones, and the ones its reflector on the selected
+// namespaces adds. It implements cache.Store for that reflector.
+type namespaceWatcher struct {
+	// watchNamespace starts watching the resources in the namespace, until
+	// stopCh is closed.
+	watchNamespace func(namespace string, stopCh <-chan struct{})
+
+	mu      sync.Mutex
+	pinned  sets.String
+	watched map[string]chan struct{}
+	stopped bool
+}
+
+var _ cache.Store = (*namespaceWatcher)(nil)
+
+// newNamespaceWatcher returns a namespaceWatcher watching the namespaces with
+// watchNamespace, until stopCh is closed.
+func newNamespaceWatcher(watchNamespace func(namespace string, stopCh <-chan struct{}), stopCh <-chan struct{}) *namespaceWatcher {
+	w := &namespaceWatcher{
+		watchNamespace: watchNamespace,
+		pinned:         sets.NewString(),
+		watched:        make(map[string]chan struct{}),
+	}
+	go func() {
+		<-stopCh
+		w.stopAll()
+	}()
+	return w
+}
+
+// pin watches the namespace until the watcher is stopped, whether it is
+// selected or not.
+func (w *namespaceWatcher) pin(namespace string) {
+	w.mu.Lock()
+	w.pinned.Insert(namespace)
+	w.mu.Unlock()
+	w.start(namespace)
+}
+
+// start watches the namespace, unless it is already watched.
+func (w *namespaceWatcher) start(namespace string) {
+	w.mu.Lock()
+	if _, ok := w.watched[namespace]; ok || w.stopped {
+		w.mu.Unlock()
+		return
+	}
+	stopCh := make(chan struct{})
+	w.watched[namespace] = stopCh
+	w.mu.Unlock()
+
+	w.watchNamespace(namespace, stopCh)
+}
+
+// stop stops watching the namespace, unless it is pinned.
+func (w *namespaceWatcher) stop(namespace string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if stopCh, ok := w.watched[namespace]; ok && !w.pinned.Has(namespace) {
+		close(stopCh)
+		delete(w.watched, namespace)
+	}
+}
+
+// stopAll stops watching all the namespaces.
+func (w *namespaceWatcher) stopAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for namespace, stopCh := range w.watched {
+		close(stopCh)
+		delete(w.watched, namespace)
+	}
+	w.stopped = true
+}
+
+// namespaces returns the sorted watched namespaces.
+func (w *namespaceWatcher) namespaces() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	namespaces := sets.NewString()
+	for namespace := range w.watched {
+		namespaces.Insert(namespace)
+	}
+	return namespaces.List()
+}
+
+func namespaceName(obj interface{}) string {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return tombstone.Key
+	}
+	if o, err := meta.Accessor(obj); err == nil {
+		return o.GetName()
+	}
+	return ""
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) Add(obj interface{}) error {
+	if name := namespaceName(obj); name != "" {
+		w.start(name)
+	}
+	return nil
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) Update(obj interface{}) error {
+	return w.Add(obj)
+}
+
+// Implements cache.Store
+// The namespaces stop being selected when they are deleted, or when their
+// labels stop matching the selector.
+func (w *namespaceWatcher) Delete(obj interface{}) error {
+	if name := namespaceName(obj); name != "" {
+		w.stop(name)
+	}
+	return nil
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) List() []interface{} {
+	return nil
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) ListKeys() []string {
+	return nil
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) Get(obj interface{}) (item interface{}, exists bool, err error) {
+	return nil, false, nil
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) GetByKey(key string) (item interface{}, exists bool, err error) {
+	return nil, false, nil
+}
+
+// Implements cache.Store
+// Replace is called with all the selected namespaces, which are watched, and
+// stops watching the ones which are not selected anymore.
+func (w *namespaceWatcher) Replace(list []interface{}, resourceVersion string) error {
+	selected := sets.NewString()
+	for _, obj := range list {
+		if name := namespaceName(obj); name != "" {
+			selected.Insert(name)
+			w.start(name)
+		}
+	}
+	for _, namespace := range w.namespaces() {
+		if !selected.Has(namespace) {
+			w.stop(namespace)
+		}
+	}
+	return nil
+}
+
+// Implements cache.Store
+func (w *namespaceWatcher) Resync() error {
+	return nil
+}
+
+// permitted returns whether the objects of res can be listed. The resources
+// of the namespaces the ServiceAccount cannot list them in are not watched.
+func permitted(ctx context.Context, res dynamic.ResourceInterface, logger *zap.SugaredLogger) bool {
+	_, err := res.List(ctx, metav1.ListOptions{Limit: 1})
+	if apierrors.IsForbidden(err) {
+		logger.Warnw("Not permitted to watch the resource", zap.Error(err))
+		return false
+	}
+	// The reflector retries on the other errors.
+	return true
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/pkg/logging"
+	pkgtesting "knative.dev/pkg/reconciler/testing"
+)
+
+func TestNamespaceWatcher(t *testing.T) {
+	var mu sync.Mutex
+	started := map[string]int{}
+	stops := map[string]<-chan struct{}{}
+	stop := make(chan struct{})
+	w := newNamespaceWatcher(func(namespace string, stopCh <-chan struct{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		started[namespace]++
+		stops[namespace] = stopCh
+	}, stop)
+	stopped := func(namespace string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		select {
+		case <-stops[namespace]:
+			return true
+		default:
+			return false
+		}
+	}
+
+	w.pin("apps")
+	w.Replace([]interface{}{simpleNamespace("web"), simpleNamespace("dev")}, "1")
+	w.Add(simpleNamespace("web"))
+	w.Update(simpleNamespace("ops"))
+	if diff := cmp.Diff([]string{"apps", "dev", "ops", "web"}, w.namespaces()); diff != "" {
+		t.Error("unexpected watched namespaces (-want, +got) =", diff)
+	}
+	if got := started["web"]; got != 1 {
+		t.Errorf("web was watched %d times, want 1", got)
+	}
+
+	w.Delete(cache.DeletedFinalStateUnknown{Key: "web", Obj: simpleNamespace("web")})
+	w.Delete(simpleNamespace("apps"))
+	w.Replace([]interface{}{simpleNamespace("dev")}, "2")
+	if diff := cmp.Diff([]string{"apps", "dev"}, w.namespaces()); diff != "" {
+		t.Error("unexpected watched namespaces (-want, +got) =", diff)
+	}
+	for namespace, want := range map[string]bool{"apps": false, "dev": false, "ops": true, "web": true} {
+		if got := stopped(namespace); got != want {
+			t.Errorf("%s stopped = %t, want %t", namespace, got, want)
+		}
+	}
+
+	close(stop)
+	if err := wait(func() bool { return len(w.namespaces()) == 0 }); err != nil {
+		t.Fatal("namespaces still watched after the watcher was stopped")
+	}
+	if !stopped("apps") || !stopped("dev") {
+		t.Error("namespaces not stopped with the watcher")
+	}
+	w.Add(simpleNamespace("late"))
+	if got := w.namespaces(); len(got) != 0 {
+		t.Error("namespaces watched after the watcher was stopped:", got)
+	}
+}
+
+func TestAdapter_StartSelectedNamespaces(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+
+	config := Config{
+		Namespace:         "default",
+		Namespaces:        []string{"apps"},
+		NamespaceSelector: "team=web",
+		Resources: []ResourceWatch{{
+			GVR: schema.GroupVersionResource{
+				Version:  "v1",
+				Resource: "pods",
+			},
+		}},
+		EventMode: "Resource",
+	}
+	ctx, _ := pkgtesting.SetupFakeContext(t)
+
+	sc := runtime.NewScheme()
+	labeled := func(ns *unstructured.Unstructured) *unstructured.Unstructured {
+		ns.SetLabels(map[string]string{"team": "web"})
+		return ns
+	}
+	k8s := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(sc, map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "pods"}:       "PodList",
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+	}, labeled(simpleNamespace("web")), labeled(simpleNamespace("secret")), simpleNamespace("other"))
+	k8s.PrependReactor("list", "pods", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "secret" {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", nil)
+		}
+		return false, nil, nil
+	})
+
+	a := &apiServerAdapter{
+		ce:     ce,
+		logger: logging.FromContext(ctx),
+		config: config,
+
+		discover: makeDiscoveryClient(),
+		k8s:      k8s,
+		source:   "unit-test",
+		name:     "unittest",
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go a.Start(ctx)
+
+	// Wait for the reflectors to be fully initialized.
+	time.Sleep(1 * time.Second)
+
+	pods := k8s.Resource(config.Resources[0].GVR)
+	for _, namespace := range []string{"apps", "web", "secret", "other"} {
+		if _, err := pods.Namespace(namespace).Create(ctx, simplePod("unit", namespace), metav1.CreateOptions{}); err != nil {
+			t.Fatal("Create() =", err)
+		}
+	}
+
+	if err := wait(func() bool { return len(ce.Sent()) >= 2 }); err != nil {
+		t.Fatal("Expected 2 events to be sent, got:", len(ce.Sent()))
+	}
+	// Give the events of the other namespaces time to show up.
+	time.Sleep(500 * time.Millisecond)
+
+	var got []string
+	for _, event := range ce.Sent() {
+		got = append(got, event.Extensions()["namespace"].(string))
+	}
+	sort.Strings(got)
+	if diff := cmp.Diff([]string{"apps", "web"}, got); diff != "" {
+		t.Error("unexpected namespaces of the events (-want, +got) =", diff)
+	}
+
+	// The namespaces selected after the start are watched too.
+	if _, err := k8s.Resource(namespacesGVR).Create(ctx, labeled(simpleNamespace("late")), metav1.CreateOptions{}); err != nil {
+		t.Fatal("Create() =", err)
+	}
+	time.Sleep(500 * time.Millisecond)
+	if _, err := pods.Namespace("late").Create(ctx, simplePod("unit", "late"), metav1.CreateOptions{}); err != nil {
+		t.Fatal("Create() =", err)
+	}
+	if err := wait(func() bool { return len(ce.Sent()) >= 3 }); err != nil {
+		t.Fatal("Expected 3 events to be sent, got:", len(ce.Sent()))
+	}
+	if got := ce.Sent()[2].Extensions()["namespace"]; got != "late" {
+		t.Errorf("Expected the event of a pod in %q, got %q", "late", got)
+	}
+}
+
+// wait polls condition until it is met, or a few seconds passed.
+func wait(condition func() bool) error {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			return context.DeadlineExceeded
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ownerResolver walks the controller owner references of the objects up to
+// their top controller. The owners are looked up in the informer cache of
+// their resource, which is started the first time an owner of its kind is
+// met.
+type ownerResolver struct {
+	ctx      context.Context
+	stopCh   <-chan struct{}
+	discover discovery.DiscoveryInterface
+	k8s      dynamic.Interface
+	maxDepth int
+	logger   *zap.SugaredLogger
+
+	mu      sync.Mutex
+	listers map[schema.GroupVersionKind]*ownerLister
+}
+
+// ownerLister lists the owners of a kind.
+type ownerLister struct {
+	// lister is nil when the owners of the kind cannot be listed.
+	lister     cache.GenericLister
+	namespaced bool
+}
+
+func newOwnerResolver(ctx context.Context, stopCh <-chan struct{}, discover discovery.DiscoveryInterface, k8s dynamic.Interface, maxDepth int, logger *zap.SugaredLogger) *ownerResolver {
+	return &ownerResolver{
+		ctx:      ctx,
+		stopCh:   stopCh,
+		discover: discover,
+		k8s:      k8s,
+		maxDepth: maxDepth,
+		logger:   logger,
+		listers:  make(map[schema.GroupVersionKind]*ownerLister),
+	}
+}
+
+// top returns the reference of the top controller of obj, walking at most
+// maxDepth owner references, or nil when obj has no controller. An owner
+// which cannot be looked up ends the walk.
+func (r *ownerResolver) top(obj *unstructured.Unstructured) *corev1.ObjectReference {
+	var top *corev1.ObjectReference
+	var current metav1.Object = obj
+	for depth := 0; depth < r.maxDepth && current != nil; depth++ {
+		controller := metav1.GetControllerOf(current)
+		if controller == nil {
+			break
+		}
+		// Owners are either in the namespace of their dependents, or
+		// cluster-scoped.
+		namespace := obj.GetNamespace()
+		var owner *unstructured.Unstructured
+		if l := r.lister(controller); l != nil {
+			if !l.namespaced {
+				namespace = ""
+			}
+			owner = l.get(namespace, controller.Name)
+		}
+		top = &corev1.ObjectReference{
+			APIVersion: controller.APIVersion,
+			Kind:       controller.Kind,
+			Name:       controller.Name,
+			Namespace:  namespace,
+		}
+		if owner == nil {
+			break
+		}
+		current = owner
+	}
+	return top
+}
+
+// lister returns the lister of the owners of the kind of ref, or nil when
+// the kind is unknown. The first lookup of a kind holds the other lookups
+// until the cache of its owners is synced.
+func (r *ownerResolver) lister(ref *metav1.OwnerReference) *ownerLister {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil
+	}
+	gvk := gv.WithKind(ref.Kind)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if l, ok := r.listers[gvk]; ok {
+		return l
+	}
+	l := r.newLister(gvk)
+	r.listers[gvk] = l
+	return l
+}
+
+func (r *ownerResolver) newLister(gvk schema.GroupVersionKind) *ownerLister {
+	resources, err := r.discover.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		r.logger.Warnw("Could not retrieve information about the owner kind", zap.String("kind", gvk.String()), zap.Error(err))
+		return nil
+	}
+	for _, apires := range resources.APIResources {
+		// Subresources share the kind of their resource.
+		if apires.Kind != gvk.Kind || strings.Contains(apires.Name, "/") {
+			continue
+		}
+		gvr := gvk.GroupVersion().WithResource(apires.Name)
+		l := &ownerLister{namespaced: apires.Namespaced}
+
+		res := r.k8s.Resource(gvr)
+		if _, err := res.List(r.ctx, metav1.ListOptions{Limit: 1}); err != nil {
+			r.logger.Warnw("Could not list the owners, they are not walked", zap.String("resource", gvr.String()), zap.Error(err))
+			return l
+		}
+		lw := &cache.ListWatch{
+			ListFunc:  asUnstructuredLister(r.ctx, res.List, "", ""),
+			WatchFunc: asUnstructuredWatcher(r.ctx, res.Watch, "", ""),
+		}
+		informer := cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 0,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		go informer.Run(r.stopCh)
+
+		if cache.WaitForCacheSync(r.stopCh, informer.HasSynced) {
+			l.lister = cache.NewGenericLister(informer.GetIndexer(), gvr.GroupResource())
+		}
+		return l
+	}
+	return nil
+}
+
+// get returns the owner named name in namespace, or nil if it is unknown.
+func (l *ownerLister) get(namespace, name string) *unstructured.Unstructured {
+	if l.lister == nil {
+		return nil
+	}
+	var lister cache.GenericNamespaceLister = l.lister
+	if l.namespaced {
+		lister = l.lister.ByNamespace(namespace)
+	}
+	obj, err := lister.Get(name)
+	if err != nil {
+		return nil
+	}
+	u, _ := obj.(*unstructured.Unstructured)
+	return u
+}
+
+// ownerDeduplicator drops the events of a same type attributed to a same
+// owner within the window of the first of them.
+type ownerDeduplicator struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	seen  map[string]time.Time
+	swept time.Time
+}
+
+func newOwnerDeduplicator(window time.Duration) *ownerDeduplicator {
+	return &ownerDeduplicator{
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// duplicate reports whether event is a duplicate of an event of the same
+// type attributed to the same owner within the window. The events which are
+// not attributed to an owner are never duplicates.
+func (d *ownerDeduplicator) duplicate(event cloudevents.Event) bool {
+	owner, ok := event.Extensions()["owner"]
+	if !ok {
+		return false
+	}
+	key := fmt.Sprintf("%s %v", event.Type(), owner)
+	now := d.now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if first, ok := d.seen[key]; ok && now.Sub(first) < d.window {
+		return true
+	}
+	d.seen[key] = now
+
+	// The owners whose window is over are forgotten once per window.
+	if now.Sub(d.swept) >= d.window {
+		for k, first := range d.seen {
+			if now.Sub(first) >= d.window {
+				delete(d.seen, k)
+			}
+		}
+		d.swept = now
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestOwnerResolverTop(t *testing.T) {
+	tests := map[string]struct {
+		obj      *unstructured.Unstructured
+		maxDepth int
+		want     *corev1.ObjectReference
+	}{
+		"no controller": {
+			obj:      simplePod("unit", "test"),
+			maxDepth: 5,
+		},
+		"top controller": {
+			obj:      ownedObject("v1", "Pod", "app-1234-abcd", "test", "apps/v1", "ReplicaSet", "app-1234"),
+			maxDepth: 5,
+			want:     &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Namespace: "test"},
+		},
+		"max depth": {
+			obj:      ownedObject("v1", "Pod", "app-1234-abcd", "test", "apps/v1", "ReplicaSet", "app-1234"),
+			maxDepth: 1,
+			want:     &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-1234", Namespace: "test"},
+		},
+		"unknown owner": {
+			obj:      ownedObject("v1", "Pod", "other-abcd", "test", "apps/v1", "ReplicaSet", "other"),
+			maxDepth: 5,
+			want:     &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "other", Namespace: "test"},
+		},
+		"unknown owner kind": {
+			obj:      ownedObject("v1", "Pod", "job-abcd", "test", "batch/v1", "Job", "job"),
+			maxDepth: 5,
+			want:     &corev1.ObjectReference{APIVersion: "batch/v1", Kind: "Job", Name: "job", Namespace: "test"},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			r := makeTestOwnerResolver(t, tc.maxDepth)
+			if diff := cmp.Diff(tc.want, r.top(tc.obj)); diff != "" {
+				t.Error("unexpected top controller (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestResourceOwnerDeduplication(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.owners = makeTestOwnerResolver(t, 5)
+	d.dedup = newOwnerDeduplicator(time.Minute)
+
+	d.Update(ownedObject("v1", "Pod", "app-1234-abcd", "test", "apps/v1", "ReplicaSet", "app-1234"))
+	d.Update(ownedObject("v1", "Pod", "app-1234-efgh", "test", "apps/v1", "ReplicaSet", "app-1234"))
+	d.Delete(ownedObject("v1", "Pod", "app-1234-efgh", "test", "apps/v1", "ReplicaSet", "app-1234"))
+	d.Update(simplePod("unit", "test"))
+	d.Update(simplePod("unit", "test"))
+
+	sent := ce.Sent()
+	if len(sent) != 4 {
+		t.Fatalf("Expected 4 events to be sent, got %d", len(sent))
+	}
+	want := []string{
+		"/apis/apps/v1/namespaces/test/deployments/app",
+		"/apis/apps/v1/namespaces/test/deployments/app",
+		"/apis/v1/namespaces/test/pods/unit",
+		"/apis/v1/namespaces/test/pods/unit",
+	}
+	for i, event := range sent {
+		if got := event.Subject(); got != want[i] {
+			t.Errorf("Expected event %d with subject %q, got %q", i, want[i], got)
+		}
+	}
+}
+
+func TestOwnerDeduplicator(t *testing.T) {
+	now := time.Now()
+	d := newOwnerDeduplicator(time.Minute)
+	d.now = func() time.Time { return now }
+
+	event := func(typ, owner string) cloudevents.Event {
+		e := cloudevents.NewEvent()
+		e.SetType(typ)
+		if owner != "" {
+			e.SetExtension("owner", owner)
+		}
+		return e
+	}
+
+	steps := []struct {
+		event   cloudevents.Event
+		elapsed time.Duration
+		want    bool
+	}{
+		{event: event("update", "/apis/apps/v1/namespaces/test/deployments/app")},
+		{event: event("update", "/apis/apps/v1/namespaces/test/deployments/app"), elapsed: 30 * time.Second, want: true},
+		{event: event("delete", "/apis/apps/v1/namespaces/test/deployments/app")},
+		{event: event("update", "/apis/apps/v1/namespaces/test/deployments/other")},
+		{event: event("update", "")},
+		{event: event("update", "")},
+		{event: event("update", "/apis/apps/v1/namespaces/test/deployments/app"), elapsed: 31 * time.Second},
+		{event: event("update", "/apis/apps/v1/namespaces/test/deployments/app"), elapsed: time.Second, want: true},
+		{event: event("update", "/apis/apps/v1/namespaces/test/deployments/another"), elapsed: time.Minute},
+	}
+	for i, step := range steps {
+		now = now.Add(step.elapsed)
+		if got := d.duplicate(step.event); got != step.want {
+			t.Errorf("duplicate() of step %d = %t, want %t", i, got, step.want)
+		}
+	}
+	if got := len(d.seen); got != 1 {
+		t.Errorf("Expected the owners whose window is over to be forgotten, got %d owners", got)
+	}
+}
+
+func makeTestOwnerResolver(t *testing.T, maxDepth int) *ownerResolver {
+	t.Helper()
+	discover := &discoveryfake.FakeDiscovery{
+		Fake: &kubetesting.Fake{
+			Resources: []*metav1.APIResourceList{{
+				GroupVersion: "apps/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "deployments", Namespaced: true, Kind: "Deployment"},
+					{Name: "deployments/scale", Namespaced: true, Kind: "Scale", Group: "autoscaling", Version: "v1"},
+					{Name: "replicasets", Namespaced: true, Kind: "ReplicaSet"},
+				},
+			}},
+		},
+	}
+	k8s := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+			{Group: "apps", Version: "v1", Resource: "replicasets"}: "ReplicaSetList",
+		},
+		ownedObject("apps/v1", "ReplicaSet", "app-1234", "test", "apps/v1", "Deployment", "app"),
+		ownedObject("apps/v1", "Deployment", "app", "test", "", "", ""),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return newOwnerResolver(ctx, ctx.Done(), discover, k8s, maxDepth, zap.NewExample().Sugar())
+}
+
+// ownedObject returns an object controlled by the owner of ownerKind named
+// ownerName, or without owner when ownerKind is empty.
+func ownedObject(apiVersion, kind, name, namespace, ownerAPIVersion, ownerKind, ownerName string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	if ownerKind != "" {
+		controller := true
+		obj.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: ownerAPIVersion,
+			Kind:       ownerKind,
+			Name:       ownerName,
+			Controller: &controller,
+		}})
+	}
+	return obj
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+)
+
+const (
+	// PausedKey is the key of the pause ConfigMap set to whether the emission
+	// of the events is paused.
+	PausedKey = "paused"
+	// ReplayOnResumeKey is the key of the pause ConfigMap set to whether the
+	// events of the objects which changed while paused are replayed on
+	// resume.
+	ReplayOnResumeKey = "replayOnResume"
+)
+
+// pauser pauses and resumes the emission of the events as its ConfigMap
+// says. The resources are still watched while paused, so their last seen
+// state stays up to date, and the events of the objects which changed while
+// paused are replayed on resume when the ConfigMap says so.
+type pauser struct {
+	configMaps corev1client.ConfigMapInterface
+	name       string
+	logger     *zap.SugaredLogger
+
+	mu     sync.Mutex
+	paused bool
+	replay bool
+	// missed are the last seen states of the objects which changed while
+	// paused, by delegate and key. They are only recorded when they are
+	// replayed on resume.
+	missed map[*resourceDelegate]map[string]missedObject
+}
+
+// missedObject is the last seen state of an object which changed while
+// paused.
+type missedObject struct {
+	obj     interface{}
+	deleted bool
+}
+
+func newPauser(configMaps corev1client.ConfigMapInterface, name string, logger *zap.SugaredLogger) *pauser {
+	return &pauser{
+		configMaps: configMaps,
+		name:       name,
+		logger:     logger,
+		missed:     make(map[*resourceDelegate]map[string]missedObject),
+	}
+}
+
+// load reads the pause from its ConfigMap. The events are emitted when it
+// does not exist.
+func (p *pauser) load(ctx context.Context) error {
+	cm, err := p.configMaps.Get(ctx, p.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	p.update(cm)
+	return nil
+}
+
+// run keeps the pause in sync with its ConfigMap until stopCh is closed.
+func (p *pauser) run(ctx context.Context, stopCh <-chan struct{}) {
+	selector := fields.OneTermEqualSelector("metadata.name", p.name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.FieldSelector = selector
+			return p.configMaps.List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.FieldSelector = selector
+			return p.configMaps.Watch(ctx, opts)
+		},
+	}
+	_, informer := cache.NewInformer(lw, &corev1.ConfigMap{}, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc:    p.update,
+		UpdateFunc: func(_, obj interface{}) { p.update(obj) },
+		// The events are emitted again once the ConfigMap is gone.
+		DeleteFunc: func(interface{}) { p.set(false, false) },
+	})
+	informer.Run(stopCh)
+}
+
+func (p *pauser) update(obj interface{}) {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok || cm.Name != p.name {
+		return
+	}
+	p.set(cm.Data[PausedKey] == "true", cm.Data[ReplayOnResumeKey] == "true")
+}
+
+// set pauses or resumes the emission of the events. The events of the
+// objects which changed while paused are replayed on resume when replay is
+// true.
+func (p *pauser) set(paused, replay bool) {
+	p.mu.Lock()
+	resumed := p.paused && !paused
+	if resumed {
+		p.logger.Infow("Resuming the emission of the events", zap.Bool("replay", replay))
+	} else if !p.paused && paused {
+		p.logger.Infow("Pausing the emission of the events", zap.Bool("replayOnResume", replay))
+	}
+	p.paused, p.replay = paused, replay
+	var missed map[*resourceDelegate]map[string]missedObject
+	if !paused || !replay {
+		missed, p.missed = p.missed, make(map[*resourceDelegate]map[string]missedObject)
+	}
+	p.mu.Unlock()
+
+	if !resumed || !replay {
+		return
+	}
+	for rd, objects := range missed {
+		for _, m := range objects {
+			rd.resumed(m)
+		}
+	}
+}
+
+// holds returns whether the events are held, as their emission is paused.
+// No event is held when p is nil.
+func (p *pauser) holds() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+// record records obj, seen by rd, as missed when the emission is paused and
+// the missed objects are replayed on resume. Like holds, it does nothing on a
+// nil pauser.
+func (p *pauser) record(rd *resourceDelegate, obj interface{}, deleted bool) {
+	if p == nil {
+		return
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused || !p.replay {
+		return
+	}
+	objects, ok := p.missed[rd]
+	if !ok {
+		objects = make(map[string]missedObject)
+		p.missed[rd] = objects
+	}
+	objects[key] = missedObject{obj: obj, deleted: deleted}
+}
+
+// resumed sends the event of the missed object m on resume: the add event of
+// its last seen state, or its delete event when it was deleted.
+func (a *resourceDelegate) resumed(m missedObject) {
+	if !m.deleted {
+		if u, ok := m.obj.(*unstructured.Unstructured); ok {
+			a.replay(u)
+		}
+		return
+	}
+	ctx, event, err := events.MakeDeleteEvent(a.source, a.apiServerSourceName, m.obj, a.ref, a.withOwner(a.opts, m.obj)...)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.dispatch(ctx, event)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func TestPauserNil(t *testing.T) {
+	var p *pauser
+	if p.holds() {
+		t.Error("nil pauser holds() = true, want false")
+	}
+	p.record(nil, simplePod("unit", "test"), false)
+}
+
+func TestPauserHolds(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.pause = newPauser(nil, "pause", zap.NewExample().Sugar())
+
+	d.pause.set(true, false)
+	if err := d.Add(simplePod("unit", "test")); err != nil {
+		t.Fatal("Add() =", err)
+	}
+	validateNotSent(t, ce, sources.ApiServerSourceAddEventType)
+
+	// Nothing is replayed on resume.
+	d.pause.set(false, false)
+	validateNotSent(t, ce, sources.ApiServerSourceAddEventType)
+
+	if err := d.Update(simplePod("unit", "test")); err != nil {
+		t.Fatal("Update() =", err)
+	}
+	validateSent(t, ce, sources.ApiServerSourceUpdateEventType)
+}
+
+func TestPauserReplayOnResume(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.pause = newPauser(nil, "pause", zap.NewExample().Sugar())
+
+	d.pause.set(true, true)
+	if err := d.Add(simplePod("added", "test")); err != nil {
+		t.Fatal("Add() =", err)
+	}
+	if err := d.Update(simplePod("added", "test")); err != nil {
+		t.Fatal("Update() =", err)
+	}
+	if err := d.Delete(simplePod("deleted", "test")); err != nil {
+		t.Fatal("Delete() =", err)
+	}
+	if got := len(ce.Sent()); got != 0 {
+		t.Fatalf("Expected no event to be sent while paused, got %d", got)
+	}
+
+	// The last state of each missed object is replayed.
+	d.pause.set(false, true)
+	var got []string
+	for _, event := range ce.Sent() {
+		got = append(got, event.Type()+" "+event.Subject())
+	}
+	sort.Strings(got)
+	want := []string{
+		sources.ApiServerSourceAddEventType + " /apis/v1/namespaces/test/pods/added",
+		sources.ApiServerSourceDeleteEventType + " /apis/v1/namespaces/test/pods/deleted",
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Replayed events = %v, want %v", got, want)
+	}
+
+	// The missed objects are replayed once.
+	ce.Reset()
+	d.pause.set(true, true)
+	d.pause.set(false, true)
+	if got := len(ce.Sent()); got != 0 {
+		t.Errorf("Expected no event to be replayed again, got %d", got)
+	}
+}
+
+func TestPauserRun(t *testing.T) {
+	ctx := context.Background()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pause"},
+		Data:       map[string]string{PausedKey: "true"},
+	}
+	kube := kubefake.NewSimpleClientset(cm)
+	p := newPauser(kube.CoreV1().ConfigMaps("test"), "pause", zap.NewExample().Sugar())
+
+	if err := p.load(ctx); err != nil {
+		t.Fatal("load() =", err)
+	}
+	if !p.holds() {
+		t.Fatal("Expected the events to be held once loaded")
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go p.run(ctx, stopCh)
+
+	eventually := func(want bool) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for p.holds() != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("holds() = %t, want %t", !want, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	resumed := cm.DeepCopy()
+	resumed.Data[PausedKey] = "false"
+	if _, err := kube.CoreV1().ConfigMaps("test").Update(ctx, resumed, metav1.UpdateOptions{}); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	eventually(false)
+
+	if _, err := kube.CoreV1().ConfigMaps("test").Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	eventually(true)
+
+	// The events are emitted again once the ConfigMap is gone.
+	if err := kube.CoreV1().ConfigMaps("test").Delete(ctx, "pause", metav1.DeleteOptions{}); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	eventually(false)
+}
+
+func TestPauserLoadNotFound(t *testing.T) {
+	p := newPauser(kubefake.NewSimpleClientset().CoreV1().ConfigMaps("test"), "pause", zap.NewExample().Sugar())
+	if err := p.load(context.Background()); err != nil {
+		t.Fatal("load() =", err)
+	}
+	if p.holds() {
+		t.Error("Expected no event to be held without ConfigMap")
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+const (
+	// ThrottledKey is the key of the throttling ConfigMap set to whether
+	// events went above the rate limit in the last report interval.
+	ThrottledKey = "throttled"
+	// ThrottledSinceKey is the key of the throttling ConfigMap set to the
+	// time the events went above, or back within, the rate limit.
+	ThrottledSinceKey = "since"
+
+	// defaultThrottleReportInterval is how often the throttling of the
+	// events is reported.
+	defaultThrottleReportInterval = 10 * time.Second
+)
+
+// rateLimiter sends the events through a token bucket, and holds, drops or
+// dead letters the events above it according to its overflow policy. It
+// reports whether it throttled events in the last interval in a ConfigMap.
+type rateLimiter struct {
+	limiter    *rate.Limiter
+	policy     string
+	configMaps corev1client.ConfigMapInterface
+	name       string
+	interval   time.Duration
+	logger     *zap.SugaredLogger
+
+	mu sync.Mutex
+	// overflowed is whether events went above the limit since the last
+	// report.
+	overflowed bool
+	// throttled is the last reported throttling, which is not reported
+	// again until it changes, once reported is true.
+	throttled bool
+	reported  bool
+}
+
+func newRateLimiter(cfg *RateLimitConfig, configMaps corev1client.ConfigMapInterface, interval time.Duration, logger *zap.SugaredLogger) *rateLimiter {
+	burst := int(cfg.Burst)
+	if burst == 0 {
+		burst = int(cfg.EventsPerSecond)
+	}
+	policy := cfg.OverflowPolicy
+	if policy == "" {
+		policy = v1.OverflowPolicyBlock
+	}
+	return &rateLimiter{
+		limiter:    rate.NewLimiter(rate.Limit(cfg.EventsPerSecond), burst),
+		policy:     policy,
+		configMaps: configMaps,
+		name:       cfg.ConfigMapName,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// allow returns whether an event can be sent now, waiting for the limit with
+// the Block policy. The events above the limit with the other policies are
+// not to be sent to the sink. Every event is allowed when r is nil.
+func (r *rateLimiter) allow(ctx context.Context) bool {
+	if r == nil || r.limiter.Allow() {
+		return true
+	}
+	r.mu.Lock()
+	r.overflowed = true
+	r.mu.Unlock()
+	if r.policy != v1.OverflowPolicyBlock {
+		return false
+	}
+	if err := r.limiter.Wait(ctx); err != nil {
+		r.logger.Warnw("Sending the event above the rate limit", zap.Error(err))
+	}
+	return true
+}
+
+// run reports the throttling every interval until stopCh is closed.
+func (r *rateLimiter) run(ctx context.Context, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.report(ctx)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// report writes the throttling of the last interval to its ConfigMap when it
+// changed.
+func (r *rateLimiter) report(ctx context.Context) {
+	r.mu.Lock()
+	throttled := r.overflowed
+	r.overflowed = false
+	if r.reported && throttled == r.throttled {
+		r.mu.Unlock()
+		return
+	}
+	r.mu.Unlock()
+
+	data := map[string]string{
+		ThrottledKey:      strconv.FormatBool(throttled),
+		ThrottledSinceKey: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := r.write(ctx, data); err != nil {
+		r.logger.Errorw("Failed to report the throttling", zap.String("configMap", r.name), zap.Error(err))
+		return
+	}
+	if throttled {
+		r.logger.Infow("The events are above the rate limit", zap.String("policy", r.policy))
+	}
+	r.mu.Lock()
+	r.throttled = throttled
+	r.reported = true
+	r.mu.Unlock()
+}
+
+func (r *rateLimiter) write(ctx context.Context, data map[string]string) error {
+	cm, err := r.configMaps.Get(ctx, r.name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	cm.Data = data
+	_, err = r.configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
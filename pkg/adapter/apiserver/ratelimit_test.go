@@ -0,0 +1,218 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/metrics/source"
+)
+
+// countingReporter counts the events reported to it.
+type countingReporter struct {
+	count int
+}
+
+func (r *countingReporter) ReportEventCount(*source.ReportArgs, int) error {
+	r.count++
+	return nil
+}
+
+func (r *countingReporter) ReportRetryEventCount(*source.ReportArgs, int) error {
+	return nil
+}
+
+func makeTestRateLimiter(eventsPerSecond, burst int32, policy string) *rateLimiter {
+	return newRateLimiter(&RateLimitConfig{
+		ApiServerSourceRateLimit: v1.ApiServerSourceRateLimit{
+			EventsPerSecond: eventsPerSecond,
+			Burst:           burst,
+			OverflowPolicy:  policy,
+		},
+		ConfigMapName: "throttling",
+	}, nil, time.Second, zap.NewExample().Sugar())
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+
+	var nilLimiter *rateLimiter
+	if !nilLimiter.allow(ctx) {
+		t.Error("nil rate limiter allow() = false, want true")
+	}
+
+	drop := makeTestRateLimiter(1, 2, v1.OverflowPolicyDrop)
+	for i, want := range []bool{true, true, false} {
+		if got := drop.allow(ctx); got != want {
+			t.Errorf("event %d: allow() = %t, want %t", i, got, want)
+		}
+	}
+	if !drop.overflowed {
+		t.Error("Expected the rate limiter to have overflowed")
+	}
+
+	block := makeTestRateLimiter(50, 1, v1.OverflowPolicyBlock)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if !block.allow(ctx) {
+			t.Fatalf("event %d: allow() = false, want true", i)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected the events above the limit to be held, they were sent in %v", elapsed)
+	}
+	if !block.overflowed {
+		t.Error("Expected the rate limiter to have overflowed")
+	}
+}
+
+func TestRateLimiterReport(t *testing.T) {
+	ctx := context.Background()
+	kube := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "throttling"},
+	})
+	r := makeTestRateLimiter(1, 1, v1.OverflowPolicyDrop)
+	r.configMaps = kube.CoreV1().ConfigMaps("test")
+
+	throttled := func() string {
+		t.Helper()
+		cm, err := kube.CoreV1().ConfigMaps("test").Get(ctx, "throttling", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if cm.Data[ThrottledSinceKey] == "" {
+			t.Errorf("Expected the time of the throttling to be reported, got %v", cm.Data)
+		}
+		return cm.Data[ThrottledKey]
+	}
+	updates := func() int {
+		n := 0
+		for _, action := range kube.Actions() {
+			if action.GetVerb() == "update" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The absence of throttling is reported once.
+	r.report(ctx)
+	if got := throttled(); got != "false" {
+		t.Errorf("throttled = %q, want false", got)
+	}
+	r.report(ctx)
+	if got := updates(); got != 1 {
+		t.Errorf("Expected 1 update of the ConfigMap, got %d", got)
+	}
+
+	r.allow(ctx)
+	r.allow(ctx)
+	r.report(ctx)
+	if got := throttled(); got != "true" {
+		t.Errorf("throttled = %q, want true", got)
+	}
+
+	// The events are back within the limit in the next interval.
+	r.report(ctx)
+	if got := throttled(); got != "false" {
+		t.Errorf("throttled = %q, want false", got)
+	}
+	if got := updates(); got != 3 {
+		t.Errorf("Expected 3 updates of the ConfigMap, got %d", got)
+	}
+}
+
+func TestResourceRateLimitDrop(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.limiter = makeTestRateLimiter(1, 1, v1.OverflowPolicyDrop)
+	reporter := &countingReporter{}
+	d.rateLimited = reporter
+
+	d.Add(simplePod("a", "test"))
+	d.Add(simplePod("b", "test"))
+
+	if got := len(ce.Sent()); got != 1 {
+		t.Errorf("Expected 1 event to be sent, got %d", got)
+	}
+	if reporter.count != 1 {
+		t.Errorf("Expected 1 event to be reported as rate limited, got %d", reporter.count)
+	}
+}
+
+func TestResourceRateLimitDeadLetter(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	dls := adaptertest.NewTestClient()
+	d.deadLetter = dls
+	d.sink = "http://sink.example.com"
+	d.limiter = makeTestRateLimiter(1, 1, v1.OverflowPolicyDeadLetter)
+	reporter := &countingReporter{}
+	d.rateLimited = reporter
+
+	d.Add(simplePod("a", "test"))
+	d.Add(simplePod("b", "test"))
+
+	if got := len(ce.Sent()); got != 1 {
+		t.Errorf("Expected 1 event to be sent to the sink, got %d", got)
+	}
+	sent := dls.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent to the dead letter sink, got %d", len(sent))
+	}
+	if got := sent[0].Subject(); got != "/apis/v1/namespaces/test/pods/b" {
+		t.Errorf("Expected the event of pod b to be dead lettered, got %q", got)
+	}
+	if got := sent[0].Extensions()["knativeerrorcode"]; got != int32(429) {
+		t.Errorf("Expected knativeerrorcode 429, got %v", got)
+	}
+	if got := sent[0].Extensions()["knativeerrordest"]; got != "http://sink.example.com" {
+		t.Errorf("Expected knativeerrordest %q, got %v", "http://sink.example.com", got)
+	}
+	if reporter.count != 0 {
+		t.Errorf("Expected no event to be reported as rate limited, got %d", reporter.count)
+	}
+}
+
+func TestResourceRateLimitAfterFilter(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.limiter = makeTestRateLimiter(1, 1, v1.OverflowPolicyDrop)
+	d.filter = subscriptionsapi.CreateSubscriptionsAPIFilters(context.Background(), []eventingv1.SubscriptionsAPIFilter{{
+		Exact: map[string]string{"subject": "/apis/v1/namespaces/test/pods/b"},
+	}})
+
+	// The filtered events do not use up the limit.
+	d.Add(simplePod("a", "test"))
+	d.Add(simplePod("b", "test"))
+
+	sent := ce.Sent()
+	if len(sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+	}
+	if got := sent[0].Subject(); got != "/apis/v1/namespaces/test/pods/b" {
+		t.Errorf("Expected the event of pod b to be sent, got %q", got)
+	}
+}
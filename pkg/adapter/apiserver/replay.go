@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+)
+
+const (
+	// replayPath is the path of the management endpoint of the adapter which
+	// replays the events of the objects it last saw.
+	replayPath = "/replay"
+
+	// replayAddr is the address the replay endpoint listens on. As it is not
+	// authenticated, it only listens on the loopback interface of the pod of
+	// the adapter, e.g. for kubectl port-forward, unlike the health probes.
+	replayAddr = "127.0.0.1:8081"
+)
+
+// replayer re-emits, on demand, the add events of the objects last seen by
+// the delegates of the watched resources, e.g. so a downstream system
+// recovering from a loss gets them all again. It serves POST requests on
+// replayPath, whose kind, namespace, name and labelSelector query parameters
+// select the objects replayed. The objects of every kind are replayed when
+// kind is unset, and likewise for the other parameters. A single replay runs
+// at a time, the requests received during a replay are rejected.
+type replayer struct {
+	// stopCh stops the replays in progress when it is closed.
+	stopCh <-chan struct{}
+
+	mu        sync.Mutex
+	delegates map[*resourceDelegate]struct{}
+	// running is whether a replay is in progress.
+	running bool
+
+	// replaying is the group of the replays in progress.
+	replaying sync.WaitGroup
+
+	logger *zap.SugaredLogger
+}
+
+var _ http.Handler = (*replayer)(nil)
+
+func newReplayer(stopCh <-chan struct{}, logger *zap.SugaredLogger) *replayer {
+	return &replayer{
+		stopCh:    stopCh,
+		delegates: make(map[*resourceDelegate]struct{}),
+		logger:    logger,
+	}
+}
+
+// register replays the objects last seen by rd, until stopCh is closed.
+func (r *replayer) register(rd *resourceDelegate, stopCh <-chan struct{}) {
+	r.mu.Lock()
+	r.delegates[rd] = struct{}{}
+	r.mu.Unlock()
+	go func() {
+		<-stopCh
+		r.mu.Lock()
+		delete(r.delegates, rd)
+		r.mu.Unlock()
+	}()
+}
+
+// replaySelector selects the objects replayed.
+type replaySelector struct {
+	kind      string
+	namespace string
+	name      string
+	labels    labels.Selector
+}
+
+func (s *replaySelector) matches(obj *unstructured.Unstructured) bool {
+	return (s.kind == "" || strings.EqualFold(s.kind, obj.GetKind())) &&
+		(s.namespace == "" || s.namespace == obj.GetNamespace()) &&
+		(s.name == "" || s.name == obj.GetName()) &&
+		s.labels.Matches(labels.Set(obj.GetLabels()))
+}
+
+// replayResponse is the body of the responses to the replay requests.
+type replayResponse struct {
+	// Replayed is the number of objects whose events are replayed.
+	Replayed int `json:"replayed"`
+}
+
+func (r *replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	query := req.URL.Query()
+	selector, err := labels.Parse(query.Get("labelSelector"))
+	if err != nil {
+		http.Error(w, "invalid labelSelector: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	s := &replaySelector{
+		kind:      query.Get("kind"),
+		namespace: query.Get("namespace"),
+		name:      query.Get("name"),
+		labels:    selector,
+	}
+
+	replays, ok := r.start(s)
+	if !ok {
+		http.Error(w, "a replay is in progress", http.StatusConflict)
+		return
+	}
+	r.logger.Infow("Replaying the events of the objects",
+		zap.String("kind", s.kind), zap.String("namespace", s.namespace), zap.String("name", s.name),
+		zap.Stringer("labelSelector", s.labels), zap.Int("count", len(replays)))
+	// The events are sent in the background, as sending a whole cache may
+	// take longer than the caller waits for.
+	r.replaying.Add(1)
+	go func() {
+		defer r.replaying.Done()
+		defer r.finish()
+		for _, replay := range replays {
+			select {
+			case <-r.stopCh:
+				return
+			default:
+				replay()
+			}
+		}
+	}()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(replayResponse{Replayed: len(replays)})
+}
+
+// wait waits for the replays in progress to complete.
+func (r *replayer) wait() {
+	r.replaying.Wait()
+}
+
+// finish records the end of the replay in progress.
+func (r *replayer) finish() {
+	r.mu.Lock()
+	r.running = false
+	r.mu.Unlock()
+}
+
+// start starts a replay, returning the replays of the objects selected by s,
+// unless a replay is already in progress.
+func (r *replayer) start(s *replaySelector) ([]func(), bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return nil, false
+	}
+	r.running = true
+	var replays []func()
+	for rd := range r.delegates {
+		if rd.objects == nil {
+			continue
+		}
+		for _, obj := range rd.objects.List() {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok || !s.matches(u) {
+				continue
+			}
+			rd := rd
+			replays = append(replays, func() { rd.replay(u) })
+		}
+	}
+	return replays, true
+}
+
+// replay sends the add event of obj again. Unlike the events of the
+// notifications of obj, it is not suppressed as a duplicate and does not
+// move the checkpoint.
+func (a *resourceDelegate) replay(obj *unstructured.Unstructured) {
+	ctx, event, err := events.MakeAddEvent(a.source, a.apiServerSourceName, obj, a.ref, a.withOwner(a.opts, obj)...)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.dispatch(ctx, event)
+}
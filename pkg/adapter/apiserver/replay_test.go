@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func TestReplayer(t *testing.T) {
+	labeled := simplePod("labeled", "foo")
+	labeled.SetLabels(map[string]string{"app": "web"})
+
+	tests := map[string]struct {
+		method string
+		query  string
+
+		wantCode     int
+		wantReplayed int
+	}{
+		"all": {
+			query:        "",
+			wantCode:     http.StatusAccepted,
+			wantReplayed: 4,
+		},
+		"kind": {
+			query:        "kind=pod",
+			wantCode:     http.StatusAccepted,
+			wantReplayed: 3,
+		},
+		"kind and namespace": {
+			query:        "kind=Pod&namespace=foo",
+			wantCode:     http.StatusAccepted,
+			wantReplayed: 2,
+		},
+		"name": {
+			query:        "kind=Pod&namespace=foo&name=unit",
+			wantCode:     http.StatusAccepted,
+			wantReplayed: 1,
+		},
+		"label selector": {
+			query:        "labelSelector=app%3Dweb",
+			wantCode:     http.StatusAccepted,
+			wantReplayed: 1,
+		},
+		"no match": {
+			query:        "kind=Deployment",
+			wantCode:     http.StatusAccepted,
+			wantReplayed: 0,
+		},
+		"invalid label selector": {
+			query:    "labelSelector=%3D%3D%3D",
+			wantCode: http.StatusBadRequest,
+		},
+		"not a post": {
+			method:   http.MethodGet,
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			pods, ce := makeResourceAndTestingClient()
+			pods.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+			for _, obj := range []interface{}{simplePod("unit", "foo"), labeled, simplePod("unit", "bar")} {
+				if err := pods.objects.Add(obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+			namespaces := *pods
+			namespaces.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+			if err := namespaces.objects.Add(simpleNamespace("foo")); err != nil {
+				t.Fatal(err)
+			}
+
+			stop := make(chan struct{})
+			defer close(stop)
+			r := newReplayer(stop, zap.NewExample().Sugar())
+			r.register(pods, stop)
+			r.register(&namespaces, stop)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(method, replayPath+"?"+tc.query, nil))
+			r.wait()
+
+			if w.Code != tc.wantCode {
+				t.Fatalf("Status code = %d, want %d", w.Code, tc.wantCode)
+			}
+			if got := len(ce.Sent()); got != tc.wantReplayed {
+				t.Errorf("Expected %d events to be replayed, got %d", tc.wantReplayed, got)
+			}
+			for _, event := range ce.Sent() {
+				if event.Type() != sources.ApiServerSourceAddEventType {
+					t.Errorf("Expected %q event to be replayed, got %q", sources.ApiServerSourceAddEventType, event.Type())
+				}
+			}
+			if tc.wantCode != http.StatusAccepted {
+				return
+			}
+			var resp replayResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatal("Failed to decode the response:", err)
+			}
+			if resp.Replayed != tc.wantReplayed {
+				t.Errorf("Replayed = %d, want %d", resp.Replayed, tc.wantReplayed)
+			}
+		})
+	}
+}
+
+func TestReplayerUnregister(t *testing.T) {
+	rd, ce := makeRefAndTestingClient()
+	rd.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+	if err := rd.objects.Add(simplePod("unit", "test")); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	r := newReplayer(stop, zap.NewExample().Sugar())
+	r.register(rd, stop)
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, replayPath, nil))
+	r.wait()
+	validateSent(t, ce, sources.ApiServerSourceAddRefEventType)
+
+	close(stop)
+	// The delegate is unregistered asynchronously.
+	for {
+		r.mu.Lock()
+		n := len(r.delegates)
+		r.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		runtime.Gosched()
+	}
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, replayPath, nil))
+	r.wait()
+	if got := len(ce.Sent()); got != 1 {
+		t.Error("Expected no event to be replayed after the delegate is stopped, got:", got-1)
+	}
+}
+
+func TestReplayerInProgress(t *testing.T) {
+	rd, ce := makeRefAndTestingClient()
+	rd.objects = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+	if err := rd.objects.Add(simplePod("unit", "test")); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	r := newReplayer(stop, zap.NewExample().Sugar())
+	r.register(rd, stop)
+
+	if _, ok := r.start(&replaySelector{labels: labels.Everything()}); !ok {
+		t.Fatal("Expected the replay to start")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, replayPath, nil))
+	if w.Code != http.StatusConflict {
+		t.Errorf("Status code = %d during a replay, want %d", w.Code, http.StatusConflict)
+	}
+	if got := len(ce.Sent()); got != 0 {
+		t.Error("Expected no event to be replayed during a replay, got:", got)
+	}
+
+	r.finish()
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, replayPath, nil))
+	r.wait()
+	if w.Code != http.StatusAccepted {
+		t.Errorf("Status code = %d after the replay, want %d", w.Code, http.StatusAccepted)
+	}
+	validateSent(t, ce, sources.ApiServerSourceAddRefEventType)
+}
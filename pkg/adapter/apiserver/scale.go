@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// scaleWatcher keeps track of the replicas of the scale subresource of the
+// objects of a resource, to tell the changes of their replicas. As the API
+// server does not watch subresources, the scale of an object is read when its
+// generation changes, which every change of its desired replicas does.
+type scaleWatcher struct {
+	ctx    context.Context
+	res    dynamic.ResourceInterface
+	logger *zap.SugaredLogger
+
+	mu     sync.Mutex
+	scales map[string]scaleState
+}
+
+// scaleState is the last read scale of an object.
+type scaleState struct {
+	generation int64
+	scale      events.Scale
+}
+
+func newScaleWatcher(ctx context.Context, res dynamic.ResourceInterface, logger *zap.SugaredLogger) *scaleWatcher {
+	return &scaleWatcher{
+		ctx:    ctx,
+		res:    res,
+		logger: logger,
+		scales: make(map[string]scaleState),
+	}
+}
+
+// changed reads the scale of obj, unless its generation is the one it was
+// last read at, and returns it when its replicas changed since then. It
+// returns false the first time the scale of obj is read. Like forget, it does
+// nothing on a nil scaleWatcher.
+func (w *scaleWatcher) changed(obj *unstructured.Unstructured) (events.Scale, bool) {
+	if w == nil {
+		return events.Scale{}, false
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return events.Scale{}, false
+	}
+	w.mu.Lock()
+	last, known := w.scales[key]
+	w.mu.Unlock()
+	generation := obj.GetGeneration()
+	if known && generation != 0 && generation == last.generation {
+		return events.Scale{}, false
+	}
+
+	scale, err := w.read(obj.GetName())
+	if err != nil {
+		w.logger.Warnw("Failed to read the scale subresource", zap.String("key", key), zap.Error(err))
+		return events.Scale{}, false
+	}
+	w.mu.Lock()
+	w.scales[key] = scaleState{generation: generation, scale: scale}
+	w.mu.Unlock()
+	if !known || scale.Replicas == last.scale.Replicas {
+		return events.Scale{}, false
+	}
+	scale.OldReplicas = last.scale.Replicas
+	return scale, true
+}
+
+// read reads the scale subresource of the object with the name.
+func (w *scaleWatcher) read(name string) (events.Scale, error) {
+	u, err := w.res.Get(w.ctx, name, metav1.GetOptions{}, v1.ScaleSubresource)
+	if err != nil {
+		return events.Scale{}, err
+	}
+	replicas, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	statusReplicas, _, _ := unstructured.NestedInt64(u.Object, "status", "replicas")
+	selector, _, _ := unstructured.NestedString(u.Object, "status", "selector")
+	return events.Scale{
+		Replicas:       int32(replicas),
+		StatusReplicas: int32(statusReplicas),
+		Selector:       selector,
+	}, nil
+}
+
+// forget drops the last read scale of obj.
+func (w *scaleWatcher) forget(obj interface{}) {
+	if w == nil {
+		return
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	w.mu.Lock()
+	delete(w.scales, key)
+	w.mu.Unlock()
+}
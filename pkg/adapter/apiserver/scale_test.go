@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/logging"
+	pkgtesting "knative.dev/pkg/reconciler/testing"
+
+	"knative.dev/eventing/pkg/adapter/apiserver/events"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func simpleDeployment(name, namespace string, generation int64) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+			},
+		},
+	}
+	u.SetGeneration(generation)
+	return u
+}
+
+// fakeScales serves the scale subresource of the deployments, with their
+// replicas.
+type fakeScales struct {
+	mu       sync.Mutex
+	replicas int64
+	reads    int
+}
+
+func (f *fakeScales) set(replicas int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.replicas = replicas
+}
+
+func (f *fakeScales) react(action kubetesting.Action) (bool, runtime.Object, error) {
+	get := action.(kubetesting.GetAction)
+	if get.GetSubresource() != "scale" {
+		return false, nil, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reads++
+	return true, &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "Scale",
+		"metadata":   map[string]interface{}{"name": get.GetName(), "namespace": get.GetNamespace()},
+		"spec":       map[string]interface{}{"replicas": f.replicas},
+		"status":     map[string]interface{}{"replicas": int64(1), "selector": "app=web"},
+	}}, nil
+}
+
+func TestResourceScale(t *testing.T) {
+	ctx, _ := pkgtesting.SetupFakeContext(t)
+	scales := &fakeScales{replicas: 1}
+	k8s := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	k8s.PrependReactor("get", "deployments", scales.react)
+	res := k8s.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("test")
+
+	d, ce := makeResourceAndTestingClient()
+	d.scale = newScaleWatcher(ctx, res, logging.FromContext(ctx))
+
+	// The initial scale is the baseline of the changes.
+	if err := d.Replace([]interface{}{simpleDeployment("web", "test", 1)}, ""); err != nil {
+		t.Fatal(err)
+	}
+	validateNotSent(t, ce, sources.ApiServerSourceScaleEventType)
+
+	// The scale is not read again while the generation is the same.
+	d.Update(simpleDeployment("web", "test", 1))
+	validateSent(t, ce, sources.ApiServerSourceUpdateEventType)
+	if scales.reads != 1 {
+		t.Errorf("Expected the scale to be read once, got %d reads", scales.reads)
+	}
+
+	scales.set(3)
+	ce.Reset()
+	d.Update(simpleDeployment("web", "test", 2))
+	sent := ce.Sent()
+	if len(sent) != 2 {
+		t.Fatal("Expected the update and the scale events to be sent, got:", len(sent))
+	}
+	if got := sent[1].Type(); got != sources.ApiServerSourceScaleEventType {
+		t.Errorf("Expected %q event to be sent, got %q", sources.ApiServerSourceScaleEventType, got)
+	}
+	var got events.Scale
+	if err := json.Unmarshal(sent[1].Data(), &got); err != nil {
+		t.Fatal("Failed to decode the scale event:", err)
+	}
+	want := events.Scale{OldReplicas: 1, Replicas: 3, StatusReplicas: 1, Selector: "app=web"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("unexpected scale (-want, +got) =", diff)
+	}
+
+	// Changes of the generation without changes of the replicas send no
+	// scale event.
+	ce.Reset()
+	d.Update(simpleDeployment("web", "test", 3))
+	validateSent(t, ce, sources.ApiServerSourceUpdateEventType)
+
+	// The scale of the objects added again is a new baseline.
+	d.Delete(simpleDeployment("web", "test", 3))
+	scales.set(5)
+	ce.Reset()
+	d.Add(simpleDeployment("web", "test", 1))
+	validateSent(t, ce, sources.ApiServerSourceAddEventType)
+}
+
+func TestHasSubresource(t *testing.T) {
+	resources := []metav1.APIResource{{Name: "deployments"}, {Name: "deployments/scale"}, {Name: "pods"}, {Name: "pods/status"}}
+	if !hasSubresource(resources, "deployments", "scale") {
+		t.Error("hasSubresource(deployments, scale) = false, want true")
+	}
+	if hasSubresource(resources, "pods", "scale") {
+		t.Error("hasSubresource(pods, scale) = true, want false")
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"knative.dev/eventing/pkg/metrics/source"
+)
+
+const (
+	// defaultWorkerQueueSize is the number of events each worker holds
+	// before the dispatch of the following ones blocks.
+	defaultWorkerQueueSize = 100
+
+	// defaultDispatchReportInterval is how often the queue depth and the
+	// utilization of the workers are reported.
+	defaultDispatchReportInterval = 10 * time.Second
+)
+
+// workerPool dispatches the events by a fixed number of workers, each with
+// its own queue. The events are sharded by the object they are about, so
+// the events of a same object are dispatched in order by a same worker,
+// while the events of different objects are dispatched in parallel. Like its
+// other methods, submit runs the dispatch inline on a nil workerPool.
+type workerPool struct {
+	queues []chan func()
+	done   chan struct{}
+
+	// queued is the number of the events in the queues, and busy the number
+	// of the workers dispatching one.
+	queued int64
+	busy   int64
+
+	metrics source.ApiServerDispatchStatsReporter
+	args    *source.ApiServerReportArgs
+	logger  *zap.SugaredLogger
+}
+
+func newWorkerPool(workers, queueSize int, metrics source.ApiServerDispatchStatsReporter, args *source.ApiServerReportArgs, logger *zap.SugaredLogger) *workerPool {
+	p := &workerPool{
+		queues:  make([]chan func(), workers),
+		done:    make(chan struct{}),
+		metrics: metrics,
+		args:    args,
+		logger:  logger,
+	}
+	for i := range p.queues {
+		p.queues[i] = make(chan func(), queueSize)
+	}
+	return p
+}
+
+// start starts the workers, which run until stop is called.
+func (p *workerPool) start() {
+	for _, queue := range p.queues {
+		go p.work(queue)
+	}
+}
+
+// stop stops the workers. The events submitted after that are dispatched
+// inline.
+func (p *workerPool) stop() {
+	close(p.done)
+}
+
+// run reports the queue depth and the utilization of the workers every
+// interval until stopCh is closed.
+func (p *workerPool) run(stopCh <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.report()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// work runs the dispatches of queue until the workers are stopped.
+func (p *workerPool) work(queue chan func()) {
+	for {
+		select {
+		case dispatch := <-queue:
+			p.do(dispatch)
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *workerPool) do(dispatch func()) {
+	atomic.AddInt64(&p.queued, -1)
+	atomic.AddInt64(&p.busy, 1)
+	defer atomic.AddInt64(&p.busy, -1)
+	dispatch()
+}
+
+// submit queues dispatch for the worker of the object identified by key,
+// waiting for room in its queue. The events are dispatched inline when p is
+// nil.
+func (p *workerPool) submit(key string, dispatch func()) {
+	if p == nil {
+		dispatch()
+		return
+	}
+	atomic.AddInt64(&p.queued, 1)
+	select {
+	case <-p.done:
+		p.do(dispatch)
+		return
+	default:
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	select {
+	case p.queues[h.Sum32()%uint32(len(p.queues))] <- dispatch:
+	case <-p.done:
+		p.do(dispatch)
+	}
+}
+
+// report reports the queue depth and the utilization of the workers.
+func (p *workerPool) report() {
+	if p.metrics == nil {
+		return
+	}
+	queued, busy := atomic.LoadInt64(&p.queued), atomic.LoadInt64(&p.busy)
+	if err := p.metrics.ReportDispatch(p.args, int(queued), int(busy), len(p.queues)); err != nil {
+		p.logger.Warnw("Failed to report the dispatch", zap.Error(err))
+	}
+}
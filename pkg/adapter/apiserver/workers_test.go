@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"knative.dev/eventing/pkg/metrics/source"
+)
+
+// recordingDispatchMetrics records the last dispatch reported to it.
+type recordingDispatchMetrics struct {
+	mu                    sync.Mutex
+	queued, busy, workers int
+}
+
+func (r *recordingDispatchMetrics) ReportDispatch(_ *source.ApiServerReportArgs, queued, busy, workers int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queued, r.busy, r.workers = queued, busy, workers
+	return nil
+}
+
+func makeTestWorkerPool(workers int) *workerPool {
+	return newWorkerPool(workers, 10, nil, &source.ApiServerReportArgs{}, zap.NewExample().Sugar())
+}
+
+// shard returns the worker of key in a pool of workers.
+func shard(key string, workers int) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum32() % uint32(workers)
+}
+
+func TestWorkerPoolNil(t *testing.T) {
+	var p *workerPool
+	ran := false
+	p.submit("key", func() { ran = true })
+	if !ran {
+		t.Error("Expected the dispatch to run inline on a nil worker pool")
+	}
+}
+
+func TestWorkerPoolOrdered(t *testing.T) {
+	p := makeTestWorkerPool(4)
+	p.start()
+	defer p.stop()
+
+	const objects, events = 8, 50
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	got := make(map[string][]int)
+	for i := 0; i < events; i++ {
+		for o := 0; o < objects; o++ {
+			key, i := "object-"+strconv.Itoa(o), i
+			wg.Add(1)
+			p.submit(key, func() {
+				defer wg.Done()
+				mu.Lock()
+				defer mu.Unlock()
+				got[key] = append(got[key], i)
+			})
+		}
+	}
+	wg.Wait()
+
+	for key, seq := range got {
+		if len(seq) != events {
+			t.Errorf("%s: got %d events, want %d", key, len(seq), events)
+		}
+		for i, n := range seq {
+			if n != i {
+				t.Fatalf("%s: events dispatched out of order: %v", key, seq)
+			}
+		}
+	}
+}
+
+func TestWorkerPoolParallel(t *testing.T) {
+	p := makeTestWorkerPool(2)
+	p.start()
+	defer p.stop()
+
+	// The key of another object than slow, dispatched by another worker.
+	other := "other"
+	for i := 0; shard(other, 2) == shard("slow", 2); i++ {
+		other = "other-" + strconv.Itoa(i)
+	}
+
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	p.submit("slow", func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	dispatched := make(chan struct{})
+	p.submit(other, func() { close(dispatched) })
+	select {
+	case <-dispatched:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the events of another object not to wait for the slow one")
+	}
+
+	metrics := &recordingDispatchMetrics{}
+	p.metrics = metrics
+	p.report()
+	if metrics.busy != 1 || metrics.workers != 2 || metrics.queued != 0 {
+		t.Errorf("Reported queued = %d, busy = %d, workers = %d, want 0, 1, 2", metrics.queued, metrics.busy, metrics.workers)
+	}
+}
+
+func TestWorkerPoolStopped(t *testing.T) {
+	p := makeTestWorkerPool(2)
+	p.start()
+	p.stop()
+
+	ran := false
+	p.submit("key", func() { ran = true })
+	if !ran {
+		t.Error("Expected the dispatch to run inline once the workers are stopped")
+	}
+}
+
+func TestResourceDelegateWorkers(t *testing.T) {
+	d, ce := makeResourceAndTestingClient()
+	d.deliveries = newDeliveryQueue()
+	d.workers = makeTestWorkerPool(4)
+	d.workers.start()
+	defer d.workers.stop()
+
+	for i := 0; i < 10; i++ {
+		if err := d.Add(simplePod("unit-"+strconv.Itoa(i), "test")); err != nil {
+			t.Fatal("Add() =", err)
+		}
+	}
+	if dropped := d.deliveries.drain(5 * time.Second); dropped != 0 {
+		t.Fatalf("Expected the deliveries to complete, %d were dropped", dropped)
+	}
+	if got := len(ce.Sent()); got != 10 {
+		t.Errorf("Expected 10 events to be sent, got %d", got)
+	}
+}
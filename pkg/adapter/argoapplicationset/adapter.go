@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoapplicationset
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+var applicationSetGVR = schema.GroupVersionResource{
+	Group:    "argoproj.io",
+	Version:  "v1alpha1",
+	Resource: "applicationsets",
+}
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// Selector is the string representation of the label selector the
+	// watched ApplicationSets have to match.
+	Selector string `envconfig:"SELECTOR"`
+}
+
+type applicationSetAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	k8s       dynamic.Interface
+	selector  string
+	source    string
+	namespace string
+	name      string
+}
+
+// NewEnvConfig creates an empty configuration for the ArgoCDApplicationSetSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter emitting an event each time an Argo CD
+// ApplicationSet of the namespace of the source is added, updated or deleted.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+
+	return &applicationSetAdapter{
+		ce:     ceClient,
+		logger: logging.FromContext(ctx),
+
+		k8s:       dynamicclient.Get(ctx),
+		selector:  env.Selector,
+		source:    sourcesv1.ArgoCDApplicationSetSourceSource(env.Namespace, env.Name),
+		namespace: env.Namespace,
+		name:      env.Name,
+	}
+}
+
+func (a *applicationSetAdapter) Start(ctx context.Context) error {
+	res := a.k8s.Resource(applicationSetGVR).Namespace(a.namespace)
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = a.selector
+			return res.List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = a.selector
+			return res.Watch(ctx, opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 10*time.Hour, cache.Indexers{})
+	informer.AddEventHandler(a.eventHandler())
+
+	a.logger.Infow("Starting ArgoCDApplicationSetSource adapter", zap.String("selector", a.selector))
+	go informer.Run(ctx.Done())
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	<-ctx.Done()
+	return srv.Shutdown(context.Background())
+}
+
+// eventHandler returns the handler of the notifications of the informer of
+// the ApplicationSets, which sends their events with the verb of the
+// notification.
+func (a *applicationSetAdapter) eventHandler() cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.handle(obj, verbAdd)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			a.handle(newObj, verbUpdate)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			a.handle(obj, verbDelete)
+		},
+	}
+}
+
+// handle sends an event describing the given ApplicationSet, which was
+// added, updated or deleted as verb tells.
+func (a *applicationSetAdapter) handle(obj interface{}, verb string) {
+	appSet, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		a.logger.Warnf("unexpected object type %T", obj)
+		return
+	}
+
+	ctx, event, err := makeEvent(a.source, a.namespace, a.name, appSet, verb)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.sendCloudEvent(ctx, event)
+}
+
+func (a *applicationSetAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoapplicationset
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func applicationSet(name string, generators ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "ApplicationSet",
+			"metadata": map[string]interface{}{
+				"namespace": "argocd",
+				"name":      name,
+			},
+			"spec": map[string]interface{}{
+				"generators": generators,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name": "{{cluster}}-guestbook",
+					},
+				},
+			},
+		},
+	}
+}
+
+func listGenerator(elements int) map[string]interface{} {
+	items := make([]interface{}, 0, elements)
+	for i := 0; i < elements; i++ {
+		items = append(items, map[string]interface{}{"cluster": "c"})
+	}
+	return map[string]interface{}{
+		"list": map[string]interface{}{"elements": items},
+	}
+}
+
+func clustersGenerator() map[string]interface{} {
+	return map[string]interface{}{
+		"clusters": map[string]interface{}{},
+	}
+}
+
+func matrixGenerator(generators ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"matrix": map[string]interface{}{"generators": generators},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		obj interface{}
+
+		wantSent       bool
+		wantGenerators string
+		wantCount      interface{}
+	}{
+		"not an applicationset": {
+			obj: cache.DeletedFinalStateUnknown{},
+		},
+		"list generator": {
+			obj:            applicationSet("guestbook", listGenerator(3)),
+			wantSent:       true,
+			wantGenerators: "list",
+			wantCount:      int32(3),
+		},
+		"several list generators": {
+			obj:            applicationSet("guestbook", listGenerator(3), listGenerator(2)),
+			wantSent:       true,
+			wantGenerators: "list",
+			wantCount:      int32(5),
+		},
+		"matrix of list generators": {
+			obj:            applicationSet("guestbook", matrixGenerator(listGenerator(3), listGenerator(2))),
+			wantSent:       true,
+			wantGenerators: "matrix",
+			wantCount:      int32(6),
+		},
+		"clusters generator": {
+			obj:            applicationSet("guestbook", clustersGenerator()),
+			wantSent:       true,
+			wantGenerators: "clusters",
+		},
+		"mixed generators": {
+			obj:            applicationSet("guestbook", listGenerator(1), clustersGenerator()),
+			wantSent:       true,
+			wantGenerators: "clusters,list",
+		},
+		"matrix with clusters generator": {
+			obj:            applicationSet("guestbook", matrixGenerator(listGenerator(3), clustersGenerator())),
+			wantSent:       true,
+			wantGenerators: "matrix",
+		},
+		"no generators": {
+			obj:      applicationSet("guestbook"),
+			wantSent: true,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			a := &applicationSetAdapter{
+				ce:        ce,
+				logger:    zap.NewNop().Sugar(),
+				source:    "unit-test",
+				namespace: "argocd",
+				name:      "test-argocdapplicationsetsource",
+			}
+
+			a.handle(tc.obj, verbAdd)
+
+			sent := ce.Sent()
+			if !tc.wantSent {
+				if len(sent) != 0 {
+					t.Fatalf("Expected no event to be sent, got %d", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+			}
+			event := sent[0]
+			if event.Type() != sources.ArgoCDApplicationSetSourceEventType {
+				t.Errorf("Expected event type %q, got %q", sources.ArgoCDApplicationSetSourceEventType, event.Type())
+			}
+			if got, want := event.Subject(), "/apis/argoproj.io/v1alpha1/namespaces/argocd/applicationsets/guestbook"; got != want {
+				t.Errorf("Expected subject %q, got %q", want, got)
+			}
+			if got := event.Extensions()["verb"]; got != verbAdd {
+				t.Errorf("Expected verb %q, got %q", verbAdd, got)
+			}
+			if got := event.Extensions()["generatortype"]; got != tc.wantGenerators {
+				t.Errorf("Expected generatortype %q, got %q", tc.wantGenerators, got)
+			}
+			if got, want := event.Extensions()["templatename"], "{{cluster}}-guestbook"; got != want {
+				t.Errorf("Expected templatename %q, got %q", want, got)
+			}
+			if got := event.Extensions()["expectedapplicationcount"]; got != tc.wantCount {
+				t.Errorf("Expected expectedapplicationcount %v, got %v", tc.wantCount, got)
+			}
+		})
+	}
+}
+
+func TestEventHandlerVerbs(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+	a := &applicationSetAdapter{
+		ce:        ce,
+		logger:    zap.NewNop().Sugar(),
+		source:    "unit-test",
+		namespace: "argocd",
+		name:      "test-argocdapplicationsetsource",
+	}
+	appSet := applicationSet("guestbook", listGenerator(1))
+
+	handler := a.eventHandler()
+	handler.OnAdd(appSet)
+	handler.OnUpdate(appSet, appSet)
+	handler.OnDelete(appSet)
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Key: "argocd/guestbook", Obj: appSet})
+
+	want := []string{verbAdd, verbUpdate, verbDelete, verbDelete}
+	sent := ce.Sent()
+	if len(sent) != len(want) {
+		t.Fatalf("Expected %d events to be sent, got %d", len(want), len(sent))
+	}
+	for i, event := range sent {
+		if event.Type() != sources.ArgoCDApplicationSetSourceEventType {
+			t.Errorf("Expected event type %q, got %q", sources.ArgoCDApplicationSetSourceEventType, event.Type())
+		}
+		if got := event.Extensions()["verb"]; got != want[i] {
+			t.Errorf("Expected verb %q of event %d, got %q", want[i], i, got)
+		}
+	}
+}
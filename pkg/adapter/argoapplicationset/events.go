@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoapplicationset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "argocdapplicationsetsources.sources.knative.dev"
+
+	// The verbs of the events, set as their verb extension like the verbs
+	// of the types of the ApiServerSource events, so the consumers can tell
+	// the ApplicationSets being deleted, and their Applications pruned,
+	// from the ones being created.
+	verbAdd    = "add"
+	verbUpdate = "update"
+	verbDelete = "delete"
+)
+
+func makeEvent(source, namespace, name string, appSet *unstructured.Unstructured, verb string) (context.Context, cloudevents.Event, error) {
+	generators, _, _ := unstructured.NestedSlice(appSet.Object, "spec", "generators")
+	templateName, _, _ := unstructured.NestedString(appSet.Object, "spec", "template", "metadata", "name")
+
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.ArgoCDApplicationSetSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/apis/%s/namespaces/%s/%s/%s",
+		applicationSetGVR.GroupVersion().String(), appSet.GetNamespace(), applicationSetGVR.Resource, appSet.GetName()))
+	event.SetExtension("verb", verb)
+	event.SetExtension("generatortype", strings.Join(generatorTypes(generators), ","))
+	event.SetExtension("templatename", templateName)
+	if count, ok := expectedApplications(generators); ok {
+		event.SetExtension("expectedapplicationcount", count)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, appSet); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
+
+// generatorTypes returns the sorted, distinct types of the given generators,
+// e.g. "list" or "git". Each generator is an object with a single key naming
+// its type.
+func generatorTypes(generators []interface{}) []string {
+	seen := make(map[string]struct{})
+	types := []string{}
+	for _, g := range generators {
+		generator, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for t := range generator {
+			if t == "selector" {
+				// Post selectors filter the generated parameters.
+				continue
+			}
+			if _, ok := seen[t]; !ok {
+				seen[t] = struct{}{}
+				types = append(types, t)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// expectedApplications returns the number of Applications the given
+// generators produce. The count can only be known for list generators, and
+// matrix generators combining them. It returns false when any of the
+// generators depends on cluster or repository state.
+func expectedApplications(generators []interface{}) (int64, bool) {
+	if len(generators) == 0 {
+		return 0, false
+	}
+	var total int64
+	for _, g := range generators {
+		generator, ok := g.(map[string]interface{})
+		if !ok {
+			return 0, false
+		}
+		count, ok := generatorApplications(generator)
+		if !ok {
+			return 0, false
+		}
+		total += count
+	}
+	return total, true
+}
+
+func generatorApplications(generator map[string]interface{}) (int64, bool) {
+	if elements, found, err := unstructured.NestedSlice(generator, "list", "elements"); found && err == nil {
+		return int64(len(elements)), true
+	}
+	if children, found, err := unstructured.NestedSlice(generator, "matrix", "generators"); found && err == nil && len(children) > 0 {
+		product := int64(1)
+		for _, c := range children {
+			child, ok := c.(map[string]interface{})
+			if !ok {
+				return 0, false
+			}
+			count, ok := generatorApplications(child)
+			if !ok {
+				return 0, false
+			}
+			product *= count
+		}
+		return product, true
+	}
+	return 0, false
+}
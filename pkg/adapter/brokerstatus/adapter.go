@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokerstatus
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/client/clientset/versioned"
+	eventingclient "knative.dev/eventing/pkg/client/injection/client"
+)
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// Selector is the string representation of the label selector the
+	// watched Brokers have to match.
+	Selector string `envconfig:"SELECTOR"`
+}
+
+type brokerStatusAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	eventing  versioned.Interface
+	selector  string
+	source    string
+	namespace string
+	name      string
+}
+
+// NewEnvConfig creates an empty configuration for the BrokerStatusSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter emitting an event each time the Ready
+// condition or the ingress URI of a Broker of the namespace of the source
+// changes.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+
+	return &brokerStatusAdapter{
+		ce:     ceClient,
+		logger: logging.FromContext(ctx),
+
+		eventing:  eventingclient.Get(ctx),
+		selector:  env.Selector,
+		source:    sourcesv1.BrokerStatusSourceSource(env.Namespace, env.Name),
+		namespace: env.Namespace,
+		name:      env.Name,
+	}
+}
+
+func (a *brokerStatusAdapter) Start(ctx context.Context) error {
+	brokers := a.eventing.EventingV1().Brokers(a.namespace)
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = a.selector
+			return brokers.List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = a.selector
+			return brokers.Watch(ctx, opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(lw, &eventingv1.Broker{}, 10*time.Hour, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.handle(nil, obj.(*eventingv1.Broker))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			a.handle(oldObj.(*eventingv1.Broker), newObj.(*eventingv1.Broker))
+		},
+	})
+
+	a.logger.Infow("Starting BrokerStatusSource adapter", zap.String("selector", a.selector))
+	go informer.Run(ctx.Done())
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	<-ctx.Done()
+	return srv.Shutdown(context.Background())
+}
+
+// handle sends an event when the Ready condition status or the ingress URI
+// of broker differs from the one of old. A nil old stands for a Broker seen
+// for the first time.
+func (a *brokerStatusAdapter) handle(old, broker *eventingv1.Broker) {
+	if readyStatus(old) == readyStatus(broker) && ingressURI(old) == ingressURI(broker) {
+		return
+	}
+
+	ctx, event, err := makeEvent(a.source, a.namespace, a.name, old, broker)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.sendCloudEvent(ctx, event)
+}
+
+func (a *brokerStatusAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
+
+func readyStatus(broker *eventingv1.Broker) string {
+	if broker == nil {
+		return ""
+	}
+	if cond := broker.Status.GetCondition(apis.ConditionReady); cond != nil {
+		return string(cond.Status)
+	}
+	return ""
+}
+
+func ingressURI(broker *eventingv1.Broker) string {
+	if broker == nil || broker.Status.Address.URL == nil {
+		return ""
+	}
+	return broker.Status.Address.URL.String()
+}
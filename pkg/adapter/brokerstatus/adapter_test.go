@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokerstatus
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/eventing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func broker(ready corev1.ConditionStatus, uri string) *eventingv1.Broker {
+	b := &eventingv1.Broker{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "default",
+			Annotations: map[string]string{eventing.BrokerClassKey: eventing.MTChannelBrokerClassValue},
+		},
+	}
+	if uri != "" {
+		b.Status.Address.URL = apis.HTTP(uri)
+	}
+	if ready != "" {
+		b.Status.SetConditions(apis.Conditions{{Type: apis.ConditionReady, Status: ready}})
+	}
+	return b
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		old *eventingv1.Broker
+		new *eventingv1.Broker
+
+		wantSent        bool
+		wantIngress     interface{}
+		wantPrevIngress interface{}
+	}{
+		"new broker": {
+			new:         broker(corev1.ConditionTrue, "broker.example.com"),
+			wantSent:    true,
+			wantIngress: "http://broker.example.com",
+		},
+		"becomes ready": {
+			old:             broker(corev1.ConditionUnknown, "broker.example.com"),
+			new:             broker(corev1.ConditionTrue, "broker.example.com"),
+			wantSent:        true,
+			wantIngress:     "http://broker.example.com",
+			wantPrevIngress: "http://broker.example.com",
+		},
+		"becomes not ready": {
+			old:             broker(corev1.ConditionTrue, "broker.example.com"),
+			new:             broker(corev1.ConditionFalse, ""),
+			wantSent:        true,
+			wantPrevIngress: "http://broker.example.com",
+		},
+		"ingress changed": {
+			old:             broker(corev1.ConditionTrue, "old.example.com"),
+			new:             broker(corev1.ConditionTrue, "new.example.com"),
+			wantSent:        true,
+			wantIngress:     "http://new.example.com",
+			wantPrevIngress: "http://old.example.com",
+		},
+		"unchanged": {
+			old: broker(corev1.ConditionTrue, "broker.example.com"),
+			new: broker(corev1.ConditionTrue, "broker.example.com"),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			a := &brokerStatusAdapter{
+				ce:        ce,
+				logger:    zap.NewNop().Sugar(),
+				source:    "unit-test",
+				namespace: "default",
+				name:      "test-brokerstatussource",
+			}
+
+			a.handle(tc.old, tc.new)
+
+			sent := ce.Sent()
+			if !tc.wantSent {
+				if len(sent) != 0 {
+					t.Fatalf("Expected no event to be sent, got %d", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+			}
+			event := sent[0]
+			if event.Type() != sources.BrokerStatusSourceEventType {
+				t.Errorf("Expected event type %q, got %q", sources.BrokerStatusSourceEventType, event.Type())
+			}
+			if got, want := event.Subject(), "/apis/eventing.knative.dev/v1/namespaces/default/brokers/default"; got != want {
+				t.Errorf("Expected subject %q, got %q", want, got)
+			}
+			if got, want := event.Extensions()["brokerclass"], eventing.MTChannelBrokerClassValue; got != want {
+				t.Errorf("Expected brokerclass %q, got %q", want, got)
+			}
+			if got := event.Extensions()["ingressuri"]; got != tc.wantIngress {
+				t.Errorf("Expected ingressuri %v, got %v", tc.wantIngress, got)
+			}
+			if got := event.Extensions()["previousingressuri"]; got != tc.wantPrevIngress {
+				t.Errorf("Expected previousingressuri %v, got %v", tc.wantPrevIngress, got)
+			}
+		})
+	}
+}
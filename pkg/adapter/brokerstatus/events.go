@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokerstatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/eventing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "brokerstatussources.sources.knative.dev"
+)
+
+func makeEvent(source, namespace, name string, old, broker *eventingv1.Broker) (context.Context, cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.BrokerStatusSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/apis/%s/namespaces/%s/brokers/%s",
+		eventingv1.SchemeGroupVersion.String(), broker.Namespace, broker.Name))
+	if class := broker.GetAnnotations()[eventing.BrokerClassKey]; class != "" {
+		event.SetExtension("brokerclass", class)
+	}
+	if uri := ingressURI(broker); uri != "" {
+		event.SetExtension("ingressuri", uri)
+	}
+	if uri := ingressURI(old); uri != "" {
+		event.SetExtension("previousingressuri", uri)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, broker.Status); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
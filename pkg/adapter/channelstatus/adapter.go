@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channelstatus
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/client/clientset/versioned"
+	eventingclient "knative.dev/eventing/pkg/client/injection/client"
+)
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// Selector is the string representation of the label selector the
+	// watched Channels have to match.
+	Selector string `envconfig:"SELECTOR"`
+}
+
+type channelStatusAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	eventing  versioned.Interface
+	selector  string
+	source    string
+	namespace string
+	name      string
+}
+
+// NewEnvConfig creates an empty configuration for the ChannelStatusSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter emitting an event each time the Ready
+// condition of a Channel of the namespace of the source changes. The generic
+// messaging.knative.dev/v1 Channels are watched, whatever the implementation
+// backing them: their status mirrors the one of the backing channel.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+
+	return &channelStatusAdapter{
+		ce:     ceClient,
+		logger: logging.FromContext(ctx),
+
+		eventing:  eventingclient.Get(ctx),
+		selector:  env.Selector,
+		source:    sourcesv1.ChannelStatusSourceSource(env.Namespace, env.Name),
+		namespace: env.Namespace,
+		name:      env.Name,
+	}
+}
+
+func (a *channelStatusAdapter) Start(ctx context.Context) error {
+	channels := a.eventing.MessagingV1().Channels(a.namespace)
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = a.selector
+			return channels.List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = a.selector
+			return channels.Watch(ctx, opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(lw, &messagingv1.Channel{}, 10*time.Hour, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.handle(nil, obj.(*messagingv1.Channel))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			a.handle(oldObj.(*messagingv1.Channel), newObj.(*messagingv1.Channel))
+		},
+	})
+
+	a.logger.Infow("Starting ChannelStatusSource adapter", zap.String("selector", a.selector))
+	go informer.Run(ctx.Done())
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	<-ctx.Done()
+	return srv.Shutdown(context.Background())
+}
+
+// handle sends an event when the Ready condition status of channel differs
+// from the one of old. A nil old stands for a Channel seen for the first time.
+func (a *channelStatusAdapter) handle(old, channel *messagingv1.Channel) {
+	if readyStatus(old) == readyStatus(channel) {
+		return
+	}
+
+	ctx, event, err := makeEvent(a.source, a.namespace, a.name, channel)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.sendCloudEvent(ctx, event)
+}
+
+func (a *channelStatusAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
+
+func readyStatus(channel *messagingv1.Channel) string {
+	if channel == nil {
+		return ""
+	}
+	if cond := channel.Status.GetCondition(apis.ConditionReady); cond != nil {
+		return string(cond.Status)
+	}
+	return ""
+}
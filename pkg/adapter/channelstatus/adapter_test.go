@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channelstatus
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func channel(ready corev1.ConditionStatus, backed bool, subscribers int) *messagingv1.Channel {
+	c := &messagingv1.Channel{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "orders",
+		},
+		Spec: messagingv1.ChannelSpec{
+			ChannelTemplate: &messagingv1.ChannelTemplateSpec{
+				TypeMeta: metav1.TypeMeta{APIVersion: "messaging.knative.dev/v1", Kind: "InMemoryChannel"},
+			},
+		},
+	}
+	for i := 0; i < subscribers; i++ {
+		c.Spec.Subscribers = append(c.Spec.Subscribers, eventingduckv1.SubscriberSpec{})
+	}
+	if backed {
+		c.Status.Channel = &duckv1.KReference{APIVersion: "messaging.knative.dev/v1", Kind: "KafkaChannel", Name: "orders"}
+		c.Status.Address = &duckv1.Addressable{URL: apis.HTTP("orders-kn-channel.default.svc.cluster.local")}
+	}
+	if ready != "" {
+		c.Status.SetConditions(apis.Conditions{{Type: apis.ConditionReady, Status: ready}})
+	}
+	return c
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		old *messagingv1.Channel
+		new *messagingv1.Channel
+
+		wantSent        bool
+		wantKind        interface{}
+		wantAddress     interface{}
+		wantSubscribers interface{}
+	}{
+		"new channel": {
+			new:             channel(corev1.ConditionUnknown, false, 0),
+			wantSent:        true,
+			wantKind:        "InMemoryChannel",
+			wantSubscribers: int32(0),
+		},
+		"becomes ready": {
+			old:             channel(corev1.ConditionUnknown, true, 2),
+			new:             channel(corev1.ConditionTrue, true, 2),
+			wantSent:        true,
+			wantKind:        "KafkaChannel",
+			wantAddress:     "http://orders-kn-channel.default.svc.cluster.local",
+			wantSubscribers: int32(2),
+		},
+		"becomes not ready": {
+			old:             channel(corev1.ConditionTrue, true, 1),
+			new:             channel(corev1.ConditionFalse, true, 1),
+			wantSent:        true,
+			wantKind:        "KafkaChannel",
+			wantAddress:     "http://orders-kn-channel.default.svc.cluster.local",
+			wantSubscribers: int32(1),
+		},
+		"subscribers changed": {
+			old: channel(corev1.ConditionTrue, true, 1),
+			new: channel(corev1.ConditionTrue, true, 3),
+		},
+		"unchanged": {
+			old: channel(corev1.ConditionTrue, true, 1),
+			new: channel(corev1.ConditionTrue, true, 1),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			a := &channelStatusAdapter{
+				ce:        ce,
+				logger:    zap.NewNop().Sugar(),
+				source:    "unit-test",
+				namespace: "default",
+				name:      "test-channelstatussource",
+			}
+
+			a.handle(tc.old, tc.new)
+
+			sent := ce.Sent()
+			if !tc.wantSent {
+				if len(sent) != 0 {
+					t.Fatalf("Expected no event to be sent, got %d", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+			}
+			event := sent[0]
+			if event.Type() != sources.ChannelStatusSourceEventType {
+				t.Errorf("Expected event type %q, got %q", sources.ChannelStatusSourceEventType, event.Type())
+			}
+			if got, want := event.Subject(), "/apis/messaging.knative.dev/v1/namespaces/default/channels/orders"; got != want {
+				t.Errorf("Expected subject %q, got %q", want, got)
+			}
+			if got := event.Extensions()["channelkind"]; got != tc.wantKind {
+				t.Errorf("Expected channelkind %v, got %v", tc.wantKind, got)
+			}
+			if got := event.Extensions()["address"]; got != tc.wantAddress {
+				t.Errorf("Expected address %v, got %v", tc.wantAddress, got)
+			}
+			if got := event.Extensions()["subscribercount"]; got != tc.wantSubscribers {
+				t.Errorf("Expected subscribercount %v, got %v", tc.wantSubscribers, got)
+			}
+		})
+	}
+}
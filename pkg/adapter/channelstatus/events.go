@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channelstatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "channelstatussources.sources.knative.dev"
+)
+
+func makeEvent(source, namespace, name string, channel *messagingv1.Channel) (context.Context, cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.ChannelStatusSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/apis/%s/namespaces/%s/channels/%s",
+		messagingv1.SchemeGroupVersion.String(), channel.Namespace, channel.Name))
+	if kind := channelKind(channel); kind != "" {
+		event.SetExtension("channelkind", kind)
+	}
+	if address := channel.Status.Address; address != nil && address.URL != nil {
+		event.SetExtension("address", address.URL.String())
+	}
+	event.SetExtension("subscribercount", len(channel.Spec.Subscribers))
+	if err := event.SetData(cloudevents.ApplicationJSON, channel.Status); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
+
+// channelKind returns the kind of the channel implementation backing channel,
+// falling back to the one of its template until the backing channel is
+// created.
+func channelKind(channel *messagingv1.Channel) string {
+	if channel.Status.Channel != nil {
+		return channel.Status.Channel.Kind
+	}
+	if channel.Spec.ChannelTemplate != nil {
+		return channel.Spec.ChannelTemplate.Kind
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclaim
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func clusterClaim(name, value string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cluster.open-cluster-management.io/v1alpha1",
+			"kind":       "ClusterClaim",
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": map[string]interface{}{
+				"value": value,
+			},
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		obj          interface{}
+		clusterAlias string
+		claims       []interface{}
+
+		wantSent  bool
+		wantName  string
+		wantValue string
+		wantAlias interface{}
+	}{
+		"not a clusterclaim": {
+			obj: cache.DeletedFinalStateUnknown{},
+		},
+		"configured alias": {
+			obj:          clusterClaim("platform.open-cluster-management.io", "AWS"),
+			clusterAlias: "east-1",
+			claims:       []interface{}{clusterClaim("id.k8s.io", "4d8f2b7c")},
+			wantSent:     true,
+			wantName:     "platform.open-cluster-management.io",
+			wantValue:    "AWS",
+			wantAlias:    "east-1",
+		},
+		"alias from cluster id claim": {
+			obj:       clusterClaim("kubeversion.open-cluster-management.io", "v1.24.3"),
+			claims:    []interface{}{clusterClaim("id.k8s.io", "4d8f2b7c")},
+			wantSent:  true,
+			wantName:  "kubeversion.open-cluster-management.io",
+			wantValue: "v1.24.3",
+			wantAlias: "4d8f2b7c",
+		},
+		"cluster id claim": {
+			obj:       clusterClaim("id.k8s.io", "4d8f2b7c"),
+			wantSent:  true,
+			wantName:  "id.k8s.io",
+			wantValue: "4d8f2b7c",
+			wantAlias: "4d8f2b7c",
+		},
+		"no alias": {
+			obj:       clusterClaim("region.open-cluster-management.io", "us-east-1"),
+			wantSent:  true,
+			wantName:  "region.open-cluster-management.io",
+			wantValue: "us-east-1",
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			claims := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, c := range tc.claims {
+				if err := claims.Add(c); err != nil {
+					t.Fatal("Failed to add claim:", err)
+				}
+			}
+			ce := adaptertest.NewTestClient()
+			a := &clusterClaimAdapter{
+				ce:           ce,
+				logger:       zap.NewNop().Sugar(),
+				clusterAlias: tc.clusterAlias,
+				source:       "unit-test",
+				namespace:    "default",
+				name:         "test-clusterclaimsource",
+				claims:       claims,
+			}
+
+			a.handle(tc.obj, verbAdd)
+
+			sent := ce.Sent()
+			if !tc.wantSent {
+				if len(sent) != 0 {
+					t.Fatalf("Expected no event to be sent, got %d", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+			}
+			event := sent[0]
+			if event.Type() != sources.ClusterClaimSourceEventType {
+				t.Errorf("Expected event type %q, got %q", sources.ClusterClaimSourceEventType, event.Type())
+			}
+			if got, want := event.Subject(), "/apis/cluster.open-cluster-management.io/v1alpha1/clusterclaims/"+tc.wantName; got != want {
+				t.Errorf("Expected subject %q, got %q", want, got)
+			}
+			if got := event.Extensions()["verb"]; got != verbAdd {
+				t.Errorf("Expected verb %q, got %q", verbAdd, got)
+			}
+			if got := event.Extensions()["claimname"]; got != tc.wantName {
+				t.Errorf("Expected claimname %q, got %q", tc.wantName, got)
+			}
+			if got := event.Extensions()["claimvalue"]; got != tc.wantValue {
+				t.Errorf("Expected claimvalue %q, got %q", tc.wantValue, got)
+			}
+			if got := event.Extensions()["clusteralias"]; got != tc.wantAlias {
+				t.Errorf("Expected clusteralias %v, got %v", tc.wantAlias, got)
+			}
+		})
+	}
+}
+
+func TestEventHandlerVerbs(t *testing.T) {
+	ce := adaptertest.NewTestClient()
+	a := &clusterClaimAdapter{
+		ce:        ce,
+		logger:    zap.NewNop().Sugar(),
+		source:    "unit-test",
+		namespace: "default",
+		name:      "test-clusterclaimsource",
+		claims:    cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	claim := clusterClaim("platform.open-cluster-management.io", "AWS")
+
+	handler := a.eventHandler()
+	handler.OnAdd(claim)
+	handler.OnUpdate(claim, claim)
+	handler.OnDelete(claim)
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Key: claim.GetName(), Obj: claim})
+
+	want := []string{verbAdd, verbUpdate, verbDelete, verbDelete}
+	sent := ce.Sent()
+	if len(sent) != len(want) {
+		t.Fatalf("Expected %d events to be sent, got %d", len(want), len(sent))
+	}
+	for i, event := range sent {
+		if event.Type() != sources.ClusterClaimSourceEventType {
+			t.Errorf("Expected event type %q, got %q", sources.ClusterClaimSourceEventType, event.Type())
+		}
+		if got := event.Extensions()["verb"]; got != want[i] {
+			t.Errorf("Expected verb %q of event %d, got %q", want[i], i, got)
+		}
+		if got := event.Extensions()["claimname"]; got != claim.GetName() {
+			t.Errorf("Expected claimname %q of event %d, got %q", claim.GetName(), i, got)
+		}
+	}
+}
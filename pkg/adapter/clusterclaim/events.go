@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclaim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "clusterclaimsources.sources.knative.dev"
+
+	// The verbs of the events, set as their verb extension like the verbs
+	// of the types of the ApiServerSource events, so the consumers can tell
+	// the ClusterClaims being deleted from the ones being created.
+	verbAdd    = "add"
+	verbUpdate = "update"
+	verbDelete = "delete"
+)
+
+func makeEvent(source, namespace, name string, claim *unstructured.Unstructured, clusterAlias, verb string) (context.Context, cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.ClusterClaimSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/apis/%s/%s/%s",
+		clusterClaimGVR.GroupVersion().String(), clusterClaimGVR.Resource, claim.GetName()))
+	event.SetExtension("verb", verb)
+	event.SetExtension("claimname", claim.GetName())
+	event.SetExtension("claimvalue", claimValue(claim))
+	if clusterAlias != "" {
+		event.SetExtension("clusteralias", clusterAlias)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, claim); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
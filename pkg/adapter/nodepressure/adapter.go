@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepressure
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// pressureConditions are the Node conditions this adapter reports on.
+var pressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+}
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// NodeSelector is the string representation of the label selector the
+	// watched Nodes have to match.
+	NodeSelector string `envconfig:"NODE_SELECTOR"`
+}
+
+type nodePressureAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	kube         kubernetes.Interface
+	nodeSelector string
+	source       string
+	namespace    string
+	name         string
+}
+
+// NewEnvConfig creates an empty configuration for the NodePressureSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter emitting an event each time a Node pressure
+// condition transitions to True.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+
+	return &nodePressureAdapter{
+		ce:     ceClient,
+		logger: logging.FromContext(ctx),
+
+		kube:         kubeclient.Get(ctx),
+		nodeSelector: env.NodeSelector,
+		source:       sourcesv1.NodePressureSourceSource(env.Namespace, env.Name),
+		namespace:    env.Namespace,
+		name:         env.Name,
+	}
+}
+
+func (a *nodePressureAdapter) Start(ctx context.Context) error {
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = a.nodeSelector
+			return a.kube.CoreV1().Nodes().List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = a.nodeSelector
+			return a.kube.CoreV1().Nodes().Watch(ctx, opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(lw, &corev1.Node{}, 10*time.Hour, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.handle(ctx, nil, obj.(*corev1.Node))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			a.handle(ctx, oldObj.(*corev1.Node), newObj.(*corev1.Node))
+		},
+	})
+
+	a.logger.Infow("Starting NodePressureSource adapter", zap.String("nodeSelector", a.nodeSelector))
+	go informer.Run(ctx.Done())
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	<-ctx.Done()
+	return srv.Shutdown(context.Background())
+}
+
+// handle sends an event for every pressure condition of node which is True
+// and was not True on old. A nil old stands for a Node seen for the first time.
+func (a *nodePressureAdapter) handle(ctx context.Context, old, node *corev1.Node) {
+	for _, t := range pressureConditions {
+		cond := getCondition(node, t)
+		if cond == nil || cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		if prev := getCondition(old, t); prev != nil && prev.Status == corev1.ConditionTrue {
+			continue
+		}
+
+		admissible, err := a.admissiblePods(ctx, node)
+		if err != nil {
+			a.logger.Warnw("failed to compute the admissible pods count", zap.String("node", node.Name), zap.Error(err))
+		}
+
+		ctx, event, err := makeEvent(a.source, a.namespace, a.name, node, cond, admissible)
+		if err != nil {
+			a.logger.Infow("event creation failed", zap.Error(err))
+			continue
+		}
+		a.sendCloudEvent(ctx, event)
+	}
+}
+
+// admissiblePods approximates the number of pods the node can still accept
+// by subtracting its non terminated pods from its allocatable pods.
+func (a *nodePressureAdapter) admissiblePods(ctx context.Context, node *corev1.Node) (*int64, error) {
+	allocatable, ok := node.Status.Allocatable[corev1.ResourcePods]
+	if !ok {
+		return nil, nil
+	}
+
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.nodeName", node.Name),
+		fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+		fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+	)
+	pods, err := a.kube.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	admissible := allocatable.Value() - int64(len(pods.Items))
+	if admissible < 0 {
+		admissible = 0
+	}
+	return &admissible, nil
+}
+
+func (a *nodePressureAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
+
+func getCondition(node *corev1.Node, t corev1.NodeConditionType) *corev1.NodeCondition {
+	if node == nil {
+		return nil
+	}
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == t {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepressure
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func node(name string, pods int64, conditions ...corev1.NodeCondition) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourcePods: *resource.NewQuantity(pods, resource.DecimalSI),
+			},
+			Conditions: conditions,
+		},
+	}
+}
+
+func condition(t corev1.NodeConditionType, status corev1.ConditionStatus) corev1.NodeCondition {
+	return corev1.NodeCondition{Type: t, Status: status, Reason: "Testing"}
+}
+
+func pod(name, nodeName string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		old  *corev1.Node
+		new  *corev1.Node
+		objs []runtime.Object
+
+		wantConditions []string
+		wantAdmissible int32
+	}{
+		"no pressure": {
+			new: node("n1", 10, condition(corev1.NodeMemoryPressure, corev1.ConditionFalse)),
+		},
+		"new node under pressure": {
+			new:            node("n1", 10, condition(corev1.NodeMemoryPressure, corev1.ConditionTrue)),
+			wantConditions: []string{"MemoryPressure"},
+			wantAdmissible: 10,
+		},
+		"transition to pressure": {
+			old: node("n1", 10, condition(corev1.NodeDiskPressure, corev1.ConditionFalse)),
+			new: node("n1", 10, condition(corev1.NodeDiskPressure, corev1.ConditionTrue)),
+			objs: []runtime.Object{
+				pod("running", "n1", corev1.PodRunning),
+			},
+			wantConditions: []string{"DiskPressure"},
+			wantAdmissible: 9,
+		},
+		"still under pressure": {
+			old: node("n1", 10, condition(corev1.NodePIDPressure, corev1.ConditionTrue)),
+			new: node("n1", 10, condition(corev1.NodePIDPressure, corev1.ConditionTrue)),
+		},
+		"pressure relieved": {
+			old: node("n1", 10, condition(corev1.NodePIDPressure, corev1.ConditionTrue)),
+			new: node("n1", 10, condition(corev1.NodePIDPressure, corev1.ConditionFalse)),
+		},
+		"multiple transitions": {
+			old: node("n1", 2),
+			new: node("n1", 2,
+				condition(corev1.NodeMemoryPressure, corev1.ConditionTrue),
+				condition(corev1.NodePIDPressure, corev1.ConditionTrue),
+				condition(corev1.NodeReady, corev1.ConditionTrue)),
+			objs: []runtime.Object{
+				pod("p1", "n1", corev1.PodRunning),
+				pod("p2", "n1", corev1.PodPending),
+				pod("p3", "n1", corev1.PodRunning),
+			},
+			wantConditions: []string{"MemoryPressure", "PIDPressure"},
+			wantAdmissible: 0,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			a := &nodePressureAdapter{
+				ce:        ce,
+				logger:    zap.NewNop().Sugar(),
+				kube:      kubefake.NewSimpleClientset(tc.objs...),
+				source:    "unit-test",
+				namespace: "default",
+				name:      "test-nodepressuresource",
+			}
+
+			a.handle(context.Background(), tc.old, tc.new)
+
+			sent := ce.Sent()
+			if len(sent) != len(tc.wantConditions) {
+				t.Fatalf("Expected %d events to be sent, got %d", len(tc.wantConditions), len(sent))
+			}
+			for i, event := range sent {
+				if event.Type() != sources.NodePressureSourceEventType {
+					t.Errorf("Expected event type %q, got %q", sources.NodePressureSourceEventType, event.Type())
+				}
+				if got := event.Extensions()["conditiontype"]; got != tc.wantConditions[i] {
+					t.Errorf("Expected conditiontype %q, got %q", tc.wantConditions[i], got)
+				}
+				if got := event.Extensions()["nodename"]; got != "n1" {
+					t.Errorf("Expected nodename %q, got %q", "n1", got)
+				}
+				if got := event.Extensions()["nodeadmissiblepodscount"]; got != tc.wantAdmissible {
+					t.Errorf("Expected nodeadmissiblepodscount %d, got %v", tc.wantAdmissible, got)
+				}
+				if got, want := event.Subject(), "/api/v1/nodes/n1"; got != want {
+					t.Errorf("Expected subject %q, got %q", want, got)
+				}
+			}
+		})
+	}
+}
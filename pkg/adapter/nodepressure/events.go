@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepressure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	corev1 "k8s.io/api/core/v1"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "nodepressuresources.sources.knative.dev"
+)
+
+func makeEvent(source, namespace, name string, node *corev1.Node, cond *corev1.NodeCondition, admissiblePods *int64) (context.Context, cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.NodePressureSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/api/v1/nodes/%s", node.Name))
+	event.SetExtension("nodename", node.Name)
+	event.SetExtension("conditiontype", string(cond.Type))
+	if admissiblePods != nil {
+		event.SetExtension("nodeadmissiblepodscount", *admissiblePods)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, cond); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
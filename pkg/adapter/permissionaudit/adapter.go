@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionaudit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// Permissions is the JSON representation of the audited permissions.
+	Permissions string `envconfig:"PERMISSIONS" required:"true"`
+
+	// Interval is the time between two audits.
+	Interval string `envconfig:"INTERVAL" default:"5m"`
+}
+
+type permissionAuditAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	kube        kubernetes.Interface
+	permissions []sourcesv1.PermissionAuditPermission
+	interval    time.Duration
+	source      string
+	namespace   string
+	name        string
+
+	// allowed holds the last audited state of each permission.
+	allowed map[sourcesv1.PermissionAuditPermission]bool
+}
+
+// NewEnvConfig creates an empty configuration for the PermissionAuditSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter periodically auditing permissions of
+// ServiceAccounts and emitting an event each time one is granted or revoked.
+//
+// A SelfSubjectAccessReview only reviews the permissions of its caller, so the
+// permissions are audited with SubjectAccessReviews on behalf of the
+// ServiceAccounts. The adapter is therefore run with a ServiceAccount allowed
+// to create SubjectAccessReviews.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+
+	var permissions []sourcesv1.PermissionAuditPermission
+	if err := json.Unmarshal([]byte(env.Permissions), &permissions); err != nil {
+		panic("failed to parse the permissions from json")
+	}
+	interval, err := time.ParseDuration(env.Interval)
+	if err != nil {
+		panic("failed to parse the interval")
+	}
+
+	return &permissionAuditAdapter{
+		ce:     ceClient,
+		logger: logging.FromContext(ctx),
+
+		kube:        kubeclient.Get(ctx),
+		permissions: permissions,
+		interval:    interval,
+		source:      sourcesv1.PermissionAuditSourceSource(env.Namespace, env.Name),
+		namespace:   env.Namespace,
+		name:        env.Name,
+		allowed:     make(map[sourcesv1.PermissionAuditPermission]bool, len(permissions)),
+	}
+}
+
+func (a *permissionAuditAdapter) Start(ctx context.Context) error {
+	a.logger.Infow("Starting PermissionAuditSource adapter",
+		zap.Int("permissions", len(a.permissions)), zap.Duration("interval", a.interval))
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	a.audit(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			a.audit(ctx)
+		case <-ctx.Done():
+			return srv.Shutdown(context.Background())
+		}
+	}
+}
+
+// audit reviews every permission and sends an event for each one whose
+// allowed status differs from the previous audit. The first audit only
+// records the initial status of the permissions.
+func (a *permissionAuditAdapter) audit(ctx context.Context) {
+	for _, p := range a.permissions {
+		status, err := a.review(ctx, p)
+		if err != nil {
+			a.logger.Warnw("failed to review permission", zap.Any("permission", p), zap.Error(err))
+			continue
+		}
+
+		previous, known := a.allowed[p]
+		a.allowed[p] = status.Allowed
+		if !known || previous == status.Allowed {
+			continue
+		}
+
+		ctx, event, err := makeEvent(a.source, a.namespace, a.name, p, status)
+		if err != nil {
+			a.logger.Infow("event creation failed", zap.Error(err))
+			continue
+		}
+		a.sendCloudEvent(ctx, event)
+	}
+}
+
+// review returns whether the ServiceAccount of p is allowed to perform the
+// verb of p.
+func (a *permissionAuditAdapter) review(ctx context.Context, p sourcesv1.PermissionAuditPermission) (authorizationv1.SubjectAccessReviewStatus, error) {
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: p.Namespace,
+				Verb:      p.Verb,
+				Group:     p.APIGroup,
+				Resource:  p.Resource,
+			},
+			User: fmt.Sprintf("system:serviceaccount:%s:%s", a.namespace, p.ServiceAccount),
+			// The groups of the ServiceAccount, which some of its permissions
+			// may be granted to.
+			Groups: []string{
+				"system:serviceaccounts",
+				"system:serviceaccounts:" + a.namespace,
+				"system:authenticated",
+			},
+		},
+	}
+
+	response, err := a.kube.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return authorizationv1.SubjectAccessReviewStatus{}, err
+	}
+	return response.Status, nil
+}
+
+func (a *permissionAuditAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
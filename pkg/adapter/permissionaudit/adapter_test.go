@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionaudit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/zap"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+func TestAudit(t *testing.T) {
+	permission := sourcesv1.PermissionAuditPermission{
+		ServiceAccount: "deployer",
+		Verb:           "create",
+		APIGroup:       "apps",
+		Resource:       "deployments",
+	}
+
+	testCases := map[string]struct {
+		allowed []bool
+
+		wantSent []bool
+	}{
+		"initial audit": {
+			allowed: []bool{true},
+		},
+		"unchanged": {
+			allowed: []bool{true, true, true},
+		},
+		"revoked": {
+			allowed:  []bool{true, false},
+			wantSent: []bool{false},
+		},
+		"granted then revoked": {
+			allowed:  []bool{false, true, true, false},
+			wantSent: []bool{true, false},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			var allowed bool
+			var reviewed *authorizationv1.SubjectAccessReview
+			kube := kubefake.NewSimpleClientset()
+			kube.PrependReactor("create", "subjectaccessreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				reviewed = action.(clientgotesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				return true, &authorizationv1.SubjectAccessReview{
+					Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed, Reason: "unit-test"},
+				}, nil
+			})
+
+			ce := adaptertest.NewTestClient()
+			a := &permissionAuditAdapter{
+				ce:          ce,
+				logger:      zap.NewNop().Sugar(),
+				kube:        kube,
+				permissions: []sourcesv1.PermissionAuditPermission{permission},
+				source:      "unit-test",
+				namespace:   "default",
+				name:        "test-permissionauditsource",
+				allowed:     make(map[sourcesv1.PermissionAuditPermission]bool),
+			}
+
+			for _, allowed = range tc.allowed {
+				a.audit(context.Background())
+			}
+
+			if got, want := reviewed.Spec.User, "system:serviceaccount:default:deployer"; got != want {
+				t.Errorf("Expected user %q, got %q", want, got)
+			}
+			if got := reviewed.Spec.ResourceAttributes; got.Verb != "create" || got.Group != "apps" || got.Resource != "deployments" {
+				t.Errorf("Unexpected resource attributes %+v", got)
+			}
+
+			sent := ce.Sent()
+			if len(sent) != len(tc.wantSent) {
+				t.Fatalf("Expected %d events to be sent, got %d", len(tc.wantSent), len(sent))
+			}
+			for i, event := range sent {
+				if event.Type() != sources.PermissionAuditSourceEventType {
+					t.Errorf("Expected event type %q, got %q", sources.PermissionAuditSourceEventType, event.Type())
+				}
+				if got, want := event.Subject(), "/api/v1/namespaces/default/serviceaccounts/deployer"; got != want {
+					t.Errorf("Expected subject %q, got %q", want, got)
+				}
+				if got, want := event.Extensions()["serviceaccount"], "deployer"; got != want {
+					t.Errorf("Expected serviceaccount %q, got %q", want, got)
+				}
+
+				var data permissionChange
+				if err := json.Unmarshal(event.Data(), &data); err != nil {
+					t.Fatal("Failed to unmarshal the event data:", err)
+				}
+				if data.Allowed != tc.wantSent[i] {
+					t.Errorf("Expected allowed %t, got %t", tc.wantSent[i], data.Allowed)
+				}
+				if data.PermissionAuditPermission != permission {
+					t.Errorf("Expected permission %+v, got %+v", permission, data.PermissionAuditPermission)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionaudit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+const (
+	resourceGroup = "permissionauditsources.sources.knative.dev"
+)
+
+// permissionChange is the data of the events.
+type permissionChange struct {
+	sourcesv1.PermissionAuditPermission `json:",inline"`
+
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func makeEvent(source, namespace, name string, p sourcesv1.PermissionAuditPermission, status authorizationv1.SubjectAccessReviewStatus) (context.Context, cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.PermissionAuditSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/api/v1/namespaces/%s/serviceaccounts/%s", namespace, p.ServiceAccount))
+	event.SetExtension("serviceaccount", p.ServiceAccount)
+	event.SetExtension("verb", p.Verb)
+	event.SetExtension("resource", p.Resource)
+	event.SetExtension("allowed", strconv.FormatBool(status.Allowed))
+	if err := event.SetData(cloudevents.ApplicationJSON, permissionChange{
+		PermissionAuditPermission: p,
+		Allowed:                   status.Allowed,
+		Reason:                    status.Reason,
+	}); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretrotation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// Selector is the string representation of the label selector the
+	// watched Secrets have to match.
+	Selector string `envconfig:"SELECTOR"`
+}
+
+type secretRotationAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	kube      kubernetes.Interface
+	selector  string
+	source    string
+	namespace string
+	name      string
+
+	// key is the key of the checksums of the data of the Secrets, drawn when
+	// the adapter starts, so the checksums sent in the events can not be
+	// brute-forced back to the data of the Secrets by their consumers.
+	key []byte
+}
+
+// NewEnvConfig creates an empty configuration for the SecretRotationSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter emitting an event each time the data of a
+// Secret of the namespace of the source changes.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+	logger := logging.FromContext(ctx)
+
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		logger.Fatalw("Failed to draw the key of the checksums", zap.Error(err))
+	}
+
+	return &secretRotationAdapter{
+		ce:     ceClient,
+		logger: logger,
+
+		kube:      kubeclient.Get(ctx),
+		selector:  env.Selector,
+		source:    sourcesv1.SecretRotationSourceSource(env.Namespace, env.Name),
+		namespace: env.Namespace,
+		name:      env.Name,
+		key:       key,
+	}
+}
+
+func (a *secretRotationAdapter) Start(ctx context.Context) error {
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = a.selector
+			return a.kube.CoreV1().Secrets(a.namespace).List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = a.selector
+			return a.kube.CoreV1().Secrets(a.namespace).Watch(ctx, opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(lw, &corev1.Secret{}, 10*time.Hour, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			a.handle(oldObj.(*corev1.Secret), newObj.(*corev1.Secret))
+		},
+	})
+
+	a.logger.Infow("Starting SecretRotationSource adapter", zap.String("selector", a.selector))
+	go informer.Run(ctx.Done())
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	<-ctx.Done()
+	return srv.Shutdown(context.Background())
+}
+
+// handle sends an event when the checksum of the data of secret differs from
+// the one of old, its previous version held by the informer cache. As the key
+// of the checksums is only kept in memory, the checksums change when the
+// adapter restarts: the previous checksum of the first rotation of a Secret
+// after a restart does not match the checksum sent for its rotation before.
+func (a *secretRotationAdapter) handle(old, secret *corev1.Secret) {
+	previous := checksum(a.key, old)
+	current := checksum(a.key, secret)
+	if previous == current {
+		return
+	}
+
+	ctx, event, err := makeEvent(a.source, a.namespace, a.name, secret, current, previous)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.sendCloudEvent(ctx, event)
+}
+
+func (a *secretRotationAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
+
+// checksum returns the hex encoded HMAC-SHA256 checksum of the data of
+// secret, keyed with key. Keys are hashed in order so the checksum does not
+// depend on map ordering.
+func checksum(key []byte, secret *corev1.Secret) string {
+	keys := make([]string, 0, len(secret.Data))
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := hmac.New(sha256.New, key)
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(secret.Data[k])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
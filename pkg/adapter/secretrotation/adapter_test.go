@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretrotation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func secret(data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "default"},
+		Type:       corev1.SecretTypeBasicAuth,
+		Data:       make(map[string][]byte, len(data)),
+	}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		old *corev1.Secret
+		new *corev1.Secret
+
+		wantSent bool
+	}{
+		"rotated": {
+			old:      secret(map[string]string{"username": "admin", "password": "old"}),
+			new:      secret(map[string]string{"username": "admin", "password": "new"}),
+			wantSent: true,
+		},
+		"key added": {
+			old:      secret(map[string]string{"username": "admin"}),
+			new:      secret(map[string]string{"username": "admin", "password": "new"}),
+			wantSent: true,
+		},
+		"value moved to another key": {
+			old:      secret(map[string]string{"a": "bc"}),
+			new:      secret(map[string]string{"ab": "c"}),
+			wantSent: true,
+		},
+		"unchanged": {
+			old: secret(map[string]string{"username": "admin", "password": "secret"}),
+			new: secret(map[string]string{"password": "secret", "username": "admin"}),
+		},
+		"metadata changed": {
+			old: secret(map[string]string{"password": "secret"}),
+			new: func() *corev1.Secret {
+				s := secret(map[string]string{"password": "secret"})
+				s.Labels = map[string]string{"team": "payments"}
+				return s
+			}(),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			a := &secretRotationAdapter{
+				ce:        ce,
+				logger:    zap.NewNop().Sugar(),
+				source:    "unit-test",
+				namespace: "default",
+				name:      "test-secretrotationsource",
+				key:       []byte("unit-test-key"),
+			}
+
+			a.handle(tc.old, tc.new)
+
+			sent := ce.Sent()
+			if !tc.wantSent {
+				if len(sent) != 0 {
+					t.Fatalf("Expected no event to be sent, got %d", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+			}
+			event := sent[0]
+			if event.Type() != sources.SecretRotationSourceEventType {
+				t.Errorf("Expected event type %q, got %q", sources.SecretRotationSourceEventType, event.Type())
+			}
+			if got, want := event.Subject(), "/api/v1/namespaces/default/secrets/db-credentials"; got != want {
+				t.Errorf("Expected subject %q, got %q", want, got)
+			}
+			if got, want := event.Extensions()["secretname"], "db-credentials"; got != want {
+				t.Errorf("Expected secretname %q, got %q", want, got)
+			}
+			if got, want := event.Extensions()["secrettype"], string(corev1.SecretTypeBasicAuth); got != want {
+				t.Errorf("Expected secrettype %q, got %q", want, got)
+			}
+			if got, want := event.Extensions()["previouschecksum"], checksum(a.key, tc.old); got != want {
+				t.Errorf("Expected previouschecksum %q, got %q", want, got)
+			}
+
+			var data rotation
+			if err := json.Unmarshal(event.Data(), &data); err != nil {
+				t.Fatal("Failed to unmarshal the event data:", err)
+			}
+			if got, want := data.Checksum, checksum(a.key, tc.new); got != want {
+				t.Errorf("Expected checksum %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestChecksumKey(t *testing.T) {
+	s := secret(map[string]string{"password": "secret"})
+	if checksum([]byte("a"), s) == checksum([]byte("b"), s) {
+		t.Error("Expected the checksums of different keys to differ")
+	}
+	if checksum([]byte("a"), s) != checksum([]byte("a"), s.DeepCopy()) {
+		t.Error("Expected the checksums of the same key and data to be equal")
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	corev1 "k8s.io/api/core/v1"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "secretrotationsources.sources.knative.dev"
+)
+
+// rotation is the data of the events, which never holds the data of the
+// Secret. Its Checksum and PreviousChecksum are the checksums of the data of
+// the Secret after and before its rotation, keyed by the adapter.
+type rotation struct {
+	Name             string            `json:"name"`
+	Namespace        string            `json:"namespace"`
+	Type             corev1.SecretType `json:"type"`
+	Checksum         string            `json:"checksum"`
+	PreviousChecksum string            `json:"previousChecksum"`
+	ResourceVersion  string            `json:"resourceVersion"`
+}
+
+func makeEvent(source, namespace, name string, secret *corev1.Secret, checksum, previousChecksum string) (context.Context, cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.SecretRotationSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", secret.Namespace, secret.Name))
+	event.SetExtension("secretname", secret.Name)
+	event.SetExtension("secrettype", string(secret.Type))
+	event.SetExtension("previouschecksum", previousChecksum)
+	if err := event.SetData(cloudevents.ApplicationJSON, rotation{
+		Name:             secret.Name,
+		Namespace:        secret.Namespace,
+		Type:             secret.Type,
+		Checksum:         checksum,
+		PreviousChecksum: previousChecksum,
+		ResourceVersion:  secret.ResourceVersion,
+	}); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
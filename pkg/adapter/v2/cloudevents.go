@@ -61,6 +61,9 @@ func NewClientHTTPObserved(topt []http.Option, copt []ceclient.Option) (ceclient
 // NewCloudEventsClient returns a client that will apply the ceOverrides to
 // outbound events and report outbound event counts.
 func NewCloudEventsClient(target string, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter) (cloudevents.Client, error) {
+	if binding, u := egressBindingFor(target); binding != nil {
+		return newEgressClient(binding, u, ceOverrides, reporter, nil)
+	}
 	opts := make([]http.Option, 0)
 	if len(target) > 0 {
 		opts = append(opts, cloudevents.WithTarget(target))
@@ -73,6 +76,65 @@ func NewCloudEventsClientWithOptions(ceOverrides *duckv1.CloudEventOverrides, re
 	return newCloudEventsClientCRStatus(nil, ceOverrides, reporter, nil, opts...)
 }
 
+// NewCloudEventsClientWithSender returns a client sending the events with
+// sender rather than over HTTP, which applies the ceOverrides to outbound
+// events and reports outbound event counts.
+func NewCloudEventsClientWithSender(sender protocol.Sender, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter, copts ...ceclient.Option) (cloudevents.Client, error) {
+	return newCloudEventsClientWithSender(sender, ceOverrides, reporter, nil, copts...)
+}
+
+func newCloudEventsClientWithSender(sender protocol.Sender, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter,
+	crStatusEventClient *crstatusevent.CRStatusEventClient, copts ...ceclient.Option) (cloudevents.Client, error) {
+	copts = append(copts, ceclient.WithTimeNow(), ceclient.WithUUIDs(), ceclient.WithObservabilityService(obsclient.New()))
+	ceClient, err := ceclient.New(sender, copts...)
+	if err != nil {
+		return nil, err
+	}
+	if crStatusEventClient == nil {
+		crStatusEventClient = crstatusevent.GetDefaultClient()
+	}
+	return &client{
+		ceClient:            ceClient,
+		ceOverrides:         ceOverrides,
+		reporter:            reporter,
+		crStatusEventClient: crStatusEventClient,
+	}, nil
+}
+
+// newEgressClient returns a client sending the events to the sink at target
+// with its egress binding.
+func newEgressClient(binding EgressBinding, target *url.URL, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter,
+	crStatusEventClient *crstatusevent.CRStatusEventClient, copts ...ceclient.Option) (cloudevents.Client, error) {
+	sender, err := binding.NewSender(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the %s sender: %w", target.Scheme, err)
+	}
+	return newCloudEventsClientWithSender(sender, ceOverrides, reporter, crStatusEventClient, copts...)
+}
+
+// NewCloudEventsClientForSink returns a client sending the events to the sink
+// at target, rather than to the sink of env, with the other settings of env
+// and its egress binding. The events are authenticated with the OIDC token for
+// audience when it is set, rather than for the audience of the sink of env.
+func NewCloudEventsClientForSink(env EnvConfigAccessor, target, audience string, ceOverrides *duckv1.CloudEventOverrides, reporter source.StatsReporter) (cloudevents.Client, error) {
+	return newCloudEventsClientCRStatus(&sinkEnvConfig{EnvConfigAccessor: env, sink: target, audience: audience}, ceOverrides, reporter, nil)
+}
+
+// sinkEnvConfig is env with another sink.
+type sinkEnvConfig struct {
+	EnvConfigAccessor
+	sink     string
+	audience string
+}
+
+func (e *sinkEnvConfig) GetSink() string {
+	return e.sink
+}
+
+func (e *sinkEnvConfig) GetSinkAudience() string {
+	return e.audience
+}
+
 // NewCloudEventsClientCRStatus returns a client CR status
 func NewCloudEventsClientCRStatus(env EnvConfigAccessor, reporter source.StatsReporter, crStatusEventClient *crstatusevent.CRStatusEventClient) (cloudevents.Client, error) {
 	return newCloudEventsClientCRStatus(env, nil, reporter, crStatusEventClient)
@@ -85,6 +147,9 @@ func newCloudEventsClientCRStatus(env EnvConfigAccessor, ceOverrides *duckv1.Clo
 		Propagation: tracecontextb3.TraceContextEgress,
 	}))
 
+	var cOpts []ceclient.Option
+	// decorators decorate the round tripper chosen by the other options.
+	var decorators []http.Option
 	if env != nil {
 		if target := env.GetSink(); len(target) > 0 {
 			pOpts = append(pOpts, cloudevents.WithTarget(target))
@@ -99,12 +164,36 @@ func newCloudEventsClientCRStatus(env EnvConfigAccessor, ceOverrides *duckv1.Clo
 				return nil, err
 			}
 		}
+		if cOpts, err = encodingOptions(env.GetCloudEventsEncoding()); err != nil {
+			return nil, err
+		}
+		tlsOpts, err := tlsOptions(env)
+		if err != nil {
+			return nil, err
+		}
+		pOpts = append(pOpts, tlsOpts...)
+		if decorators, err = contentEncodingOptions(env.GetContentEncoding()); err != nil {
+			return nil, err
+		}
+		oidcOpts, err := oidcOptions(env.GetOIDCTokensDir(), env.GetSinkAudience())
+		if err != nil {
+			return nil, err
+		}
+		decorators = append(decorators, oidcOpts...)
+		// The sinks of the other protocols than HTTP are sent to with their
+		// egress binding.
+		if binding, u := egressBindingFor(env.GetSink()); binding != nil {
+			return newEgressClient(binding, u, ceOverrides, reporter, crStatusEventClient, cOpts...)
+		}
 	}
 
 	// Make sure that explicitly set options have priority
 	opts = append(pOpts, opts...)
+	// The content encoding and the OIDC token decorate the round tripper
+	// chosen by the options above.
+	opts = append(opts, decorators...)
 
-	ceClient, err := newClientHTTPObserved(opts, nil)
+	ceClient, err := newClientHTTPObserved(opts, cOpts)
 
 	if crStatusEventClient == nil {
 		crStatusEventClient = crstatusevent.GetDefaultClient()
@@ -189,10 +278,12 @@ func (c *client) reportMetrics(ctx context.Context, event cloudevents.Event, res
 	if cloudevents.IsACK(result) {
 		var res *http.Result
 		if !cloudevents.ResultAs(result, &res) {
-			c.reportError(reportArgs, result)
+			// The events sent with other protocols than HTTP have no
+			// response code.
+			_ = c.reporter.ReportEventCount(reportArgs, 0)
+		} else {
+			_ = c.reporter.ReportEventCount(reportArgs, res.StatusCode)
 		}
-
-		_ = c.reporter.ReportEventCount(reportArgs, res.StatusCode)
 	} else {
 		c.crStatusEventClient.ReportCRStatusEvent(ctx, result)
 
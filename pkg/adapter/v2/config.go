@@ -45,6 +45,13 @@ const (
 	EnvConfigTracingConfig        = "K_TRACING_CONFIG"
 	EnvConfigLeaderElectionConfig = "K_LEADER_ELECTION_CONFIG"
 	EnvSinkTimeout                = "K_SINK_TIMEOUT"
+	EnvConfigCEEncoding           = "K_CE_ENCODING"
+	EnvConfigCEContentEncoding    = "K_CE_CONTENT_ENCODING"
+	EnvConfigSinkAudience         = "K_SINK_AUDIENCE"
+	EnvConfigOIDCTokensDir        = "K_OIDC_TOKENS_DIR"
+	EnvConfigCACertsFile          = "K_CA_CERTS_FILE"
+	EnvConfigClientCertFile       = "K_CLIENT_CERT_FILE"
+	EnvConfigClientKeyFile        = "K_CLIENT_KEY_FILE"
 )
 
 // EnvConfig is the minimal set of configuration parameters
@@ -91,6 +98,31 @@ type EnvConfig struct {
 	// Time in seconds to wait for sink to respond
 	EnvSinkTimeout string `envconfig:"K_SINK_TIMEOUT"`
 
+	// CEEncoding is the encoding of the outbound events, either binary or
+	// structured. Default is the encoding chosen by the CloudEvents SDK.
+	CEEncoding string `envconfig:"K_CE_ENCODING"`
+
+	// CEContentEncoding is the content encoding applied to the body of the
+	// outbound requests. Only gzip is supported. Default is no encoding.
+	CEContentEncoding string `envconfig:"K_CE_CONTENT_ENCODING"`
+
+	// SinkAudience is the OIDC audience of the sink. The requests to the
+	// sink are not authenticated when it is empty.
+	SinkAudience string `envconfig:"K_SINK_AUDIENCE"`
+
+	// OIDCTokensDir is the directory the OIDC tokens of the audiences of the
+	// sinks are projected in, each in the file OIDCTokenFileName names.
+	OIDCTokensDir string `envconfig:"K_OIDC_TOKENS_DIR"`
+
+	// CACertsFile is the file of the PEM CA certificates the certificates
+	// of the HTTPS sinks are verified with, instead of the system ones.
+	CACertsFile string `envconfig:"K_CA_CERTS_FILE"`
+
+	// ClientCertFile and ClientKeyFile are the files of the PEM client
+	// certificate and key presented to the sinks requiring mutual TLS.
+	ClientCertFile string `envconfig:"K_CLIENT_CERT_FILE"`
+	ClientKeyFile  string `envconfig:"K_CLIENT_KEY_FILE"`
+
 	// cached zap logger
 	logger *zap.SugaredLogger
 }
@@ -125,6 +157,24 @@ type EnvConfigAccessor interface {
 
 	// Get the timeout to apply on a request to a sink
 	GetSinktimeout() int
+
+	// Get the encoding of the outbound events.
+	GetCloudEventsEncoding() string
+
+	// Get the content encoding of the outbound requests.
+	GetContentEncoding() string
+
+	// Get the OIDC audience of the sink.
+	GetSinkAudience() string
+
+	// Get the directory the OIDC tokens are projected in.
+	GetOIDCTokensDir() string
+
+	// Get the file of the CA certificates the sinks are verified with.
+	GetCACertsFile() string
+
+	// Get the files of the client certificate and key.
+	GetClientCertFiles() (string, string)
 }
 
 var _ EnvConfigAccessor = (*EnvConfig)(nil)
@@ -179,6 +229,30 @@ func (e *EnvConfig) GetSinktimeout() int {
 	return -1
 }
 
+func (e *EnvConfig) GetCloudEventsEncoding() string {
+	return e.CEEncoding
+}
+
+func (e *EnvConfig) GetContentEncoding() string {
+	return e.CEContentEncoding
+}
+
+func (e *EnvConfig) GetSinkAudience() string {
+	return e.SinkAudience
+}
+
+func (e *EnvConfig) GetOIDCTokensDir() string {
+	return e.OIDCTokensDir
+}
+
+func (e *EnvConfig) GetCACertsFile() string {
+	return e.CACertsFile
+}
+
+func (e *EnvConfig) GetClientCertFiles() (string, string) {
+	return e.ClientCertFile, e.ClientKeyFile
+}
+
 func (e *EnvConfig) SetupTracing(logger *zap.SugaredLogger) (tracing.Tracer, error) {
 	config, err := tracingconfig.JSONToTracingConfig(e.TracingConfigJson)
 	if err != nil {
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// EgressBinding sends the events to the sinks of the protocols other than
+// HTTP, which are selected by the scheme of their URI.
+type EgressBinding interface {
+	// NewSender returns a sender of the events to the sink at target.
+	NewSender(target *url.URL) (protocol.Sender, error)
+}
+
+var (
+	egressBindingsMu sync.RWMutex
+	egressBindings   = map[string]EgressBinding{
+		"mqtt":  mqttBinding{},
+		"mqtts": mqttBinding{},
+		"amqp":  amqpBinding{},
+		"amqps": amqpBinding{},
+	}
+)
+
+// RegisterEgressBinding registers binding as the binding of the sinks whose
+// URI has one of the schemes, in place of the binding registered for them.
+func RegisterEgressBinding(binding EgressBinding, schemes ...string) {
+	egressBindingsMu.Lock()
+	defer egressBindingsMu.Unlock()
+	for _, scheme := range schemes {
+		egressBindings[strings.ToLower(scheme)] = binding
+	}
+}
+
+// egressBindingFor returns the binding of the sink at target along with its
+// parsed URI, or nil when the events are sent to it over HTTP.
+func egressBindingFor(target string) (EgressBinding, *url.URL) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, nil
+	}
+	egressBindingsMu.RLock()
+	defer egressBindingsMu.RUnlock()
+	binding, ok := egressBindings[strings.ToLower(u.Scheme)]
+	if !ok {
+		return nil, nil
+	}
+	return binding, u
+}
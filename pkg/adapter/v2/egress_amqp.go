@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-amqp"
+	ceamqp "github.com/cloudevents/sdk-go/protocol/amqp/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+const (
+	// defaultAMQPQoS is the QoS the events are sent with when the sink URI
+	// does not set one, at least once.
+	defaultAMQPQoS = 1
+)
+
+// amqpBinding sends the events to the node of an AMQP 1.0 container, at a
+// URI of the form amqp[s]://[user:password@]host[:port]/address. Its qos query
+// sends the events settled, at most once, when it is 0, or unsettled, at
+// least once, when it is 1. The connection is reestablished whenever it is
+// lost.
+type amqpBinding struct{}
+
+var _ EgressBinding = amqpBinding{}
+
+func (amqpBinding) NewSender(target *url.URL) (protocol.Sender, error) {
+	address := strings.TrimPrefix(target.Path, "/")
+	if address == "" {
+		return nil, fmt.Errorf("no AMQP address in %s", target.Redacted())
+	}
+	qos, err := parseQoS(target.Query().Get("qos"), defaultAMQPQoS, 1)
+	if err != nil {
+		return nil, err
+	}
+	settle := amqp.ModeUnsettled
+	if qos == 0 {
+		settle = amqp.ModeSettled
+	}
+
+	// The credentials of the URI are the SASL PLAIN credentials of the
+	// connection.
+	server := &url.URL{Scheme: target.Scheme, Host: target.Host, User: target.User}
+	return &amqpSender{
+		dial: func() (amqpLink, error) {
+			return dialAMQP(server.String(), address, settle)
+		},
+	}, nil
+}
+
+// amqpLink is a link to the node the events are sent to.
+type amqpLink interface {
+	Send(ctx context.Context, msg *amqp.Message) error
+	Close(ctx context.Context) error
+}
+
+// amqpConnLink is a link over its own connection.
+type amqpConnLink struct {
+	*amqp.Sender
+	client *amqp.Client
+}
+
+func (l *amqpConnLink) Close(ctx context.Context) error {
+	_ = l.Sender.Close(ctx)
+	return l.client.Close()
+}
+
+// dialAMQP opens a link sending the messages to address over a new
+// connection to server.
+func dialAMQP(server, address string, settle amqp.SenderSettleMode) (amqpLink, error) {
+	client, err := amqp.Dial(server)
+	if err != nil {
+		return nil, err
+	}
+	session, err := client.NewSession()
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	sender, err := session.NewSender(amqp.LinkTargetAddress(address), amqp.LinkSenderSettle(settle))
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &amqpConnLink{Sender: sender, client: client}, nil
+}
+
+// amqpSender sends the events over its link, which it opens again when it is
+// lost.
+type amqpSender struct {
+	dial func() (amqpLink, error)
+
+	mu   sync.Mutex
+	link amqpLink
+}
+
+var _ protocol.Sender = (*amqpSender)(nil)
+var _ protocol.Closer = (*amqpSender)(nil)
+
+// Send implements protocol.Sender. An event whose link was lost is sent
+// again once over a new link.
+func (s *amqpSender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) (err error) {
+	defer func() { _ = m.Finish(err) }()
+
+	var msg amqp.Message
+	if err = ceamqp.WriteMessage(ctx, m, &msg, transformers...); err != nil {
+		return err
+	}
+	for attempt := 0; attempt < 2; attempt++ {
+		var link amqpLink
+		if link, err = s.connect(); err != nil {
+			return err
+		}
+		if err = link.Send(ctx, &msg); err == nil || !amqpLinkLost(err) {
+			return err
+		}
+		s.reset(ctx, link)
+	}
+	return err
+}
+
+// connect returns the link of s, which it opens when there is none.
+func (s *amqpSender) connect() (amqpLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.link == nil {
+		link, err := s.dial()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the AMQP container: %w", err)
+		}
+		s.link = link
+	}
+	return s.link, nil
+}
+
+// reset drops link, which was lost, so the next event opens a new one.
+func (s *amqpSender) reset(ctx context.Context, link amqpLink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.link == link {
+		s.link = nil
+		_ = link.Close(ctx)
+	}
+}
+
+// Close implements protocol.Closer
+func (s *amqpSender) Close(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.link == nil {
+		return nil
+	}
+	err := s.link.Close(ctx)
+	s.link = nil
+	return err
+}
+
+// amqpLinkLost returns whether err is the loss of the link, of its session
+// or of its connection.
+func amqpLinkLost(err error) bool {
+	var detach *amqp.DetachError
+	var netErr net.Error
+	return errors.Is(err, amqp.ErrConnClosed) || errors.Is(err, amqp.ErrSessionClosed) ||
+		errors.Is(err, amqp.ErrLinkClosed) || errors.As(err, &detach) ||
+		errors.Is(err, io.EOF) || errors.As(err, &netErr)
+}
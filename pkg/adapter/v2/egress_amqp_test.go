@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/go-amqp"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+)
+
+// fakeAMQPLink fails with the errors in turn, then sends the messages.
+type fakeAMQPLink struct {
+	errs   []error
+	sent   []*amqp.Message
+	closed bool
+}
+
+func (l *fakeAMQPLink) Send(_ context.Context, msg *amqp.Message) error {
+	if len(l.errs) > 0 {
+		err := l.errs[0]
+		l.errs = l.errs[1:]
+		return err
+	}
+	l.sent = append(l.sent, msg)
+	return nil
+}
+
+func (l *fakeAMQPLink) Close(context.Context) error {
+	l.closed = true
+	return nil
+}
+
+func TestAMQPBindingNewSender(t *testing.T) {
+	tests := map[string]struct {
+		target  string
+		wantErr bool
+	}{
+		"valid":       {target: "amqp://user:pw@broker/queue"},
+		"settled":     {target: "amqps://broker:5671/queue?qos=0"},
+		"no address":  {target: "amqp://broker/", wantErr: true},
+		"invalid qos": {target: "amqp://broker/queue?qos=2", wantErr: true},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			u, err := url.Parse(tc.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = amqpBinding{}.NewSender(u)
+			if got := err != nil; got != tc.wantErr {
+				t.Errorf("NewSender() = %v, wantErr %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAMQPSenderSend(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+
+	rejected := errors.New("rejected")
+	tests := map[string]struct {
+		links     []*fakeAMQPLink
+		dialErr   error
+		wantErr   error
+		wantDials int
+		wantSent  int
+	}{
+		"sent": {
+			links:     []*fakeAMQPLink{{}},
+			wantDials: 1,
+			wantSent:  1,
+		},
+		"link lost": {
+			links:     []*fakeAMQPLink{{errs: []error{amqp.ErrLinkClosed}}, {}},
+			wantDials: 2,
+			wantSent:  1,
+		},
+		"detached": {
+			links:     []*fakeAMQPLink{{errs: []error{&amqp.DetachError{}}}, {}},
+			wantDials: 2,
+			wantSent:  1,
+		},
+		"lost twice": {
+			links:     []*fakeAMQPLink{{errs: []error{amqp.ErrConnClosed}}, {errs: []error{amqp.ErrConnClosed}}},
+			wantErr:   amqp.ErrConnClosed,
+			wantDials: 2,
+		},
+		"not retried": {
+			links:     []*fakeAMQPLink{{errs: []error{rejected}}},
+			wantErr:   rejected,
+			wantDials: 1,
+		},
+		"dial failure": {
+			dialErr:   rejected,
+			wantErr:   rejected,
+			wantDials: 1,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			dials := 0
+			s := &amqpSender{dial: func() (amqpLink, error) {
+				dials++
+				if tc.dialErr != nil {
+					return nil, tc.dialErr
+				}
+				return tc.links[dials-1], nil
+			}}
+
+			err := s.Send(context.Background(), binding.ToMessage(&event))
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("Send() = %v, want %v", err, tc.wantErr)
+			}
+			if dials != tc.wantDials {
+				t.Errorf("dials = %d, want %d", dials, tc.wantDials)
+			}
+			sent := 0
+			for i, l := range tc.links {
+				sent += len(l.sent)
+				if i < dials-1 && !l.closed {
+					t.Errorf("Expected the lost link %d to be closed", i)
+				}
+			}
+			if sent != tc.wantSent {
+				t.Errorf("sent = %d, want %d", sent, tc.wantSent)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultMQTTQoS is the QoS the events are published with when the sink
+	// URI does not set one, at least once.
+	defaultMQTTQoS = 1
+	// defaultMQTTKeepAlive is the keep alive period of the connection to
+	// the broker, in seconds, when the sink URI does not set one.
+	defaultMQTTKeepAlive = 30
+)
+
+// mqttBinding publishes the events to the topic of an MQTT 5 broker, at a
+// URI of the form mqtt[s]://[user:password@]host[:port]/topic. Its query sets
+// the qos (0, 1 or 2) and the retain flag of the events, along with the
+// clientid and the keepalive period of the connection. The connection is
+// reestablished whenever it is lost.
+type mqttBinding struct{}
+
+var _ EgressBinding = mqttBinding{}
+
+func (mqttBinding) NewSender(target *url.URL) (protocol.Sender, error) {
+	topic := strings.TrimPrefix(target.Path, "/")
+	if topic == "" {
+		return nil, fmt.Errorf("no MQTT topic in %s", target.Redacted())
+	}
+	query := target.Query()
+	qos, err := parseQoS(query.Get("qos"), defaultMQTTQoS, 2)
+	if err != nil {
+		return nil, err
+	}
+	var retain bool
+	if r := query.Get("retain"); r != "" {
+		if retain, err = strconv.ParseBool(r); err != nil {
+			return nil, fmt.Errorf("invalid MQTT retain flag %q: %w", r, err)
+		}
+	}
+	keepAlive := uint64(defaultMQTTKeepAlive)
+	if k := query.Get("keepalive"); k != "" {
+		if keepAlive, err = strconv.ParseUint(k, 10, 16); err != nil {
+			return nil, fmt.Errorf("invalid MQTT keep alive period %q: %w", k, err)
+		}
+	}
+	clientID := query.Get("clientid")
+	if clientID == "" {
+		clientID = "knative-" + uuid.New().String()
+	}
+
+	broker := &url.URL{Scheme: target.Scheme, Host: target.Host}
+	if target.Port() == "" {
+		port := "1883"
+		if strings.EqualFold(target.Scheme, "mqtts") {
+			port = "8883"
+		}
+		broker.Host = net.JoinHostPort(target.Hostname(), port)
+	}
+	config := autopaho.ClientConfig{
+		BrokerUrls:     []*url.URL{broker},
+		KeepAlive:      uint16(keepAlive),
+		OnConnectError: func(error) {},
+		ClientConfig: paho.ClientConfig{
+			ClientID: clientID,
+		},
+	}
+	if user := target.User; user != nil {
+		password, _ := user.Password()
+		config.SetUsernamePassword(user.Username(), []byte(password))
+	}
+
+	// The connection outlives the sending of the events, it is only closed
+	// with the sender.
+	cm, err := autopaho.NewConnection(context.Background(), config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the MQTT broker %s: %w", broker, err)
+	}
+	return &mqttSender{
+		publisher: cm,
+		topic:     topic,
+		qos:       qos,
+		retain:    retain,
+	}, nil
+}
+
+// parseQoS returns the QoS in value, or def when value is empty.
+func parseQoS(value string, def, max byte) (byte, error) {
+	if value == "" {
+		return def, nil
+	}
+	qos, err := strconv.ParseUint(value, 10, 8)
+	if err != nil || byte(qos) > max {
+		return 0, fmt.Errorf("invalid QoS %q, must be between 0 and %d", value, max)
+	}
+	return byte(qos), nil
+}
+
+// mqttPublisher publishes the messages to the broker.
+type mqttPublisher interface {
+	AwaitConnection(ctx context.Context) error
+	Publish(ctx context.Context, p *paho.Publish) (*paho.PublishResponse, error)
+	Disconnect(ctx context.Context) error
+}
+
+// mqttSender publishes the events to the topic.
+type mqttSender struct {
+	publisher mqttPublisher
+	topic     string
+	qos       byte
+	retain    bool
+}
+
+var _ protocol.Sender = (*mqttSender)(nil)
+var _ protocol.Closer = (*mqttSender)(nil)
+
+// Send implements protocol.Sender. It waits for the connection to the broker
+// to be up.
+func (s *mqttSender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) (err error) {
+	defer func() { _ = m.Finish(err) }()
+
+	msg := &paho.Publish{
+		QoS:        s.qos,
+		Retain:     s.retain,
+		Topic:      s.topic,
+		Properties: &paho.PublishProperties{},
+	}
+	w := (*mqttMessageWriter)(msg)
+	if _, err = binding.Write(ctx, m, w, w, transformers...); err != nil {
+		return err
+	}
+	if err = s.publisher.AwaitConnection(ctx); err != nil {
+		return err
+	}
+	_, err = s.publisher.Publish(ctx, msg)
+	return err
+}
+
+// Close implements protocol.Closer
+func (s *mqttSender) Close(ctx context.Context) error {
+	return s.publisher.Disconnect(ctx)
+}
+
+// mqttMessageWriter writes the events in MQTT 5 messages, as the MQTT
+// protocol binding of CloudEvents defines it. The attributes of the events
+// in binary mode are the user properties of the messages.
+type mqttMessageWriter paho.Publish
+
+var _ binding.StructuredWriter = (*mqttMessageWriter)(nil)
+var _ binding.BinaryWriter = (*mqttMessageWriter)(nil)
+
+func (w *mqttMessageWriter) SetStructuredEvent(_ context.Context, f format.Format, event io.Reader) (err error) {
+	w.Properties.ContentType = f.MediaType()
+	w.Payload, err = io.ReadAll(event)
+	return err
+}
+
+func (w *mqttMessageWriter) Start(context.Context) error {
+	return nil
+}
+
+func (w *mqttMessageWriter) End(context.Context) error {
+	return nil
+}
+
+func (w *mqttMessageWriter) SetData(data io.Reader) (err error) {
+	w.Payload, err = io.ReadAll(data)
+	return err
+}
+
+func (w *mqttMessageWriter) SetAttribute(attribute spec.Attribute, value interface{}) error {
+	if attribute.Kind() == spec.DataContentType {
+		if value == nil {
+			w.Properties.ContentType = ""
+			return nil
+		}
+		s, err := types.Format(value)
+		if err != nil {
+			return err
+		}
+		w.Properties.ContentType = s
+		return nil
+	}
+	return w.SetExtension(attribute.Name(), value)
+}
+
+func (w *mqttMessageWriter) SetExtension(name string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	s, err := types.Format(value)
+	if err != nil {
+		return err
+	}
+	w.Properties.User.Add(name, s)
+	return nil
+}
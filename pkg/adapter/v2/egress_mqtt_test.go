@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/eclipse/paho.golang/paho"
+)
+
+type fakeMQTTPublisher struct {
+	connectErr error
+	published  []*paho.Publish
+}
+
+func (p *fakeMQTTPublisher) AwaitConnection(context.Context) error {
+	return p.connectErr
+}
+
+func (p *fakeMQTTPublisher) Publish(_ context.Context, msg *paho.Publish) (*paho.PublishResponse, error) {
+	p.published = append(p.published, msg)
+	return &paho.PublishResponse{}, nil
+}
+
+func (p *fakeMQTTPublisher) Disconnect(context.Context) error {
+	return nil
+}
+
+func TestMQTTBindingNewSender(t *testing.T) {
+	tests := map[string]struct {
+		target  string
+		wantErr bool
+		qos     byte
+		retain  bool
+	}{
+		"defaults":          {target: "mqtt://broker/events", qos: 1},
+		"qos and retain":    {target: "mqtts://user:pw@broker:8884/a/b?qos=2&retain=true", qos: 2, retain: true},
+		"no topic":          {target: "mqtt://broker", wantErr: true},
+		"invalid qos":       {target: "mqtt://broker/events?qos=3", wantErr: true},
+		"invalid retain":    {target: "mqtt://broker/events?retain=maybe", wantErr: true},
+		"invalid keepalive": {target: "mqtt://broker/events?keepalive=-1", wantErr: true},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			u, err := url.Parse(tc.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s, err := mqttBinding{}.NewSender(u)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			sender := s.(*mqttSender)
+			defer sender.Close(context.Background())
+			if sender.qos != tc.qos || sender.retain != tc.retain {
+				t.Errorf("qos, retain = %d, %t, want %d, %t", sender.qos, sender.retain, tc.qos, tc.retain)
+			}
+		})
+	}
+}
+
+func TestMQTTSenderSend(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+	event.SetExtension("foo", "bar")
+	if err := event.SetData(cloudevents.ApplicationJSON, map[string]string{"hello": "world"}); err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := &fakeMQTTPublisher{}
+	s := &mqttSender{publisher: publisher, topic: "events", qos: 1}
+
+	if err := s.Send(context.Background(), binding.ToMessage(&event)); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := s.Send(binding.WithForceStructured(context.Background()), binding.ToMessage(&event)); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if len(publisher.published) != 2 {
+		t.Fatalf("Expected 2 messages to be published, got %d", len(publisher.published))
+	}
+
+	binary := publisher.published[0]
+	if binary.Topic != "events" || binary.QoS != 1 {
+		t.Errorf("topic, qos = %s, %d, want events, 1", binary.Topic, binary.QoS)
+	}
+	if got := binary.Properties.ContentType; got != cloudevents.ApplicationJSON {
+		t.Errorf("content type = %s, want %s", got, cloudevents.ApplicationJSON)
+	}
+	for name, want := range map[string]string{"specversion": "1.0", "id": "abc-123", "type": "unit.type", "foo": "bar"} {
+		if got := binary.Properties.User.Get(name); got != want {
+			t.Errorf("user property %s = %q, want %q", name, got, want)
+		}
+	}
+	if got := string(binary.Payload); got != `{"hello":"world"}` {
+		t.Errorf("payload = %s", got)
+	}
+
+	structured := publisher.published[1]
+	if got := structured.Properties.ContentType; got != cloudevents.ApplicationCloudEventsJSON {
+		t.Errorf("content type = %s, want %s", got, cloudevents.ApplicationCloudEventsJSON)
+	}
+	if got := structured.Properties.User.Get("id"); got != "" {
+		t.Errorf("Unexpected id user property %q in structured mode", got)
+	}
+	got := cloudevents.NewEvent()
+	if err := got.UnmarshalJSON(structured.Payload); err != nil {
+		t.Fatal("Failed to decode the structured event:", err)
+	}
+	if got.ID() != "abc-123" {
+		t.Errorf("id = %s, want abc-123", got.ID())
+	}
+}
+
+func TestMQTTSenderSendNotConnected(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+
+	connectErr := errors.New("not connected")
+	publisher := &fakeMQTTPublisher{connectErr: connectErr}
+	s := &mqttSender{publisher: publisher, topic: "events"}
+	if err := s.Send(context.Background(), binding.ToMessage(&event)); !errors.Is(err, connectErr) {
+		t.Errorf("Send() = %v, want %v", err, connectErr)
+	}
+	if len(publisher.published) != 0 {
+		t.Errorf("Unexpected published messages: %v", publisher.published)
+	}
+}
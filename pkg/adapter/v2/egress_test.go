@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// fakeEgressBinding records the events sent to its sinks.
+type fakeEgressBinding struct {
+	targets []string
+	sent    []event.Event
+}
+
+func (b *fakeEgressBinding) NewSender(target *url.URL) (protocol.Sender, error) {
+	b.targets = append(b.targets, target.String())
+	return b, nil
+}
+
+func (b *fakeEgressBinding) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) error {
+	e, err := binding.ToEvent(ctx, m, transformers...)
+	if err != nil {
+		return err
+	}
+	b.sent = append(b.sent, *e)
+	return m.Finish(nil)
+}
+
+func TestEgressBindingFor(t *testing.T) {
+	custom := &fakeEgressBinding{}
+	RegisterEgressBinding(custom, "Unit-Test")
+
+	tests := map[string]struct {
+		target string
+		want   EgressBinding
+	}{
+		"http":           {target: "http://sink.ns.svc.cluster.local"},
+		"https":          {target: "https://sink.ns.svc.cluster.local"},
+		"no scheme":      {target: "sink"},
+		"invalid":        {target: ":not a uri"},
+		"mqtt":           {target: "mqtt://broker:1883/events", want: mqttBinding{}},
+		"mqtts":          {target: "mqtts://broker/events", want: mqttBinding{}},
+		"amqp":           {target: "amqp://broker/events", want: amqpBinding{}},
+		"amqps":          {target: "AMQPS://broker/events", want: amqpBinding{}},
+		"registered":     {target: "unit-test://sink", want: custom},
+		"not registered": {target: "unit-other://sink"},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, u := egressBindingFor(tc.target)
+			if got != tc.want {
+				t.Errorf("egressBindingFor() = %v, want %v", got, tc.want)
+			}
+			if got != nil && u == nil {
+				t.Error("Expected the URI of the target")
+			}
+		})
+	}
+}
+
+func TestNewCloudEventsClient_egress(t *testing.T) {
+	b := &fakeEgressBinding{}
+	RegisterEgressBinding(b, "unit-egress")
+
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+
+	reporter := &mockReporter{}
+	c, err := NewCloudEventsClient("unit-egress://sink/topic", &duckv1.CloudEventOverrides{
+		Extensions: map[string]string{"foo": "bar"},
+	}, reporter)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal("Failed to send the event:", res)
+	}
+
+	env := &EnvConfig{Sink: "unit-egress://env/topic"}
+	c, err = NewCloudEventsClientCRStatus(env, reporter, nil)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal("Failed to send the event:", res)
+	}
+
+	if want := []string{"unit-egress://sink/topic", "unit-egress://env/topic"}; len(b.targets) != 2 || b.targets[0] != want[0] || b.targets[1] != want[1] {
+		t.Errorf("targets = %v, want %v", b.targets, want)
+	}
+	if len(b.sent) != 2 {
+		t.Fatalf("Expected 2 events to be sent, got %d", len(b.sent))
+	}
+	if got := b.sent[0].Extensions()["foo"]; got != "bar" {
+		t.Errorf("foo extension = %v, want bar", got)
+	}
+	if reporter.eventCount != 2 {
+		t.Errorf("Expected 2 events to be reported, got %d", reporter.eventCount)
+	}
+}
+
+func TestNewCloudEventsClientForSink_egress(t *testing.T) {
+	b := &fakeEgressBinding{}
+	RegisterEgressBinding(b, "unit-sink-egress")
+
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+
+	// The OIDC settings of the HTTP sinks do not keep the sinks of the other
+	// protocols from being sent to with their egress binding.
+	env := &EnvConfig{
+		Sink:          "http://sink.example.com",
+		SinkAudience:  "sink-audience",
+		OIDCTokensDir: t.TempDir(),
+	}
+	c, err := NewCloudEventsClientForSink(env, "unit-sink-egress://dls/topic", "dls-audience", nil, &mockReporter{})
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal("Failed to send the event:", res)
+	}
+
+	if want := []string{"unit-sink-egress://dls/topic"}; len(b.targets) != 1 || b.targets[0] != want[0] {
+		t.Errorf("targets = %v, want %v", b.targets, want)
+	}
+	if len(b.sent) != 1 {
+		t.Fatalf("Expected 1 event to be sent, got %d", len(b.sent))
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	nethttp "net/http"
+
+	ceclient "github.com/cloudevents/sdk-go/v2/client"
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+const (
+	// EncodingBinary sends the outbound events in binary mode.
+	EncodingBinary = "binary"
+	// EncodingStructured sends the outbound events in structured mode.
+	EncodingStructured = "structured"
+
+	// ContentEncodingGzip compresses the body of the outbound requests with gzip.
+	ContentEncodingGzip = "gzip"
+)
+
+// encodingOptions returns the client options forcing the encoding of the
+// outbound events to encoding. The SDK picks the encoding when it is empty.
+func encodingOptions(encoding string) ([]ceclient.Option, error) {
+	switch encoding {
+	case "":
+		return nil, nil
+	case EncodingBinary:
+		return []ceclient.Option{ceclient.WithForceBinary()}, nil
+	case EncodingStructured:
+		return []ceclient.Option{ceclient.WithForceStructured()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported %s %q", EnvConfigCEEncoding, encoding)
+	}
+}
+
+// contentEncodingOptions returns the protocol options applying contentEncoding
+// to the body of the outbound requests.
+func contentEncodingOptions(contentEncoding string) ([]http.Option, error) {
+	switch contentEncoding {
+	case "":
+		return nil, nil
+	case ContentEncodingGzip:
+		return []http.Option{WithGzipContentEncoding()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported %s %q", EnvConfigCEContentEncoding, contentEncoding)
+	}
+}
+
+// WithGzipContentEncoding compresses the body of the outbound requests with
+// gzip. It decorates the round tripper set by the previous options.
+func WithGzipContentEncoding() http.Option {
+	return http.WithRoundTripperDecorator(func(rt nethttp.RoundTripper) nethttp.RoundTripper {
+		return &gzipTransport{base: rt}
+	})
+}
+
+type gzipTransport struct {
+	base nethttp.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *gzipTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	if req.Body == nil || req.Body == nethttp.NoBody || req.Header.Get("Content-Encoding") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := io.Copy(zw, req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	body := buf.Bytes()
+
+	// A RoundTripper must not modify the request, send a copy instead.
+	out := req.Clone(req.Context())
+	out.Header.Set("Content-Encoding", ContentEncodingGzip)
+	out.ContentLength = int64(len(body))
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return t.base.RoundTrip(out)
+}
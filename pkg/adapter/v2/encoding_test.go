@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestNewCloudEventsClient_encoding(t *testing.T) {
+	testCases := map[string]struct {
+		encoding            string
+		contentEncoding     string
+		wantContentType     string
+		wantContentEncoding string
+		wantErr             bool
+	}{
+		"default": {
+			wantContentType: cloudevents.ApplicationJSON,
+		},
+		"binary": {
+			encoding:        EncodingBinary,
+			wantContentType: cloudevents.ApplicationJSON,
+		},
+		"structured": {
+			encoding:        EncodingStructured,
+			wantContentType: cloudevents.ApplicationCloudEventsJSON,
+		},
+		"binary with gzip": {
+			encoding:            EncodingBinary,
+			contentEncoding:     ContentEncodingGzip,
+			wantContentType:     cloudevents.ApplicationJSON,
+			wantContentEncoding: ContentEncodingGzip,
+		},
+		"structured with gzip": {
+			encoding:            EncodingStructured,
+			contentEncoding:     ContentEncodingGzip,
+			wantContentType:     cloudevents.ApplicationCloudEventsJSON,
+			wantContentEncoding: ContentEncodingGzip,
+		},
+		"unsupported encoding": {
+			encoding: "batched",
+			wantErr:  true,
+		},
+		"unsupported content encoding": {
+			contentEncoding: "br",
+			wantErr:         true,
+		},
+	}
+
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			received := make(chan *nethttp.Request, 1)
+			bodies := make(chan []byte, 1)
+			server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				body := io.Reader(r.Body)
+				if r.Header.Get("Content-Encoding") == ContentEncodingGzip {
+					zr, err := gzip.NewReader(r.Body)
+					if err != nil {
+						t.Error("Failed to read the gzip body:", err)
+						w.WriteHeader(nethttp.StatusBadRequest)
+						return
+					}
+					body = zr
+				}
+				b, err := io.ReadAll(body)
+				if err != nil {
+					t.Error("Failed to read the body:", err)
+				}
+				received <- r
+				bodies <- b
+				w.WriteHeader(nethttp.StatusAccepted)
+			}))
+			defer server.Close()
+
+			env := &EnvConfig{
+				Sink:              server.URL,
+				CEEncoding:        tc.encoding,
+				CEContentEncoding: tc.contentEncoding,
+			}
+			c, err := NewCloudEventsClientCRStatus(env, &mockReporter{}, nil)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+
+			event := cloudevents.NewEvent()
+			event.SetID("abc-123")
+			event.SetSource("unit/test")
+			event.SetType("unit.type")
+			if err := event.SetData(cloudevents.ApplicationJSON, map[string]string{"hello": "world"}); err != nil {
+				t.Fatal(err)
+			}
+			if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+				t.Fatal("Failed to send the event:", res)
+			}
+
+			r, body := <-received, <-bodies
+			if got := r.Header.Get("Content-Type"); got != tc.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tc.wantContentType)
+			}
+			if got := r.Header.Get("Content-Encoding"); got != tc.wantContentEncoding {
+				t.Errorf("Content-Encoding = %q, want %q", got, tc.wantContentEncoding)
+			}
+			if tc.wantContentType == cloudevents.ApplicationJSON {
+				if got, want := string(body), `{"hello":"world"}`; got != want {
+					t.Errorf("body = %s, want %s", got, want)
+				}
+			} else if len(body) == 0 {
+				t.Error("Expected an event in the body")
+			}
+		})
+	}
+}
+
+func TestNewCloudEventsClientForSink_encoding(t *testing.T) {
+	received := make(chan *nethttp.Request, 1)
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		received <- r
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer server.Close()
+	slow := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		time.Sleep(2 * time.Second)
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer slow.Close()
+
+	env := &EnvConfig{
+		Sink:              "http://sink.example.com",
+		EnvSinkTimeout:    "1",
+		CEEncoding:        EncodingStructured,
+		CEContentEncoding: ContentEncodingGzip,
+	}
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+
+	// The events are sent to the other sinks than the sink of env with its
+	// encoding, content encoding and timeout.
+	c, err := NewCloudEventsClientForSink(env, server.URL, "", nil, &mockReporter{})
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal("Failed to send the event:", res)
+	}
+	r := <-received
+	if got := r.Header.Get("Content-Type"); got != cloudevents.ApplicationCloudEventsJSON {
+		t.Errorf("Content-Type = %q, want %q", got, cloudevents.ApplicationCloudEventsJSON)
+	}
+	if got := r.Header.Get("Content-Encoding"); got != ContentEncodingGzip {
+		t.Errorf("Content-Encoding = %q, want %q", got, ContentEncodingGzip)
+	}
+
+	c, err = NewCloudEventsClientForSink(env, slow.URL, "", nil, &mockReporter{})
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if res := c.Send(context.Background(), event); cloudevents.IsACK(res) {
+		t.Error("Expected the sink timeout to expire")
+	}
+}
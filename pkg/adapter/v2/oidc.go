@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+const (
+	// oidcTokenRereadInterval is how often the token file is read again, so
+	// the token the kubelet rotated in it is picked up.
+	oidcTokenRereadInterval = time.Minute
+	// oidcTokenExpiryMargin is how long before its expiry a token is no
+	// longer sent.
+	oidcTokenExpiryMargin = 30 * time.Second
+)
+
+// OIDCTokenFileName returns the name of the file the OIDC token for audience
+// is projected in, within the OIDC tokens directory.
+func OIDCTokenFileName(audience string) string {
+	sum := sha256.Sum256([]byte(audience))
+	return hex.EncodeToString(sum[:8])
+}
+
+// OIDCTokenPath returns the path of the file the OIDC token for audience is
+// projected in, within dir.
+func OIDCTokenPath(dir, audience string) string {
+	return filepath.Join(dir, OIDCTokenFileName(audience))
+}
+
+// oidcOptions returns the protocol options authenticating the outbound
+// requests with the OIDC token for audience in dir. The requests are not
+// authenticated when audience is empty.
+func oidcOptions(dir, audience string) ([]http.Option, error) {
+	if audience == "" {
+		return nil, nil
+	}
+	if dir == "" {
+		return nil, fmt.Errorf("%s is required with %s", EnvConfigOIDCTokensDir, EnvConfigSinkAudience)
+	}
+	return []http.Option{WithOIDCToken(OIDCTokenPath(dir, audience))}, nil
+}
+
+// WithOIDCToken sets the Authorization header of the outbound requests to
+// the bearer token in the file at path, such as a projected ServiceAccount
+// token. The file is read again as the token nears its expiry, and when the
+// sink rejects it. It decorates the round tripper set by the previous
+// options.
+func WithOIDCToken(path string) http.Option {
+	tokens := &fileTokenSource{path: path, now: time.Now}
+	return http.WithRoundTripperDecorator(func(rt nethttp.RoundTripper) nethttp.RoundTripper {
+		return &oidcTransport{base: rt, tokens: tokens}
+	})
+}
+
+type oidcTransport struct {
+	base   nethttp.RoundTripper
+	tokens *fileTokenSource
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *oidcTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	token, err := t.tokens.token()
+	if err != nil {
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request, send a copy instead.
+	out := req.Clone(req.Context())
+	out.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.base.RoundTrip(out)
+	if err == nil && resp.StatusCode == nethttp.StatusUnauthorized {
+		// The token may have been rotated before its expiry.
+		t.tokens.invalidate()
+	}
+	return resp, err
+}
+
+// fileTokenSource reads the token in the file at path, and keeps it until it
+// is to be read again.
+type fileTokenSource struct {
+	path string
+	now  func() time.Time
+
+	mu     sync.Mutex
+	cached string
+	reread time.Time
+}
+
+func (s *fileTokenSource) token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.cached != "" && now.Before(s.reread) {
+		return s.cached, nil
+	}
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the OIDC token: %w", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("no OIDC token in %s", s.path)
+	}
+
+	s.cached = token
+	s.reread = now.Add(oidcTokenRereadInterval)
+	if expiry, ok := tokenExpiry(token); ok && expiry.Add(-oidcTokenExpiryMargin).Before(s.reread) {
+		s.reread = expiry.Add(-oidcTokenExpiryMargin)
+	}
+	return token, nil
+}
+
+// invalidate drops the cached token, so the next request reads it again.
+func (s *fileTokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cached = ""
+}
+
+// tokenExpiry returns the expiry of the JWT token, whose signature is left to
+// the sink to verify.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Expiry int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Expiry == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Expiry, 0), true
+}
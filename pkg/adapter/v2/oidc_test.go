@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestOIDCTokenFileName(t *testing.T) {
+	a := OIDCTokenFileName("https://broker-ingress.knative-eventing/default/default")
+	if a != OIDCTokenFileName("https://broker-ingress.knative-eventing/default/default") {
+		t.Error("Expected the file name of an audience to be stable")
+	}
+	if a == OIDCTokenFileName("https://broker-ingress.knative-eventing/default/other") {
+		t.Error("Expected the file names of different audiences to differ")
+	}
+	if got := OIDCTokenPath("/var/run/oidc", "aud"); got != "/var/run/oidc/"+OIDCTokenFileName("aud") {
+		t.Errorf("OIDCTokenPath() = %s", got)
+	}
+}
+
+func TestNewCloudEventsClient_oidc(t *testing.T) {
+	dir := t.TempDir()
+	path := OIDCTokenPath(dir, "sink-audience")
+	if err := os.WriteFile(path, []byte("first-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	authorizations := make(chan string, 3)
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		authorization := r.Header.Get("Authorization")
+		authorizations <- authorization
+		if authorization != "Bearer second-token" {
+			w.WriteHeader(nethttp.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer server.Close()
+
+	env := &EnvConfig{
+		Sink:          server.URL,
+		SinkAudience:  "sink-audience",
+		OIDCTokensDir: dir,
+	}
+	c, err := NewCloudEventsClientCRStatus(env, &mockReporter{}, nil)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+
+	if res := c.Send(context.Background(), event); cloudevents.IsACK(res) {
+		t.Fatal("Expected the first token to be rejected")
+	}
+	// The rejected token is read again, after the kubelet rotated it.
+	if err := os.WriteFile(path, []byte("second-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal("Failed to send the event:", res)
+	}
+
+	for _, want := range []string{"Bearer first-token", "Bearer second-token"} {
+		if got := <-authorizations; got != want {
+			t.Errorf("Authorization = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestNewCloudEventsClient_oidcNoTokensDir(t *testing.T) {
+	env := &EnvConfig{
+		Sink:         "http://sink.example.com",
+		SinkAudience: "sink-audience",
+	}
+	if _, err := NewCloudEventsClientCRStatus(env, &mockReporter{}, nil); err == nil {
+		t.Fatal("Expected an error without the OIDC tokens directory")
+	}
+}
+
+func TestFileTokenSource(t *testing.T) {
+	now := time.Unix(1667296800, 0)
+	path := t.TempDir() + "/token"
+	tokens := &fileTokenSource{path: path, now: func() time.Time { return now }}
+
+	if _, err := tokens.token(); err == nil {
+		t.Error("Expected an error without a token file")
+	}
+
+	jwt := func(exp time.Time) string {
+		payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"aud":["sink"],"exp":%d}`, exp.Unix())))
+		return "header." + payload + ".signature"
+	}
+	// The token expires before the next reread interval.
+	first := jwt(now.Add(40 * time.Second))
+	if err := os.WriteFile(path, []byte(first), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := tokens.token(); err != nil || got != first {
+		t.Fatalf("token() = %q, %v, want %q", got, err, first)
+	}
+
+	second := jwt(now.Add(time.Hour))
+	if err := os.WriteFile(path, []byte(second), 0600); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(5 * time.Second)
+	if got, _ := tokens.token(); got != first {
+		t.Errorf("token() = %q, want the cached token %q", got, first)
+	}
+	now = now.Add(5 * time.Second)
+	if got, _ := tokens.token(); got != second {
+		t.Errorf("token() = %q, want the token read again %q", got, second)
+	}
+
+	// A token which is not a JWT is read again every interval.
+	if err := os.WriteFile(path, []byte("opaque"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(oidcTokenRereadInterval)
+	if got, _ := tokens.token(); got != "opaque" {
+		t.Errorf("token() = %q, want opaque", got)
+	}
+}
+
+func TestNewCloudEventsClientForSink_oidc(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(OIDCTokenPath(dir, "dls-audience"), []byte("dls-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	authorizations := make(chan string, 1)
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		authorizations <- r.Header.Get("Authorization")
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer server.Close()
+
+	env := &EnvConfig{
+		Sink:          "http://sink.example.com",
+		SinkAudience:  "sink-audience",
+		OIDCTokensDir: dir,
+	}
+	c, err := NewCloudEventsClientForSink(env, server.URL, "dls-audience", nil, &mockReporter{})
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal("Failed to send the event:", res)
+	}
+	if got, want := <-authorizations, "Bearer dls-token"; got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+}
@@ -47,7 +47,6 @@ type EventData struct {
 }
 
 var _ cloudevents.Client = (*TestCloudEventsClient)(nil)
-var eventData EventData
 
 // Send_AppendResult will enqueue a response for the following Send call.
 // For testing.
@@ -72,6 +71,7 @@ func (c *TestCloudEventsClient) Send(ctx context.Context, out event.Event) proto
 	defer c.lock.Unlock()
 	// TODO: improve later.
 	bytes, _ := json.Marshal(out)
+	var eventData EventData
 	if err := json.Unmarshal(bytes, &eventData); err != nil {
 		fmt.Printf("json unmarshal error %s:", err)
 	}
@@ -102,6 +102,7 @@ func (c *TestCloudEventsClient) Request(ctx context.Context, out event.Event) (*
 	defer c.lock.Unlock()
 	// TODO: improve later.
 	bytes, _ := json.Marshal(out)
+	var eventData EventData
 	if err := json.Unmarshal(bytes, &eventData); err != nil {
 		fmt.Printf("json unmarshal error %s:", err)
 	}
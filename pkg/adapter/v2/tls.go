@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	nethttp "net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/fsnotify/fsnotify"
+	"go.opencensus.io/plugin/ochttp"
+
+	"knative.dev/pkg/tracing/propagation/tracecontextb3"
+)
+
+// tlsOptions returns the protocol options sending the outbound requests with
+// the certificates of env. The system CA certificates are used, and no
+// client certificate is presented, when env has none.
+func tlsOptions(env EnvConfigAccessor) ([]http.Option, error) {
+	caCertsFile := env.GetCACertsFile()
+	certFile, keyFile := env.GetClientCertFiles()
+	if caCertsFile == "" && certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	opt, err := WithTLSFiles(caCertsFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return []http.Option{opt}, nil
+}
+
+// WithTLSFiles sends the outbound requests with the PEM CA certificates in
+// caCertsFile, instead of the system ones, and presents the PEM client
+// certificate and key in certFile and keyFile, when they are set. The files
+// are watched, and loaded again when they change, such as when the kubelet
+// updates the Secret or the ConfigMap they are mounted from. The previous
+// certificates are kept while the files are not valid.
+func WithTLSFiles(caCertsFile, certFile, keyFile string) (http.Option, error) {
+	t, err := newTLSTransport(caCertsFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch the TLS files: %w", err)
+	}
+	if err := t.watch(watcher); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	return http.WithRoundTripper(&ochttp.Transport{
+		Base:        t,
+		Propagation: tracecontextb3.TraceContextEgress,
+	}), nil
+}
+
+// tlsTransport sends the requests with the transport configured with the
+// certificates last loaded from the files.
+type tlsTransport struct {
+	caCertsFile string
+	certFile    string
+	keyFile     string
+
+	mu        sync.RWMutex
+	transport *nethttp.Transport
+	// loaded is the content of the files the transport was configured with.
+	loaded []byte
+}
+
+var _ nethttp.RoundTripper = (*tlsTransport)(nil)
+
+func newTLSTransport(caCertsFile, certFile, keyFile string) (*tlsTransport, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("%s and %s are required together", EnvConfigClientCertFile, EnvConfigClientKeyFile)
+	}
+	t := &tlsTransport{caCertsFile: caCertsFile, certFile: certFile, keyFile: keyFile}
+	if _, err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *tlsTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	t.mu.RLock()
+	transport := t.transport
+	t.mu.RUnlock()
+	return transport.RoundTrip(req)
+}
+
+// reload configures a new transport with the certificates in the files,
+// unless they did not change, and returns whether it did.
+func (t *tlsTransport) reload() (bool, error) {
+	var loaded []byte
+	read := func(file string) ([]byte, error) {
+		if file == "" {
+			return nil, nil
+		}
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		loaded = append(loaded, b...)
+		return b, nil
+	}
+	caCerts, err := read(t.caCertsFile)
+	if err != nil {
+		return false, err
+	}
+	cert, err := read(t.certFile)
+	if err != nil {
+		return false, err
+	}
+	key, err := read(t.keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	t.mu.RLock()
+	unchanged := t.transport != nil && bytes.Equal(loaded, t.loaded)
+	t.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCerts != nil {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caCerts) {
+			return false, fmt.Errorf("no PEM CA certificate in %s", t.caCertsFile)
+		}
+	}
+	if cert != nil {
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return false, fmt.Errorf("failed to load the client certificate in %s: %w", t.certFile, err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+	transport.TLSClientConfig = config
+
+	t.mu.Lock()
+	previous := t.transport
+	t.transport, t.loaded = transport, loaded
+	t.mu.Unlock()
+	// The connections established with the previous certificates are closed
+	// once idle, so the next requests are sent with the new ones.
+	if previous != nil {
+		previous.CloseIdleConnections()
+	}
+	return true, nil
+}
+
+// watch reloads the files on the changes of their directories, until
+// watcher is closed. The directories are watched rather than the files, as
+// the kubelet replaces the mounted files by swapping a symbolic link.
+func (t *tlsTransport) watch(watcher *fsnotify.Watcher) error {
+	dirs := make(map[string]struct{})
+	for _, file := range []string{t.caCertsFile, t.certFile, t.keyFile} {
+		if file == "" {
+			continue
+		}
+		dir := filepath.Dir(file)
+		if _, ok := dirs[dir]; ok {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+		dirs[dir] = struct{}{}
+	}
+	go func() {
+		for {
+			select {
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				// The files being partially written are not valid, and are
+				// loaded on their next change.
+				_, _ = t.reload()
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/fsnotify/fsnotify"
+)
+
+// testCA is a CA issuing the certificates of the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM certificate and key of a leaf certificate for the
+// local host, valid for usage.
+func (ca *testCA) issue(t *testing.T, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// newMTLSServer returns a server presenting a certificate of ca, and
+// requiring a client certificate of clientCA.
+func newMTLSServer(t *testing.T, ca, clientCA *testCA) *httptest.Server {
+	t.Helper()
+	certPEM, keyPEM := ca.issue(t, x509.ExtKeyUsageServerAuth)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCA.cert)
+
+	server := httptest.NewUnstartedServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeFile(t *testing.T, path string, b []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testEvent() cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+	return event
+}
+
+func TestNewCloudEventsClient_mTLS(t *testing.T) {
+	ca, clientCA := newTestCA(t), newTestCA(t)
+	server := newMTLSServer(t, ca, clientCA)
+
+	dir := t.TempDir()
+	certPEM, keyPEM := clientCA.issue(t, x509.ExtKeyUsageClientAuth)
+	writeFile(t, filepath.Join(dir, "ca.crt"), ca.pem)
+	writeFile(t, filepath.Join(dir, "tls.crt"), certPEM)
+	writeFile(t, filepath.Join(dir, "tls.key"), keyPEM)
+
+	tests := map[string]struct {
+		env    *EnvConfig
+		wantOK bool
+	}{
+		"CA certificates and client certificate": {
+			env: &EnvConfig{
+				CACertsFile:    filepath.Join(dir, "ca.crt"),
+				ClientCertFile: filepath.Join(dir, "tls.crt"),
+				ClientKeyFile:  filepath.Join(dir, "tls.key"),
+			},
+			wantOK: true,
+		},
+		"no client certificate": {
+			env: &EnvConfig{
+				CACertsFile: filepath.Join(dir, "ca.crt"),
+			},
+		},
+		"system CA certificates": {
+			env: &EnvConfig{
+				ClientCertFile: filepath.Join(dir, "tls.crt"),
+				ClientKeyFile:  filepath.Join(dir, "tls.key"),
+			},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			tc.env.Sink = server.URL
+			c, err := NewCloudEventsClientCRStatus(tc.env, &mockReporter{}, nil)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			if res := c.Send(context.Background(), testEvent()); cloudevents.IsACK(res) != tc.wantOK {
+				t.Errorf("Send() = %v, want ACK %v", res, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewCloudEventsClient_invalidTLSFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "ca.crt"), []byte("not a certificate"))
+
+	tests := map[string]*EnvConfig{
+		"missing CA certificates": {
+			CACertsFile: filepath.Join(dir, "missing.crt"),
+		},
+		"invalid CA certificates": {
+			CACertsFile: filepath.Join(dir, "ca.crt"),
+		},
+		"client certificate without key": {
+			ClientCertFile: filepath.Join(dir, "tls.crt"),
+		},
+	}
+	for n, env := range tests {
+		t.Run(n, func(t *testing.T) {
+			env.Sink = "https://sink.example.com"
+			if _, err := NewCloudEventsClientCRStatus(env, &mockReporter{}, nil); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestTLSTransportRotation(t *testing.T) {
+	oldCA, newCA, clientCA := newTestCA(t), newTestCA(t), newTestCA(t)
+	oldServer := newMTLSServer(t, oldCA, clientCA)
+	newServer := newMTLSServer(t, newCA, clientCA)
+
+	dir := t.TempDir()
+	caCertsFile := filepath.Join(dir, "ca.crt")
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	certPEM, keyPEM := clientCA.issue(t, x509.ExtKeyUsageClientAuth)
+	writeFile(t, caCertsFile, oldCA.pem)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+
+	transport, err := newTLSTransport(caCertsFile, certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+	if err := transport.watch(watcher); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(url string) error {
+		req, _ := nethttp.NewRequest(nethttp.MethodGet, url, nil)
+		res, err := transport.RoundTrip(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+	if err := get(oldServer.URL); err != nil {
+		t.Fatal("Failed to send to the server of the old CA:", err)
+	}
+	if err := get(newServer.URL); err == nil {
+		t.Fatal("Expected the server of the new CA to be rejected")
+	}
+
+	// The CA bundle is rotated to the new CA.
+	writeFile(t, caCertsFile, newCA.pem)
+	deadline := time.Now().Add(10 * time.Second)
+	for get(newServer.URL) != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the new CA certificates to be loaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := get(oldServer.URL); err == nil {
+		t.Error("Expected the server of the old CA to be rejected after the rotation")
+	}
+
+	// An invalid bundle keeps the previous certificates.
+	if changed, err := transport.reload(); changed || err != nil {
+		t.Errorf("reload() = %v, %v, want no change", changed, err)
+	}
+	writeFile(t, caCertsFile, []byte("not a certificate"))
+	if _, err := transport.reload(); err == nil {
+		t.Error("Expected an error reloading an invalid bundle")
+	}
+	if err := get(newServer.URL); err != nil {
+		t.Error("Failed to send with the previous certificates:", err)
+	}
+}
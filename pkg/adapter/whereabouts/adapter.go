@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whereabouts
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+var ipPoolGVR = schema.GroupVersionResource{
+	Group:    "whereabouts.cni.cncf.io",
+	Version:  "v1alpha1",
+	Resource: "ippools",
+}
+
+type envConfig struct {
+	adapter.EnvConfig
+
+	// Selector is the string representation of the label selector the
+	// watched IPPools have to match.
+	Selector string `envconfig:"SELECTOR"`
+}
+
+type ipPoolAdapter struct {
+	ce     cloudevents.Client
+	logger *zap.SugaredLogger
+
+	k8s       dynamic.Interface
+	selector  string
+	source    string
+	namespace string
+	name      string
+}
+
+// NewEnvConfig creates an empty configuration for the WhereaboutsSource adapter.
+func NewEnvConfig() adapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter creates an adapter emitting an event each time IPs are allocated
+// from or released to a Whereabouts IPPool of the namespace of the source.
+func NewAdapter(ctx context.Context, processed adapter.EnvConfigAccessor, ceClient cloudevents.Client) adapter.Adapter {
+	env := processed.(*envConfig)
+
+	return &ipPoolAdapter{
+		ce:     ceClient,
+		logger: logging.FromContext(ctx),
+
+		k8s:       dynamicclient.Get(ctx),
+		selector:  env.Selector,
+		source:    sourcesv1.WhereaboutsSourceSource(env.Namespace, env.Name),
+		namespace: env.Namespace,
+		name:      env.Name,
+	}
+}
+
+func (a *ipPoolAdapter) Start(ctx context.Context) error {
+	res := a.k8s.Resource(ipPoolGVR).Namespace(a.namespace)
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = a.selector
+			return res.List(ctx, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = a.selector
+			return res.Watch(ctx, opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 10*time.Hour, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.handle(nil, obj.(*unstructured.Unstructured))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			a.handle(oldObj.(*unstructured.Unstructured), newObj.(*unstructured.Unstructured))
+		},
+	})
+
+	a.logger.Infow("Starting WhereaboutsSource adapter", zap.String("selector", a.selector))
+	go informer.Run(ctx.Done())
+
+	srv := &http.Server{
+		Addr: ":8080",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	}
+	go srv.ListenAndServe()
+
+	<-ctx.Done()
+	return srv.Shutdown(context.Background())
+}
+
+// handle sends an event when the number of allocations of pool differs from
+// the one of old. A nil old stands for an IPPool seen for the first time.
+func (a *ipPoolAdapter) handle(old, pool *unstructured.Unstructured) {
+	allocated := allocations(pool)
+	if old != nil && allocations(old) == allocated {
+		return
+	}
+
+	ctx, event, err := makeEvent(a.source, a.namespace, a.name, pool, allocated)
+	if err != nil {
+		a.logger.Infow("event creation failed", zap.Error(err))
+		return
+	}
+	a.sendCloudEvent(ctx, event)
+}
+
+func allocations(pool *unstructured.Unstructured) int64 {
+	allocs, _, _ := unstructured.NestedMap(pool.Object, "spec", "allocations")
+	return int64(len(allocs))
+}
+
+func (a *ipPoolAdapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) {
+	event.SetID(uuid.New().String()) // provide an ID here so we can track it with logging
+	defer a.logger.Debug("Finished sending cloudevent id: ", event.ID())
+	a.logger.Debugf("sending cloudevent id: %s, subject: %s", event.ID(), event.Subject())
+
+	if result := a.ce.Send(ctx, event); !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send cloudevent", zap.Error(result), zap.String("subject", event.Subject()),
+			zap.String("id", event.ID()))
+	} else {
+		a.logger.Debugf("cloudevent sent id: %s, subject: %s", event.ID(), event.Subject())
+	}
+}
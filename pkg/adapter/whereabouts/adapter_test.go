@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whereabouts
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+func ipPool(network string, allocated int) *unstructured.Unstructured {
+	allocations := make(map[string]interface{}, allocated)
+	for i := 0; i < allocated; i++ {
+		allocations[string(rune('1'+i))] = map[string]interface{}{
+			"id":     "container-id",
+			"podref": "default/pod",
+			"ifname": "net1",
+		}
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "whereabouts.cni.cncf.io/v1alpha1",
+			"kind":       "IPPool",
+			"metadata": map[string]interface{}{
+				"namespace": "kube-system",
+				"name":      "pool",
+			},
+			"spec": map[string]interface{}{
+				"range":       network,
+				"allocations": allocations,
+			},
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		old *unstructured.Unstructured
+		new *unstructured.Unstructured
+
+		wantSent        bool
+		wantAllocated   interface{}
+		wantTotal       interface{}
+		wantUtilization interface{}
+	}{
+		"new pool": {
+			new:             ipPool("10.0.0.0/24", 0),
+			wantSent:        true,
+			wantAllocated:   int32(0),
+			wantTotal:       int32(254),
+			wantUtilization: int32(0),
+		},
+		"allocation": {
+			old:             ipPool("10.0.0.0/28", 2),
+			new:             ipPool("10.0.0.0/28", 3),
+			wantSent:        true,
+			wantAllocated:   int32(3),
+			wantTotal:       int32(14),
+			wantUtilization: int32(21),
+		},
+		"release": {
+			old:             ipPool("10.0.0.0/30", 2),
+			new:             ipPool("10.0.0.0/30", 1),
+			wantSent:        true,
+			wantAllocated:   int32(1),
+			wantTotal:       int32(2),
+			wantUtilization: int32(50),
+		},
+		"allocations unchanged": {
+			old: ipPool("10.0.0.0/24", 2),
+			new: ipPool("10.0.0.0/24", 2),
+		},
+		"ipv6 pool": {
+			old:             ipPool("fd00::/120", 0),
+			new:             ipPool("fd00::/120", 1),
+			wantSent:        true,
+			wantAllocated:   int32(1),
+			wantTotal:       int32(256),
+			wantUtilization: int32(0),
+		},
+		"uncountable ipv6 pool": {
+			new:           ipPool("fd00::/64", 1),
+			wantSent:      true,
+			wantAllocated: int32(1),
+		},
+		"invalid range": {
+			new:           ipPool("not-a-cidr", 1),
+			wantSent:      true,
+			wantAllocated: int32(1),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ce := adaptertest.NewTestClient()
+			a := &ipPoolAdapter{
+				ce:        ce,
+				logger:    zap.NewNop().Sugar(),
+				source:    "unit-test",
+				namespace: "kube-system",
+				name:      "test-whereaboutssource",
+			}
+
+			a.handle(tc.old, tc.new)
+
+			sent := ce.Sent()
+			if !tc.wantSent {
+				if len(sent) != 0 {
+					t.Fatalf("Expected no event to be sent, got %d", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("Expected 1 event to be sent, got %d", len(sent))
+			}
+			event := sent[0]
+			if event.Type() != sources.WhereaboutsSourceEventType {
+				t.Errorf("Expected event type %q, got %q", sources.WhereaboutsSourceEventType, event.Type())
+			}
+			if got, want := event.Subject(), "/apis/whereabouts.cni.cncf.io/v1alpha1/namespaces/kube-system/ippools/pool"; got != want {
+				t.Errorf("Expected subject %q, got %q", want, got)
+			}
+			network, _, _ := unstructured.NestedString(tc.new.Object, "spec", "range")
+			if got := event.Extensions()["network"]; got != network {
+				t.Errorf("Expected network %q, got %q", network, got)
+			}
+			if got := event.Extensions()["allocated"]; got != tc.wantAllocated {
+				t.Errorf("Expected allocated %v, got %v", tc.wantAllocated, got)
+			}
+			if got := event.Extensions()["total"]; got != tc.wantTotal {
+				t.Errorf("Expected total %v, got %v", tc.wantTotal, got)
+			}
+			if got := event.Extensions()["utilizationpercent"]; got != tc.wantUtilization {
+				t.Errorf("Expected utilizationpercent %v, got %v", tc.wantUtilization, got)
+			}
+		})
+	}
+}
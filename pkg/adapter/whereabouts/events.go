@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whereabouts
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kncloudevents "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/apis/sources"
+)
+
+const (
+	resourceGroup = "whereaboutssources.sources.knative.dev"
+)
+
+func makeEvent(source, namespace, name string, pool *unstructured.Unstructured, allocated int64) (context.Context, cloudevents.Event, error) {
+	network, _, _ := unstructured.NestedString(pool.Object, "spec", "range")
+
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetType(sources.WhereaboutsSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(fmt.Sprintf("/apis/%s/namespaces/%s/%s/%s",
+		ipPoolGVR.GroupVersion().String(), pool.GetNamespace(), ipPoolGVR.Resource, pool.GetName()))
+	event.SetExtension("network", network)
+	event.SetExtension("allocated", allocated)
+	if total, ok := usableAddresses(network); ok {
+		event.SetExtension("total", total)
+		if total > 0 {
+			event.SetExtension("utilizationpercent", allocated*100/total)
+		}
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, pool); err != nil {
+		return nil, event, err
+	}
+
+	ctx := kncloudevents.ContextWithMetricTag(context.Background(), &kncloudevents.MetricTag{
+		Namespace:     namespace,
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 50*time.Millisecond, 5)
+
+	return ctx, event, nil
+}
+
+// usableAddresses returns the number of addresses Whereabouts can allocate
+// from the given CIDR, which excludes the network and broadcast addresses of
+// IPv4 ranges. It returns false when the range is not a valid CIDR or holds
+// more addresses than a CloudEvents integer can represent.
+func usableAddresses(cidr string) (int64, bool) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return 0, false
+	}
+	ones, bits := ipNet.Mask.Size()
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	if bits == 8*net.IPv4len && bits-ones > 1 {
+		size.Sub(size, big.NewInt(2))
+	}
+	if !size.IsInt64() || size.Int64() > math.MaxInt32 {
+		return 0, false
+	}
+	return size.Int64(), true
+}
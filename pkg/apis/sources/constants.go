@@ -30,6 +30,31 @@ const (
 	ApiServerSourceUpdateRefEventType = "dev.knative.apiserver.ref.update"
 	// ApiServerSourceDeleteRefEventType is the ApiServerSource CloudEvent type for ref deletions.
 	ApiServerSourceDeleteRefEventType = "dev.knative.apiserver.ref.delete"
+
+	// ApiServerSourceUpdateDiffEventType is the ApiServerSource CloudEvent type for diff updates.
+	ApiServerSourceUpdateDiffEventType = "dev.knative.apiserver.resource.update.diff"
+
+	// ApiServerSourceUpdateStatusEventType is the ApiServerSource CloudEvent type for status-only updates.
+	ApiServerSourceUpdateStatusEventType = "dev.knative.apiserver.resource.update.status"
+	// ApiServerSourceUpdateSpecEventType is the ApiServerSource CloudEvent type for spec-only updates.
+	ApiServerSourceUpdateSpecEventType = "dev.knative.apiserver.resource.update.spec"
+	// ApiServerSourceUpdateStatusRefEventType is the ApiServerSource CloudEvent type for status-only ref updates.
+	ApiServerSourceUpdateStatusRefEventType = "dev.knative.apiserver.ref.update.status"
+	// ApiServerSourceUpdateSpecRefEventType is the ApiServerSource CloudEvent type for spec-only ref updates.
+	ApiServerSourceUpdateSpecRefEventType = "dev.knative.apiserver.ref.update.spec"
+
+	// ApiServerSourceBatchEventType is the ApiServerSource CloudEvent type for batches.
+	ApiServerSourceBatchEventType = "dev.knative.apiserver.resource.batch"
+	// ApiServerSourceBatchRefEventType is the ApiServerSource CloudEvent type for ref batches.
+	ApiServerSourceBatchRefEventType = "dev.knative.apiserver.ref.batch"
+
+	// ApiServerSourceSyncEventType is the ApiServerSource CloudEvent type for periodic resyncs.
+	ApiServerSourceSyncEventType = "dev.knative.apiserver.resource.sync"
+	// ApiServerSourceSyncRefEventType is the ApiServerSource CloudEvent type for periodic ref resyncs.
+	ApiServerSourceSyncRefEventType = "dev.knative.apiserver.ref.sync"
+
+	// ApiServerSourceScaleEventType is the ApiServerSource CloudEvent type for changes of the replicas of scale subresources.
+	ApiServerSourceScaleEventType = "dev.knative.apiserver.resource.scale"
 )
 
 // ApiServerSourceEventReferenceModeTypes is the list of CloudEvent types the ApiServerSource with EventMode of ReferenceMode emits.
@@ -45,3 +70,60 @@ var ApiServerSourceEventResourceModeTypes = []string{
 	ApiServerSourceDeleteEventType,
 	ApiServerSourceUpdateEventType,
 }
+
+// ApiServerSourceEventDiffModeTypes is the list of CloudEvent types the ApiServerSource with EventMode of DiffMode emits.
+var ApiServerSourceEventDiffModeTypes = []string{
+	ApiServerSourceAddEventType,
+	ApiServerSourceDeleteEventType,
+	ApiServerSourceUpdateEventType,
+	ApiServerSourceUpdateDiffEventType,
+}
+
+const (
+	// NodePressureSourceEventType is the NodePressureSource CloudEvent type for
+	// a Node pressure condition transitioning to True.
+	NodePressureSourceEventType = "dev.knative.k8s.node.pressure"
+)
+
+const (
+	// ArgoCDApplicationSetSourceEventType is the ArgoCDApplicationSetSource
+	// CloudEvent type for an Argo CD ApplicationSet being added, updated or
+	// deleted.
+	ArgoCDApplicationSetSourceEventType = "dev.knative.k8s.argocd.applicationset.change"
+)
+
+const (
+	// WhereaboutsSourceEventType is the WhereaboutsSource CloudEvent type for
+	// the number of IPs allocated from a Whereabouts IPPool changing.
+	WhereaboutsSourceEventType = "dev.knative.k8s.whereabouts.ippool.change"
+)
+
+const (
+	// BrokerStatusSourceEventType is the BrokerStatusSource CloudEvent type for
+	// a Broker Ready condition transition or ingress URI change.
+	BrokerStatusSourceEventType = "dev.knative.k8s.broker.status.change"
+)
+
+const (
+	// SecretRotationSourceEventType is the SecretRotationSource CloudEvent type
+	// for the data of a Secret changing.
+	SecretRotationSourceEventType = "dev.knative.k8s.secret.rotated"
+)
+
+const (
+	// ClusterClaimSourceEventType is the ClusterClaimSource CloudEvent type for
+	// an Open Cluster Management ClusterClaim being added, updated or deleted.
+	ClusterClaimSourceEventType = "dev.knative.k8s.ocm.clusterclaim.change"
+)
+
+const (
+	// PermissionAuditSourceEventType is the PermissionAuditSource CloudEvent
+	// type for an audited permission being granted or revoked.
+	PermissionAuditSourceEventType = "dev.knative.k8s.permission.changed"
+)
+
+const (
+	// ChannelStatusSourceEventType is the ChannelStatusSource CloudEvent type
+	// for a Channel Ready condition transition.
+	ChannelStatusSourceEventType = "dev.knative.k8s.channel.status.change"
+)
@@ -18,6 +18,28 @@ package v1
 
 import (
 	"context"
+
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// DefaultApiServerSourceBatchMaxSize is the default maximum number of
+	// events of a batch.
+	DefaultApiServerSourceBatchMaxSize = 100
+	// DefaultApiServerSourceBatchWindow is the default batching window.
+	DefaultApiServerSourceBatchWindow = "1s"
+	// DefaultApiServerSourceCheckpointInterval is the default interval the
+	// checkpoint is stored at.
+	DefaultApiServerSourceCheckpointInterval = "10s"
+	// DefaultApiServerSourceOwnerRefMaxDepth is the default maximum number
+	// of owner references walked in the Controller owner reference mode.
+	DefaultApiServerSourceOwnerRefMaxDepth = 5
+	// DefaultApiServerSourceAcknowledgementPatchesPerSecond is the default
+	// maximum rate the objects are patched at to record the deliveries.
+	DefaultApiServerSourceAcknowledgementPatchesPerSecond = 10
+	// DefaultApiServerSourceClusterKubeconfigKey is the default key of the
+	// Secret holding the kubeconfig of the remote cluster.
+	DefaultApiServerSourceClusterKubeconfigKey = "kubeconfig"
 )
 
 func (s *ApiServerSource) SetDefaults(ctx context.Context) {
@@ -30,7 +52,41 @@ func (ss *ApiServerSourceSpec) SetDefaults(ctx context.Context) {
 		ss.EventMode = ReferenceMode
 	}
 
+	if ss.OwnerRefMode == OwnerRefModeController && ss.OwnerRefMaxDepth == nil {
+		ss.OwnerRefMaxDepth = ptr.Int32(DefaultApiServerSourceOwnerRefMaxDepth)
+	}
+
 	if ss.ServiceAccountName == "" {
 		ss.ServiceAccountName = "default"
 	}
+
+	if ss.Batch != nil {
+		if ss.Batch.MaxSize == 0 {
+			ss.Batch.MaxSize = DefaultApiServerSourceBatchMaxSize
+		}
+		if ss.Batch.Window == "" {
+			ss.Batch.Window = DefaultApiServerSourceBatchWindow
+		}
+	}
+
+	if ss.RateLimit != nil {
+		if ss.RateLimit.Burst == 0 {
+			ss.RateLimit.Burst = ss.RateLimit.EventsPerSecond
+		}
+		if ss.RateLimit.OverflowPolicy == "" {
+			ss.RateLimit.OverflowPolicy = OverflowPolicyBlock
+		}
+	}
+
+	if ss.Checkpoint != nil && ss.Checkpoint.Interval == "" {
+		ss.Checkpoint.Interval = DefaultApiServerSourceCheckpointInterval
+	}
+
+	if ss.Acknowledgement != nil && ss.Acknowledgement.PatchesPerSecond == 0 {
+		ss.Acknowledgement.PatchesPerSecond = DefaultApiServerSourceAcknowledgementPatchesPerSecond
+	}
+
+	if ss.Cluster != nil && ss.Cluster.KubeconfigSecretRef.Key == "" {
+		ss.Cluster.KubeconfigSecretRef.Key = DefaultApiServerSourceClusterKubeconfigKey
+	}
 }
@@ -21,8 +21,11 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
 )
 
 func TestApiServerSourceDefaults(t *testing.T) {
@@ -124,6 +127,158 @@ func TestApiServerSourceDefaults(t *testing.T) {
 				},
 			},
 		},
+		"empty Batch": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Batch:              &ApiServerSourceBatch{},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Batch: &ApiServerSourceBatch{
+						MaxSize: DefaultApiServerSourceBatchMaxSize,
+						Window:  DefaultApiServerSourceBatchWindow,
+					},
+				},
+			},
+		},
+		"Batch set": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Batch:              &ApiServerSourceBatch{MaxSize: 10, Window: "100ms"},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Batch:              &ApiServerSourceBatch{MaxSize: 10, Window: "100ms"},
+				},
+			},
+		},
+		"empty Checkpoint": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Checkpoint:         &ApiServerSourceCheckpoint{ConfigMapName: "checkpoint"},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Checkpoint: &ApiServerSourceCheckpoint{
+						ConfigMapName: "checkpoint",
+						Interval:      DefaultApiServerSourceCheckpointInterval,
+					},
+				},
+			},
+		},
+		"empty Acknowledgement": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Acknowledgement:    &ApiServerSourceAcknowledgement{},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Acknowledgement: &ApiServerSourceAcknowledgement{
+						PatchesPerSecond: DefaultApiServerSourceAcknowledgementPatchesPerSecond,
+					},
+				},
+			},
+		},
+		"Cluster without key": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Cluster: &ApiServerSourceCluster{
+						Name: "remote",
+						KubeconfigSecretRef: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "remote-kubeconfig"},
+						},
+					},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					Cluster: &ApiServerSourceCluster{
+						Name: "remote",
+						KubeconfigSecretRef: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "remote-kubeconfig"},
+							Key:                  DefaultApiServerSourceClusterKubeconfigKey,
+						},
+					},
+				},
+			},
+		},
+		"RateLimit without Burst": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					RateLimit:          &ApiServerSourceRateLimit{EventsPerSecond: 50},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					RateLimit: &ApiServerSourceRateLimit{
+						EventsPerSecond: 50,
+						Burst:           50,
+						OverflowPolicy:  OverflowPolicyBlock,
+					},
+				},
+			},
+		},
+		"RateLimit set": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					RateLimit:          &ApiServerSourceRateLimit{EventsPerSecond: 50, Burst: 200, OverflowPolicy: OverflowPolicyDrop},
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					RateLimit:          &ApiServerSourceRateLimit{EventsPerSecond: 50, Burst: 200, OverflowPolicy: OverflowPolicyDrop},
+				},
+			},
+		},
+		"Controller OwnerRefMode": {
+			initial: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					OwnerRefMode:       OwnerRefModeController,
+				},
+			},
+			expected: ApiServerSource{
+				Spec: ApiServerSourceSpec{
+					EventMode:          ResourceMode,
+					ServiceAccountName: "default",
+					OwnerRefMode:       OwnerRefModeController,
+					OwnerRefMaxDepth:   ptr.Int32(DefaultApiServerSourceOwnerRefMaxDepth),
+				},
+			},
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"fmt"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,17 +33,36 @@ const (
 	// ApiServerConditionSinkProvided has status True when the ApiServerSource has been configured with a sink target.
 	ApiServerConditionSinkProvided apis.ConditionType = "SinkProvided"
 
+	// ApiServerConditionSinksResolved has status True when the additional sinks of the ApiServerSource
+	// have been resolved, or when the ApiServerSource has no additional sinks.
+	ApiServerConditionSinksResolved apis.ConditionType = "SinksResolved"
+
 	// ApiServerConditionDeployed has status True when the ApiServerSource has had it's deployment created.
 	ApiServerConditionDeployed apis.ConditionType = "Deployed"
 
 	// ApiServerConditionSufficientPermissions has status True when the ApiServerSource has sufficient permissions to access resources.
 	ApiServerConditionSufficientPermissions apis.ConditionType = "SufficientPermissions"
+
+	// ApiServerConditionDeadLetterSinkResolved has status True when the dead letter sink of the ApiServerSource
+	// has been resolved, or when the ApiServerSource has no dead letter sink.
+	ApiServerConditionDeadLetterSinkResolved apis.ConditionType = "DeadLetterSinkResolved"
+
+	// ApiServerConditionThrottled has status True when the receive adapter of the ApiServerSource holds, drops
+	// or dead letters events above its rate limit. It does not affect the readiness of the ApiServerSource.
+	ApiServerConditionThrottled apis.ConditionType = "Throttled"
+
+	// ApiServerConditionClusterConnected has status True when the remote cluster of the ApiServerSource
+	// can be reached with the credentials of its kubeconfig, or when the ApiServerSource has no remote cluster.
+	ApiServerConditionClusterConnected apis.ConditionType = "ClusterConnected"
 )
 
 var apiserverCondSet = apis.NewLivingConditionSet(
 	ApiServerConditionSinkProvided,
+	ApiServerConditionSinksResolved,
 	ApiServerConditionDeployed,
 	ApiServerConditionSufficientPermissions,
+	ApiServerConditionDeadLetterSinkResolved,
+	ApiServerConditionClusterConnected,
 )
 
 // GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
@@ -89,6 +110,90 @@ func (s *ApiServerSourceStatus) MarkNoSink(reason, messageFormat string, message
 	apiserverCondSet.Manage(s).MarkFalse(ApiServerConditionSinkProvided, reason, messageFormat, messageA...)
 }
 
+// MarkSinks sets the condition that the additional sinks of the source have been resolved to uris.
+func (s *ApiServerSourceStatus) MarkSinks(uris []*apis.URL) {
+	s.SinkURIs = uris
+	if len(uris) == 0 {
+		apiserverCondSet.Manage(s).MarkTrueWithReason(ApiServerConditionSinksResolved, "SinksNotConfigured", "No additional sinks are configured.")
+		return
+	}
+	apiserverCondSet.Manage(s).MarkTrue(ApiServerConditionSinksResolved)
+}
+
+// MarkSinksResolvedFailed sets the condition that an additional sink of the source could not be resolved.
+func (s *ApiServerSourceStatus) MarkSinksResolvedFailed(reason, messageFormat string, messageA ...interface{}) {
+	s.SinkURIs = nil
+	apiserverCondSet.Manage(s).MarkFalse(ApiServerConditionSinksResolved, reason, messageFormat, messageA...)
+}
+
+// MarkForbiddenNamespaces sets the selected namespaces the resources of the
+// source cannot be watched in.
+func (s *ApiServerSourceStatus) MarkForbiddenNamespaces(namespaces []string) {
+	s.ForbiddenNamespaces = namespaces
+}
+
+// MarkDeadLetterSinkResolvedSucceeded sets the condition that the dead letter sink of the source has been resolved to uri.
+func (s *ApiServerSourceStatus) MarkDeadLetterSinkResolvedSucceeded(uri *apis.URL) {
+	s.DeadLetterSinkURI = uri
+	apiserverCondSet.Manage(s).MarkTrue(ApiServerConditionDeadLetterSinkResolved)
+}
+
+// MarkDeadLetterSinkNotConfigured sets the condition that the source has no dead letter sink.
+func (s *ApiServerSourceStatus) MarkDeadLetterSinkNotConfigured() {
+	s.DeadLetterSinkURI = nil
+	apiserverCondSet.Manage(s).MarkTrueWithReason(ApiServerConditionDeadLetterSinkResolved, "DeadLetterSinkNotConfigured", "No dead letter sink is configured.")
+}
+
+// MarkDeadLetterSinkResolvedFailed sets the condition that the dead letter sink of the source could not be resolved.
+func (s *ApiServerSourceStatus) MarkDeadLetterSinkResolvedFailed(reason, messageFormat string, messageA ...interface{}) {
+	s.DeadLetterSinkURI = nil
+	apiserverCondSet.Manage(s).MarkFalse(ApiServerConditionDeadLetterSinkResolved, reason, messageFormat, messageA...)
+}
+
+// MarkClusterConnected sets the condition that the remote cluster of the source can be reached.
+func (s *ApiServerSourceStatus) MarkClusterConnected() {
+	apiserverCondSet.Manage(s).MarkTrue(ApiServerConditionClusterConnected)
+}
+
+// MarkClusterNotConfigured sets the condition that the source watches the resources of its own cluster.
+func (s *ApiServerSourceStatus) MarkClusterNotConfigured() {
+	apiserverCondSet.Manage(s).MarkTrueWithReason(ApiServerConditionClusterConnected, "ClusterNotConfigured", "No remote cluster is configured.")
+}
+
+// MarkClusterDisconnected sets the condition that the remote cluster of the source cannot be reached.
+func (s *ApiServerSourceStatus) MarkClusterDisconnected(reason, messageFormat string, messageA ...interface{}) {
+	apiserverCondSet.Manage(s).MarkFalse(ApiServerConditionClusterConnected, reason, messageFormat, messageA...)
+}
+
+// MarkThrottled sets the condition that the source is throttling its events.
+func (s *ApiServerSourceStatus) MarkThrottled(reason, messageFormat string, messageA ...interface{}) {
+	apiserverCondSet.Manage(s).SetCondition(apis.Condition{
+		Type:     ApiServerConditionThrottled,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   reason,
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+// MarkNotThrottled sets the condition that the source sends its events
+// within its rate limit.
+func (s *ApiServerSourceStatus) MarkNotThrottled() {
+	apiserverCondSet.Manage(s).SetCondition(apis.Condition{
+		Type:     ApiServerConditionThrottled,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   "WithinRateLimit",
+		Message:  "The events are sent within the rate limit.",
+	})
+}
+
+// MarkNoRateLimit removes the throttling condition of a source without a
+// rate limit.
+func (s *ApiServerSourceStatus) MarkNoRateLimit() {
+	_ = apiserverCondSet.Manage(s).ClearCondition(ApiServerConditionThrottled)
+}
+
 // PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
 // ApiServerConditionDeployed should be marked as true or false.
 func (s *ApiServerSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
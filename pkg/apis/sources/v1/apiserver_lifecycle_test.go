@@ -132,6 +132,7 @@ func TestApiServerSourceStatusIsReady(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			return s
 		}(),
 		wantConditionStatus: corev1.ConditionUnknown,
@@ -161,7 +162,10 @@ func TestApiServerSourceStatusIsReady(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(availableDeployment)
 			return s
 		}(),
@@ -173,7 +177,10 @@ func TestApiServerSourceStatusIsReady(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(unavailableDeployment)
 			return s
 		}(),
@@ -185,7 +192,10 @@ func TestApiServerSourceStatusIsReady(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(unknownDeployment)
 			return s
 		}(),
@@ -197,7 +207,10 @@ func TestApiServerSourceStatusIsReady(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(&appsv1.Deployment{})
 			return s
 		}(),
@@ -209,18 +222,112 @@ func TestApiServerSourceStatusIsReady(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(availableDeployment)
 			return s
 		}(),
 		wantConditionStatus: corev1.ConditionTrue,
 		want:                true,
+	}, {
+		name: "mark sink and sufficient permissions and deployed and dead letter sink",
+		s: func() *ApiServerSourceStatus {
+			s := &ApiServerSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.MarkSinks(nil)
+			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkResolvedSucceeded(apis.HTTP("dls"))
+			s.MarkClusterNotConfigured()
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionTrue,
+		want:                true,
+	}, {
+		name: "mark sink and sufficient permissions and deployed and unresolved dead letter sink",
+		s: func() *ApiServerSourceStatus {
+			s := &ApiServerSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.MarkSinks(nil)
+			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkResolvedFailed("NotFound", "")
+			s.MarkClusterNotConfigured()
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		want:                false,
+	}, {
+		name: "mark sink and sufficient permissions and deployed and additional sinks",
+		s: func() *ApiServerSourceStatus {
+			s := &ApiServerSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.MarkSinks([]*apis.URL{apis.HTTP("other")})
+			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionTrue,
+		want:                true,
+	}, {
+		name: "mark sink and sufficient permissions and deployed and unresolved additional sinks",
+		s: func() *ApiServerSourceStatus {
+			s := &ApiServerSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.MarkSinksResolvedFailed("SinksNotFound", "")
+			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		want:                false,
+	}, {
+		name: "mark sink and sufficient permissions and deployed and connected cluster",
+		s: func() *ApiServerSourceStatus {
+			s := &ApiServerSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.MarkSinks(nil)
+			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterConnected()
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionTrue,
+		want:                true,
+	}, {
+		name: "mark sink and sufficient permissions and deployed and disconnected cluster",
+		s: func() *ApiServerSourceStatus {
+			s := &ApiServerSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.MarkSinks(nil)
+			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterDisconnected("Unreachable", "Cluster %q cannot be reached", "remote")
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		want:                false,
 	}, {
 		name: "mark sink and not enough permissions",
 		s: func() *ApiServerSourceStatus {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkNoSufficientPermissions("areason", "amessage")
 			return s
 		}(),
@@ -289,6 +396,7 @@ func TestApiServerSourceStatusGetCondition(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			return s
 		}(),
 		condQuery: ApiServerConditionReady,
@@ -302,7 +410,10 @@ func TestApiServerSourceStatusGetCondition(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(availableDeployment)
 			return s
 		}(),
@@ -317,7 +428,10 @@ func TestApiServerSourceStatusGetCondition(t *testing.T) {
 			s := &ApiServerSourceStatus{}
 			s.InitializeConditions()
 			s.MarkSink(sink)
+			s.MarkSinks(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(availableDeployment)
 			return s
 		}(),
@@ -333,6 +447,8 @@ func TestApiServerSourceStatusGetCondition(t *testing.T) {
 			s.InitializeConditions()
 			s.MarkSink(nil)
 			s.MarkSufficientPermissions()
+			s.MarkDeadLetterSinkNotConfigured()
+			s.MarkClusterNotConfigured()
 			s.PropagateDeploymentAvailability(availableDeployment)
 			return s
 		}(),
@@ -368,3 +484,43 @@ func TestApiServerSourceGetters(t *testing.T) {
 		t.Errorf("GetUntypedSpec() = %v, want: %v", got, want)
 	}
 }
+
+func TestApiServerSourceStatusThrottled(t *testing.T) {
+	s := &ApiServerSourceStatus{}
+	s.InitializeConditions()
+	s.MarkSink(apis.HTTP("example"))
+	s.MarkSinks(nil)
+	s.MarkDeadLetterSinkNotConfigured()
+	s.MarkClusterNotConfigured()
+	s.MarkSufficientPermissions()
+	s.PropagateDeploymentAvailability(availableDeployment)
+
+	s.MarkThrottled("RateLimited", "Events above %d per second are dropped.", 10)
+	got := s.GetCondition(ApiServerConditionThrottled)
+	want := &apis.Condition{
+		Type:     ApiServerConditionThrottled,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   "RateLimited",
+		Message:  "Events above 10 per second are dropped.",
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(apis.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Error("unexpected throttled condition (-want, +got) =", diff)
+	}
+	if !s.IsReady() {
+		t.Error("Expected a throttled source to be ready")
+	}
+
+	s.MarkNotThrottled()
+	if got := s.GetCondition(ApiServerConditionThrottled); !got.IsFalse() {
+		t.Errorf("Throttled condition = %v, want False", got)
+	}
+	if !s.IsReady() {
+		t.Error("Expected a source which is not throttled to be ready")
+	}
+
+	s.MarkNoRateLimit()
+	if got := s.GetCondition(ApiServerConditionThrottled); got != nil {
+		t.Errorf("Throttled condition = %v, want none", got)
+	}
+}
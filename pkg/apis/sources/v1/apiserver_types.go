@@ -17,8 +17,11 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
@@ -63,19 +66,178 @@ type ApiServerSourceSpec struct {
 	// +required
 	Resources []APIVersionKindSelector `json:"resources,omitempty"`
 
+	// Namespaces are the namespaces the namespaced resources are watched in,
+	// along with the ones NamespaceSelector selects. The resources are
+	// watched in the namespace of the source when neither is set. The
+	// namespaces the ServiceAccount cannot watch the resources in are
+	// skipped, and reported in the status.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects the namespaces the namespaced resources are
+	// watched in by their labels, including the namespaces created after the
+	// source. The ServiceAccount needs to list and watch the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// ResourceOwner is an additional filter to only track resources that are
 	// owned by a specific resource type. If ResourceOwner matches Resources[n]
 	// then Resources[n] is allowed to pass the ResourceOwner filter.
 	// +optional
 	ResourceOwner *APIVersionKind `json:"owner,omitempty"`
 
+	// OwnerRefMode controls the attribution of the events to the owners of
+	// their resources.
+	// `None` leaves the events as they are.
+	// `Controller` walks the controller owner references of the resources,
+	// up to OwnerRefMaxDepth of them, and sets the subject and the `owner`
+	// extension of the events to the top controller, e.g. the Deployment
+	// behind a Pod. The ServiceAccount needs to list and watch the owners.
+	// Defaults to `None`
+	// +optional
+	OwnerRefMode string `json:"ownerRefMode,omitempty"`
+
+	// OwnerRefMaxDepth is the maximum number of owner references walked in
+	// the `Controller` OwnerRefMode.
+	// Defaults to 5
+	// +optional
+	OwnerRefMaxDepth *int32 `json:"ownerRefMaxDepth,omitempty"`
+
+	// OwnerRefDeduplicationWindow drops, in the `Controller` OwnerRefMode,
+	// the events of a same type attributed to a same controller within this
+	// window of the first of them, e.g. `5s`. No event is dropped when it is
+	// not set.
+	// +optional
+	OwnerRefDeduplicationWindow string `json:"ownerRefDeduplicationWindow,omitempty"`
+
 	// EventMode controls the format of the event.
 	// `Reference` sends a dataref event type for the resource under watch.
 	// `Resource` send the full resource lifecycle event.
+	// `Diff` sends the full resource lifecycle event for adds and deletions,
+	// and a JSON patch of the changes to the resource for updates.
 	// Defaults to `Reference`
 	// +optional
 	EventMode string `json:"mode,omitempty"`
 
+	// Format controls the shape of the data of the add, update and delete
+	// events. `KubernetesEvent` sends a Kubernetes Event of the change of
+	// the resource, with a reason, a message and the involved resource,
+	// rather than the resource or its reference. It can not be combined with
+	// the `Diff` mode. The attributes of the events are not affected.
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// ExtensionExpressions are the CloudEvents extensions set on the events,
+	// by name, to the value the CEL expression evaluates to against the
+	// object of the event, so triggers can filter on any field of the
+	// objects, e.g. `status.phase` or `labels['app']`. The variables of the
+	// expressions are `object`, its top level fields `apiVersion`, `kind`,
+	// `metadata`, `spec`, `status` and `data`, and the `labels` and
+	// `annotations` of its metadata. An extension is not set when its
+	// expression fails to evaluate, e.g. as it selects a field the object
+	// does not have, or evaluates to a value which is not a scalar.
+	// +optional
+	ExtensionExpressions map[string]string `json:"extensionExpressions,omitempty"`
+
+	// ResyncPeriod is the period the current state of all the watched
+	// resources is sent at, as sync events, e.g. `1h`. Only the changes of
+	// the resources are sent when it is not set.
+	// +optional
+	ResyncPeriod string `json:"resyncPeriod,omitempty"`
+
+	// FieldsToDrop are the paths of the fields removed from the resources
+	// before they are sent, e.g. `metadata.managedFields` or `data.*`. The
+	// fields of a path are separated by dots, dots within a field are escaped
+	// with a backslash, `*` matches every field of an object, and the path
+	// applies to every item of the lists it goes through. It has no effect in
+	// the `Reference` mode.
+	// +optional
+	FieldsToDrop []string `json:"fieldsToDrop,omitempty"`
+
+	// DataContentType is the content type the data of the events is encoded
+	// in: `application/json`, `application/protobuf` for a
+	// google.protobuf.Value message, or `application/avro` for the Avro binary
+	// encoding of a generic value record.
+	// Defaults to `application/json`
+	// +optional
+	DataContentType string `json:"dataContentType,omitempty"`
+
+	// DataSchema is the absolute URI of the schema the data of the events
+	// adheres to, e.g. the Avro schema registered in a schema registry. It is
+	// set as the dataschema attribute of the events.
+	// +optional
+	DataSchema string `json:"dataSchema,omitempty"`
+
+	// Batch coalesces the events of the resources of a same kind into a
+	// single event carrying a JSON array of them. The events are sent one by
+	// one when it is not set.
+	// +optional
+	Batch *ApiServerSourceBatch `json:"batch,omitempty"`
+
+	// Delivery contains the retry count, backoff policy, backoff delay and
+	// timeout of the delivery of the events to the sink, and the dead letter
+	// sink the events are sent to when their delivery fails. The timeout
+	// bounds the delivery of each event, its retries included.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Concurrency is the number of workers the events are dispatched by.
+	// The events of a same object are dispatched in order by a same worker,
+	// while the events of different objects are dispatched in parallel, so
+	// a slow sink does not hold back the events of every resource.
+	// Defaults to 1, which dispatches the events one after the other.
+	// +optional
+	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// RateLimit bounds the rate the events are sent at, so a storm of
+	// changes of the resources does not overwhelm the sinks. The events are
+	// sent as fast as they come when it is not set.
+	// +optional
+	RateLimit *ApiServerSourceRateLimit `json:"rateLimit,omitempty"`
+
+	// Checkpoint records the resourceVersion of the last delivered event of
+	// each resource in a ConfigMap, so the changes a restart of the adapter
+	// missed are replayed when it starts again. The events are only kept in
+	// memory when it is not set.
+	// +optional
+	Checkpoint *ApiServerSourceCheckpoint `json:"checkpoint,omitempty"`
+
+	// Acknowledgement records the delivery of the events on the objects
+	// they are about, in their `sources.knative.dev/last-event-delivered`
+	// annotation set to the `<id>@<time>` of their last delivered event, as
+	// an in-cluster audit trail of the deliveries. The ServiceAccount needs
+	// to be able to patch the watched resources then. The deliveries are
+	// not recorded when it is not set.
+	// +optional
+	Acknowledgement *ApiServerSourceAcknowledgement `json:"acknowledgement,omitempty"`
+
+	// Filters are evaluated on the events before they are sent, with the
+	// same dialects as the Trigger filters. Only the events which pass all
+	// the filters are sent. All the events are sent when it is empty.
+	// +optional
+	Filters []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
+
+	// Sinks are additional sinks the events are sent to, along with Sink.
+	// Each event is sent to all the sinks whose filters it passes
+	// concurrently, and each delivery is retried independently.
+	// +optional
+	Sinks []ApiServerSourceSink `json:"sinks,omitempty"`
+
+	// TLS configures the certificates the events are sent to the HTTPS sinks
+	// with. The certificates are reloaded when they are rotated. The sinks'
+	// certificates are verified with the system CA certificates when it is
+	// not set.
+	// +optional
+	TLS *ApiServerSourceTLS `json:"tls,omitempty"`
+
+	// Cluster is the remote cluster the resources are watched in, with the
+	// credentials of a kubeconfig, while the events are sent to the sinks of
+	// this cluster. The credentials are reloaded when the Secret holding
+	// them is rotated. The resources are watched in the cluster of the
+	// source when it is not set.
+	// +optional
+	Cluster *ApiServerSourceCluster `json:"cluster,omitempty"`
+
 	// ServiceAccountName is the name of the ServiceAccount to use to run this
 	// source. Defaults to default if not set.
 	// +optional
@@ -92,6 +254,105 @@ type ApiServerSourceStatus struct {
 	// * SinkURI - the current active sink URI that has been configured for the
 	//   Source.
 	duckv1.SourceStatus `json:",inline"`
+
+	// DeliveryStatus contains the resolved URI of the dead letter sink of
+	// the source.
+	eventingduckv1.DeliveryStatus `json:",inline"`
+
+	// SinkURIs are the resolved URIs of the additional sinks of the source,
+	// in the order of spec.sinks.
+	// +optional
+	SinkURIs []*apis.URL `json:"sinkUris,omitempty"`
+
+	// ForbiddenNamespaces are the selected namespaces the ServiceAccount
+	// cannot watch the resources in, which are not watched.
+	// +optional
+	ForbiddenNamespaces []string `json:"forbiddenNamespaces,omitempty"`
+}
+
+// ApiServerSourceSink is an additional sink the ApiServerSource events are
+// sent to.
+type ApiServerSourceSink struct {
+	// Sink is the destination the events are sent to.
+	Sink duckv1.Destination `json:"sink"`
+
+	// Filters are evaluated on the events before they are sent to this sink,
+	// with the same dialects as the Trigger filters. When the events are
+	// batched, they are evaluated on the batch events. All the events are
+	// sent to this sink when it is empty.
+	// +optional
+	Filters []eventingv1.SubscriptionsAPIFilter `json:"filters,omitempty"`
+}
+
+// ApiServerSourceBatch configures the batching of the ApiServerSource events.
+type ApiServerSourceBatch struct {
+	// MaxSize is the maximum number of events of a batch. A batch is sent as
+	// soon as it is full.
+	// Defaults to 100
+	// +optional
+	MaxSize int32 `json:"maxSize,omitempty"`
+
+	// Window is the maximum time the first event of a batch waits for the
+	// batch to be sent, as a duration string such as "1s".
+	// Defaults to 1s
+	// +optional
+	Window string `json:"window,omitempty"`
+}
+
+// ApiServerSourceRateLimit configures the token bucket the ApiServerSource
+// events are sent through.
+type ApiServerSourceRateLimit struct {
+	// EventsPerSecond is the sustained rate the events are sent at. When the
+	// events are batched, it is the rate of the events before they are
+	// batched.
+	// +required
+	EventsPerSecond int32 `json:"eventsPerSecond"`
+
+	// Burst is the number of events which can be sent at once above the
+	// sustained rate.
+	// Defaults to EventsPerSecond
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+
+	// OverflowPolicy controls the events above the limit.
+	// `Block` holds them until they are within the limit, which slows down
+	// the watch of the resources.
+	// `Drop` drops them, and counts them in the `rate_limited_event_count`
+	// metric.
+	// `DeadLetter` sends them to the dead letter sink of the delivery, with
+	// the 429 error code.
+	// Defaults to `Block`
+	// +optional
+	OverflowPolicy string `json:"overflowPolicy,omitempty"`
+}
+
+// ApiServerSourceTLS configures the certificates the ApiServerSource events
+// are sent to the HTTPS sinks with.
+type ApiServerSourceTLS struct {
+	// CACerts is the bundle of PEM CA certificates the sinks' certificates
+	// are verified with, instead of the system ones, such as a trust-manager
+	// bundle.
+	// +optional
+	CACerts *ApiServerSourceCABundle `json:"caCerts,omitempty"`
+
+	// ClientCertSecretName is the name of the `kubernetes.io/tls` Secret,
+	// in the namespace of the source, holding the client certificate and key
+	// presented to the sinks requiring mutual TLS.
+	// +optional
+	ClientCertSecretName string `json:"clientCertSecretName,omitempty"`
+}
+
+// ApiServerSourceCABundle is the key of a ConfigMap or a Secret, in the
+// namespace of the source, holding a bundle of PEM CA certificates. Exactly
+// one of them is set.
+type ApiServerSourceCABundle struct {
+	// ConfigMapKeyRef selects the key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects the key of a Secret.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
 // APIVersionKind is an APIVersion and Kind tuple.
@@ -118,6 +379,46 @@ type APIVersionKindSelector struct {
 	// More info: http://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors
 	// +optional
 	LabelSelector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// FieldSelector filters this source to objects to those resources pass the
+	// field selector, e.g. `status.phase=Running`. Only the fields the API
+	// server supports for the resource can be selected.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// ClassifyUpdates sets the `changedsection` extension of the update
+	// events of the resource to the top level sections of the objects which
+	// changed, e.g. `spec,status`, and sends the updates which only changed
+	// the status or the spec with the `update.status` or `update.spec` event
+	// types.
+	// +optional
+	ClassifyUpdates bool `json:"classifyUpdates,omitempty"`
+
+	// Subresource is the subresource of the resource watched along with it.
+	// `scale` sends the `scale` events of the changes of the replicas of the
+	// scale subresource of the objects, along with their other events.
+	// +optional
+	Subresource string `json:"subresource,omitempty"`
+
+	// EventTypeTemplate is the template of the types of the events of the
+	// resource, in place of their default types, e.g.
+	// `com.mycorp.{kind}.{verb}`. Its placeholders are the `{group}`,
+	// `{version}` and `{kind}` of the resource, the group of the core
+	// resources being `core` and the kind in lower case, and the `{mode}`,
+	// `resource` or `ref`, and the `{verb}`, e.g. `add` or `update.status`,
+	// of the default types. The batch events keep their default types.
+	// +optional
+	EventTypeTemplate string `json:"eventTypeTemplate,omitempty"`
+
+	// Verbs are the verbs of the events of the resource which are sent,
+	// among `add`, `update` and `delete`, e.g. only `delete` to watch the
+	// deletions of a high churn resource. The update events include their
+	// `update.status` and `update.spec` classifications. The events which
+	// are not of a verb, like the sync, scale and batch events, are sent
+	// whatever the verbs. All the events are sent when it is empty.
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -133,3 +434,40 @@ type ApiServerSourceList struct {
 func (a *ApiServerSource) GetStatus() *duckv1.Status {
 	return &a.Status.Status
 }
+
+// ApiServerSourceCluster is a remote cluster an ApiServerSource watches.
+type ApiServerSourceCluster struct {
+	// Name identifies the cluster in the `cluster` extension of the events.
+	Name string `json:"name"`
+
+	// KubeconfigSecretRef selects the key of the Secret, in the namespace of
+	// the source, holding the kubeconfig of the cluster.
+	// The key defaults to kubeconfig
+	KubeconfigSecretRef corev1.SecretKeySelector `json:"kubeconfigSecretRef"`
+}
+
+// ApiServerSourceAcknowledgement configures the recording of the delivery of
+// the ApiServerSource events on the objects they are about.
+type ApiServerSourceAcknowledgement struct {
+	// PatchesPerSecond is the maximum rate the objects are patched at. The
+	// deliveries of the events of an object above it are recorded at once,
+	// with its last delivered event.
+	// Defaults to 10
+	// +optional
+	PatchesPerSecond int32 `json:"patchesPerSecond,omitempty"`
+}
+
+// ApiServerSourceCheckpoint configures the checkpointing of the delivery of
+// the ApiServerSource events.
+type ApiServerSourceCheckpoint struct {
+	// ConfigMapName is the name of the ConfigMap, in the namespace of the
+	// source, the checkpoint is stored in. Defaults to a name derived from
+	// the name of the source.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// Interval is how often the checkpoint is stored, e.g. `10s`. Defaults
+	// to `10s`.
+	// +optional
+	Interval string `json:"interval,omitempty"`
+}
@@ -18,11 +18,26 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"knative.dev/pkg/apis"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/apis/sources"
 )
 
 const (
@@ -30,10 +45,86 @@ const (
 	ReferenceMode = "Reference"
 	// ResourceMode produces payloads of ResourceEvent
 	ResourceMode = "Resource"
+	// DiffMode produces payloads of ResourceEvent, except for updates which
+	// produce payloads of JSON patches
+	DiffMode = "Diff"
+
+	// KubernetesEventFormat produces payloads of Kubernetes Events
+	KubernetesEventFormat = "KubernetesEvent"
+
+	// OwnerRefModeNone leaves the events as they are
+	OwnerRefModeNone = "None"
+	// OwnerRefModeController attributes the events to the top controller of
+	// their resources
+	OwnerRefModeController = "Controller"
+
+	// OverflowPolicyBlock holds the events above the rate limit until they
+	// are within it
+	OverflowPolicyBlock = "Block"
+	// OverflowPolicyDrop drops the events above the rate limit
+	OverflowPolicyDrop = "Drop"
+	// OverflowPolicyDeadLetter sends the events above the rate limit to the
+	// dead letter sink
+	OverflowPolicyDeadLetter = "DeadLetter"
+
+	// ScaleSubresource watches the scale subresource of a resource
+	ScaleSubresource = "scale"
+
+	// AddVerb selects the add events of a resource
+	AddVerb = "add"
+	// UpdateVerb selects the update events of a resource
+	UpdateVerb = "update"
+	// DeleteVerb selects the delete events of a resource
+	DeleteVerb = "delete"
+
+	// PausedAnnotationKey is the annotation pausing the emission of the
+	// events of an ApiServerSource when it is "true", and resuming it when it
+	// is "false". The resources are still watched while paused.
+	PausedAnnotationKey = "sources.knative.dev/paused"
+	// ReplayOnResumeAnnotationKey is the annotation replaying, on resume, the
+	// events of the objects which changed while paused when it is "true".
+	ReplayOnResumeAnnotationKey = "sources.knative.dev/replay-on-resume"
+	// LastEventDeliveredAnnotationKey is the annotation the delivery of the
+	// last event of an object is recorded in on the object, as
+	// `<id>@<time>`, when the deliveries are acknowledged.
+	LastEventDeliveredAnnotationKey = "sources.knative.dev/last-event-delivered"
+
+	// maxOwnerRefMaxDepth is the maximum number of owner references which
+	// can be walked.
+	maxOwnerRefMaxDepth = 20
+
+	// maxConcurrency is the maximum number of workers the events can be
+	// dispatched by.
+	maxConcurrency = 1000
 )
 
+// apiServerSourceDataContentTypes are the content types the data of the
+// ApiServerSource events can be encoded in.
+var apiServerSourceDataContentTypes = sets.NewString("application/json", "application/protobuf", "application/avro")
+
+// apiServerSourceVerbs are the verbs of the events of a resource which can be
+// selected.
+var apiServerSourceVerbs = sets.NewString(AddVerb, UpdateVerb, DeleteVerb)
+
+// reservedExtensionNames are the CloudEvents attributes the ExtensionExpressions
+// can not set.
+var reservedExtensionNames = sets.NewString("specversion", "id", "source", "type", "subject", "time", "datacontenttype", "dataschema", "data")
+
 func (c *ApiServerSource) Validate(ctx context.Context) *apis.FieldError {
-	return c.Spec.Validate(ctx).ViaField("spec")
+	errs := validatePauseAnnotations(c.Annotations).ViaField("metadata")
+	return errs.Also(c.Spec.Validate(ctx).ViaField("spec"))
+}
+
+// validatePauseAnnotations validates the annotations pausing and resuming
+// the emission of the events, which are booleans.
+func validatePauseAnnotations(annotations map[string]string) *apis.FieldError {
+	var errs *apis.FieldError
+	for _, key := range []string{PausedAnnotationKey, ReplayOnResumeAnnotationKey} {
+		if v, ok := annotations[key]; ok && v != "true" && v != "false" {
+			errs = errs.Also(apis.ErrInvalidValue(v, apis.CurrentField, `must be "true" or "false"`).ViaFieldKey("annotations", key))
+		}
+	}
+	return errs
 }
 
 func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
@@ -41,12 +132,22 @@ func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 
 	// Validate mode, if can be empty or set as certain value
 	switch cs.EventMode {
-	case ReferenceMode, ResourceMode:
+	case ReferenceMode, ResourceMode, DiffMode:
 	// EventMode is valid.
 	default:
 		errs = errs.Also(apis.ErrInvalidValue(cs.EventMode, "mode"))
 	}
 
+	switch cs.Format {
+	case "":
+	case KubernetesEventFormat:
+		if cs.EventMode == DiffMode {
+			errs = errs.Also(apis.ErrGeneric("the KubernetesEvent format can not be combined with the Diff mode", "format", "mode"))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(cs.Format, "format"))
+	}
+
 	// Validate sink
 	errs = errs.Also(cs.Sink.Validate(ctx).ViaField("sink"))
 
@@ -61,8 +162,37 @@ func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 		if strings.TrimSpace(res.Kind) == "" {
 			errs = errs.Also(apis.ErrMissingField("kind").ViaFieldIndex("resources", i))
 		}
+		if _, err := fields.ParseSelector(res.FieldSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(res.FieldSelector, "fieldSelector").ViaFieldIndex("resources", i))
+		}
+		if res.EventTypeTemplate != "" {
+			if _, err := ExpandEventTypeTemplate(res.EventTypeTemplate, sources.ApiServerSourceAddEventType, res.APIVersion, res.Kind); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(res.EventTypeTemplate, apis.CurrentField, err.Error()).ViaField("eventTypeTemplate").ViaFieldIndex("resources", i))
+			}
+		}
+		switch res.Subresource {
+		case "", ScaleSubresource:
+		// Subresource is valid.
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(res.Subresource, "subresource").ViaFieldIndex("resources", i))
+		}
+		for j, verb := range res.Verbs {
+			if !apiServerSourceVerbs.Has(verb) {
+				errs = errs.Also(apis.ErrInvalidArrayValue(verb, "verbs", j).ViaFieldIndex("resources", i))
+			}
+		}
 	}
 
+	for i, namespace := range cs.Namespaces {
+		if msgs := validation.IsDNS1123Label(namespace); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidArrayValue(namespace, "namespaces", i))
+		}
+	}
+	if cs.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(cs.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrGeneric(err.Error(), "namespaceSelector"))
+		}
+	}
 	if cs.ResourceOwner != nil {
 		_, err := schema.ParseGroupVersion(cs.ResourceOwner.APIVersion)
 		if err != nil {
@@ -72,6 +202,399 @@ func (cs *ApiServerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 			errs = errs.Also(apis.ErrMissingField("kind").ViaField("owner"))
 		}
 	}
+	switch cs.OwnerRefMode {
+	case "", OwnerRefModeNone, OwnerRefModeController:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(cs.OwnerRefMode, "ownerRefMode"))
+	}
+	if cs.OwnerRefMaxDepth != nil && (*cs.OwnerRefMaxDepth < 1 || *cs.OwnerRefMaxDepth > maxOwnerRefMaxDepth) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*cs.OwnerRefMaxDepth, 1, maxOwnerRefMaxDepth, "ownerRefMaxDepth"))
+	}
+	if cs.Concurrency != nil && (*cs.Concurrency < 1 || *cs.Concurrency > maxConcurrency) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*cs.Concurrency, 1, maxConcurrency, "concurrency"))
+	}
+	if cs.OwnerRefDeduplicationWindow != "" {
+		if window, err := time.ParseDuration(cs.OwnerRefDeduplicationWindow); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(cs.OwnerRefDeduplicationWindow, "ownerRefDeduplicationWindow"))
+		} else if window <= 0 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(cs.OwnerRefDeduplicationWindow, "0s", "", "ownerRefDeduplicationWindow"))
+		}
+	}
+	if cs.ResyncPeriod != "" {
+		if period, err := time.ParseDuration(cs.ResyncPeriod); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(cs.ResyncPeriod, "resyncPeriod"))
+		} else if period <= 0 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(cs.ResyncPeriod, "0s", "", "resyncPeriod"))
+		}
+	}
+	if cs.DataContentType != "" && !apiServerSourceDataContentTypes.Has(cs.DataContentType) {
+		errs = errs.Also(apis.ErrInvalidValue(cs.DataContentType, "dataContentType"))
+	}
+	if cs.DataSchema != "" {
+		if u, err := url.Parse(cs.DataSchema); err != nil || !u.IsAbs() {
+			errs = errs.Also(apis.ErrInvalidValue(cs.DataSchema, "dataSchema"))
+		}
+	}
+
+	if cs.Batch != nil {
+		errs = errs.Also(cs.Batch.Validate(ctx).ViaField("batch"))
+		if cs.DataContentType != "" && cs.DataContentType != "application/json" {
+			errs = errs.Also(apis.ErrGeneric("batching requires the application/json data content type", "batch", "dataContentType"))
+		}
+	}
+
+	if cs.Delivery != nil {
+		errs = errs.Also(cs.Delivery.Validate(ctx).ViaField("delivery"))
+	}
+
+	if cs.RateLimit != nil {
+		errs = errs.Also(cs.RateLimit.Validate(ctx).ViaField("rateLimit"))
+		if cs.RateLimit.OverflowPolicy == OverflowPolicyDeadLetter && (cs.Delivery == nil || cs.Delivery.DeadLetterSink == nil) {
+			errs = errs.Also(apis.ErrGeneric("the DeadLetter overflow policy requires a dead letter sink", "rateLimit.overflowPolicy", "delivery.deadLetterSink"))
+		}
+	}
+
+	for i, path := range cs.FieldsToDrop {
+		if _, err := ParseFieldPath(path); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(path, apis.CurrentField, err.Error()).ViaFieldIndex("fieldsToDrop", i))
+		}
+	}
+	for name, expr := range cs.ExtensionExpressions {
+		if !isExtensionName(name) {
+			errs = errs.Also(apis.ErrInvalidKeyName(name, "extensionExpressions", "keys MUST be lowercase alphanumeric"))
+		} else if reservedExtensionNames.Has(name) {
+			errs = errs.Also(apis.ErrInvalidKeyName(name, "extensionExpressions", "keys MUST NOT be CloudEvents attributes"))
+		}
+		if _, err := CompileExtensionExpression(expr); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(expr, apis.CurrentField, err.Error()).ViaKey(name).ViaField("extensionExpressions"))
+		}
+	}
+	if cs.Checkpoint != nil {
+		errs = errs.Also(cs.Checkpoint.Validate(ctx).ViaField("checkpoint"))
+	}
+	if cs.Acknowledgement != nil {
+		errs = errs.Also(cs.Acknowledgement.Validate(ctx).ViaField("acknowledgement"))
+	}
+	errs = errs.Also(validateFilters(ctx, cs.Filters).ViaField("filters"))
+	for i, sink := range cs.Sinks {
+		errs = errs.Also(sink.Sink.Validate(ctx).ViaField("sink").ViaFieldIndex("sinks", i))
+		errs = errs.Also(validateFilters(ctx, sink.Filters).ViaField("filters").ViaFieldIndex("sinks", i))
+	}
+	if cs.TLS != nil {
+		errs = errs.Also(cs.TLS.Validate(ctx).ViaField("tls"))
+	}
+	if cs.Cluster != nil {
+		errs = errs.Also(cs.Cluster.Validate(ctx).ViaField("cluster"))
+	}
 	errs = errs.Also(cs.SourceSpec.Validate(ctx))
 	return errs
 }
+
+// validateFilters validates the filters of the ApiServerSource events. Unlike
+// the Trigger filters, they are not behind the new-trigger-filters feature,
+// so they are always validated.
+func validateFilters(ctx context.Context, filters []eventingv1.SubscriptionsAPIFilter) *apis.FieldError {
+	if len(filters) == 0 {
+		return nil
+	}
+	ctx = feature.ToContext(ctx, feature.Flags{feature.NewTriggerFilters: feature.Enabled})
+	return eventingv1.ValidateSubscriptionAPIFiltersList(ctx, filters)
+}
+
+func (c *ApiServerSourceCheckpoint) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if c.ConfigMapName != "" {
+		if msgs := validation.IsDNS1123Subdomain(c.ConfigMapName); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(c.ConfigMapName, "configMapName", strings.Join(msgs, ", ")))
+		}
+	}
+	if interval, err := time.ParseDuration(c.Interval); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(c.Interval, "interval"))
+	} else if interval <= 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(c.Interval, "0s", "", "interval"))
+	}
+	return errs
+}
+
+func (a *ApiServerSourceAcknowledgement) Validate(ctx context.Context) *apis.FieldError {
+	if a.PatchesPerSecond < 1 {
+		return apis.ErrOutOfBoundsValue(a.PatchesPerSecond, 1, math.MaxInt32, "patchesPerSecond")
+	}
+	return nil
+}
+
+func (c *ApiServerSourceCluster) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if c.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Label(c.Name); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.Name, "name", strings.Join(msgs, ", ")))
+	}
+	ref := c.KubeconfigSecretRef
+	var refErrs *apis.FieldError
+	if ref.Name == "" {
+		refErrs = refErrs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Subdomain(ref.Name); len(msgs) > 0 {
+		refErrs = refErrs.Also(apis.ErrInvalidValue(ref.Name, "name", strings.Join(msgs, ", ")))
+	}
+	if ref.Key == "" {
+		refErrs = refErrs.Also(apis.ErrMissingField("key"))
+	} else if msgs := validation.IsConfigMapKey(ref.Key); len(msgs) > 0 {
+		refErrs = refErrs.Also(apis.ErrInvalidValue(ref.Key, "key", strings.Join(msgs, ", ")))
+	}
+	return errs.Also(refErrs.ViaField("kubeconfigSecretRef"))
+}
+
+func (b *ApiServerSourceBatch) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if b.MaxSize < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(b.MaxSize, 1, math.MaxInt32, "maxSize"))
+	}
+	if window, err := time.ParseDuration(b.Window); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(b.Window, "window"))
+	} else if window <= 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(b.Window, "0s", "", "window"))
+	}
+	return errs
+}
+
+func (r *ApiServerSourceRateLimit) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if r.EventsPerSecond < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(r.EventsPerSecond, 1, math.MaxInt32, "eventsPerSecond"))
+	}
+	if r.Burst < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(r.Burst, 1, math.MaxInt32, "burst"))
+	}
+	switch r.OverflowPolicy {
+	case OverflowPolicyBlock, OverflowPolicyDrop, OverflowPolicyDeadLetter:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(r.OverflowPolicy, "overflowPolicy"))
+	}
+	return errs
+}
+
+func (t *ApiServerSourceTLS) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if t.CACerts == nil && t.ClientCertSecretName == "" {
+		errs = errs.Also(apis.ErrMissingOneOf("caCerts", "clientCertSecretName"))
+	}
+	if t.CACerts != nil {
+		errs = errs.Also(t.CACerts.Validate(ctx).ViaField("caCerts"))
+	}
+	if t.ClientCertSecretName != "" {
+		if msgs := validation.IsDNS1123Subdomain(t.ClientCertSecretName); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(t.ClientCertSecretName, "clientCertSecretName", strings.Join(msgs, ", ")))
+		}
+	}
+	return errs
+}
+
+func (b *ApiServerSourceCABundle) Validate(ctx context.Context) *apis.FieldError {
+	var name, key, field string
+	switch {
+	case b.ConfigMapKeyRef != nil && b.SecretKeyRef != nil:
+		return apis.ErrMultipleOneOf("configMapKeyRef", "secretKeyRef")
+	case b.ConfigMapKeyRef != nil:
+		name, key, field = b.ConfigMapKeyRef.Name, b.ConfigMapKeyRef.Key, "configMapKeyRef"
+	case b.SecretKeyRef != nil:
+		name, key, field = b.SecretKeyRef.Name, b.SecretKeyRef.Key, "secretKeyRef"
+	default:
+		return apis.ErrMissingOneOf("configMapKeyRef", "secretKeyRef")
+	}
+	var errs *apis.FieldError
+	if name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(name, "name", strings.Join(msgs, ", ")))
+	}
+	if key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	} else if msgs := validation.IsConfigMapKey(key); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(key, "key", strings.Join(msgs, ", ")))
+	}
+	return errs.ViaField(field)
+}
+
+// isExtensionName returns whether name is a valid CloudEvents extension name.
+func isExtensionName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if !((c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// extensionExpressionFields are the top level fields of the objects, and
+// extensionExpressionMetadataFields the fields of their metadata, which are
+// variables of the expressions of ExtensionExpressions, next to the object
+// variable of the object itself.
+var (
+	extensionExpressionFields         = []string{"apiVersion", "kind", "metadata", "spec", "status", "data"}
+	extensionExpressionMetadataFields = []string{"labels", "annotations"}
+)
+
+// extensionExpressionCostLimit bounds the cost of the evaluation of each
+// expression of ExtensionExpressions, as it runs for every event.
+const extensionExpressionCostLimit = 10000
+
+var (
+	extensionExpressionEnvOnce sync.Once
+	extensionExpressionEnv     *cel.Env
+	extensionExpressionEnvErr  error
+)
+
+// CompileExtensionExpression compiles a CEL expression of ExtensionExpressions,
+// e.g. `status.phase`, `labels['app']` or `size(spec.containers)`, into the
+// program evaluating it against the ExtensionExpressionVariables of an object.
+func CompileExtensionExpression(expr string) (cel.Program, error) {
+	extensionExpressionEnvOnce.Do(func() {
+		opts := []cel.EnvOption{cel.Variable("object", cel.DynType)}
+		for _, name := range append(extensionExpressionFields, extensionExpressionMetadataFields...) {
+			opts = append(opts, cel.Variable(name, cel.DynType))
+		}
+		extensionExpressionEnv, extensionExpressionEnvErr = cel.NewEnv(opts...)
+	})
+	if extensionExpressionEnvErr != nil {
+		return nil, extensionExpressionEnvErr
+	}
+	ast, issues := extensionExpressionEnv.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	return extensionExpressionEnv.Program(ast, cel.CostLimit(extensionExpressionCostLimit))
+}
+
+// ExtensionExpressionVariables returns the variables the programs of
+// CompileExtensionExpression are evaluated with against obj. The variables of
+// the top level fields obj does not have are left unset, so the expressions
+// selecting them fail to evaluate.
+func ExtensionExpressionVariables(obj map[string]interface{}) map[string]interface{} {
+	vars := map[string]interface{}{"object": obj}
+	for _, name := range extensionExpressionFields {
+		if v, ok := obj[name]; ok {
+			vars[name] = v
+		}
+	}
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, name := range extensionExpressionMetadataFields {
+			if v, ok := metadata[name]; ok {
+				vars[name] = v
+			}
+		}
+	}
+	return vars
+}
+
+// apiServerSourceEventTypePrefix is the prefix of the default types of the
+// events of the resources, followed by their mode and their verb.
+const apiServerSourceEventTypePrefix = "dev.knative.apiserver."
+
+// SendsEventType returns whether the events of the default type eventType of
+// the resource are sent given its Verbs. The events which are not of a verb
+// are always sent.
+func (s *APIVersionKindSelector) SendsEventType(eventType string) bool {
+	if len(s.Verbs) == 0 {
+		return true
+	}
+	rest := strings.TrimPrefix(eventType, apiServerSourceEventTypePrefix)
+	if rest == eventType {
+		return true
+	}
+	_, verb, _ := strings.Cut(rest, ".")
+	verb, _, _ = strings.Cut(verb, ".")
+	if !apiServerSourceVerbs.Has(verb) {
+		return true
+	}
+	return sets.NewString(s.Verbs...).Has(verb)
+}
+
+// ExpandEventTypeTemplate returns the type of an event for the
+// EventTypeTemplate tmpl, in place of its default type eventType, of an
+// object of the apiVersion and the kind.
+func ExpandEventTypeTemplate(tmpl, eventType, apiVersion, kind string) (string, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return "", err
+	}
+	group := gv.Group
+	if group == "" {
+		group = "core"
+	}
+	var mode, verb string
+	if rest := strings.TrimPrefix(eventType, apiServerSourceEventTypePrefix); rest != eventType {
+		mode, verb, _ = strings.Cut(rest, ".")
+	}
+	values := map[string]string{
+		"group":   group,
+		"version": gv.Version,
+		"kind":    strings.ToLower(kind),
+		"mode":    mode,
+		"verb":    verb,
+	}
+
+	var b strings.Builder
+	rest := tmpl
+	for {
+		start := strings.IndexAny(rest, "{}")
+		if start < 0 {
+			break
+		}
+		if rest[start] == '}' {
+			return "", errors.New("unexpected '}'")
+		}
+		end := strings.IndexAny(rest[start+1:], "{}")
+		if end < 0 || rest[start+1+end] != '}' {
+			return "", errors.New("unterminated placeholder")
+		}
+		name := rest[start+1 : start+1+end]
+		value, ok := values[name]
+		if !ok {
+			return "", fmt.Errorf("unknown placeholder {%s}", name)
+		}
+		b.WriteString(rest[:start])
+		b.WriteString(value)
+		rest = rest[start+1+end+1:]
+	}
+	b.WriteString(rest)
+	t := b.String()
+	if t == "" || strings.ContainsAny(t, " \t\n") {
+		return "", fmt.Errorf("invalid type %q", t)
+	}
+	return t, nil
+}
+
+// ParseFieldPath splits a path of FieldsToDrop into its fields.
+func ParseFieldPath(path string) ([]string, error) {
+	var fields []string
+	var field strings.Builder
+	escaped := false
+	for _, r := range path {
+		switch {
+		case escaped:
+			field.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '.':
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteRune(r)
+		}
+	}
+	if escaped {
+		return nil, errors.New("trailing escape character")
+	}
+	fields = append(fields, field.String())
+	for _, f := range fields {
+		if f == "" {
+			return nil, errors.New("empty field")
+		}
+	}
+	return fields, nil
+}
@@ -19,11 +19,18 @@ package v1
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 
 	"github.com/google/go-cmp/cmp"
 	"knative.dev/pkg/apis"
@@ -40,8 +47,640 @@ func TestAPIServerValidation(t *testing.T) {
 			EventMode: "Resource",
 			Resources: []APIVersionKindSelector{{
 				APIVersion: "v1",
-				Kind:       "Foo",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "valid diff mode",
+		spec: ApiServerSourceSpec{
+			EventMode: "Diff",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "empty sink",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("sink"))
+			return errs
+		}(),
+	}, {
+		name: "invalid mode",
+		spec: ApiServerSourceSpec{
+			EventMode: "Test",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrInvalidValue("Test", "mode"))
+			return errs
+		}(),
+	}, {
+		name: "invalid apiVersion",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1/v2/v3",
+				Kind:       "Foo",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: v1/v2/v3: resources[0].apiVersion"),
+	}, {
+		name: "invalid fieldSelector",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:    "v1",
+				Kind:          "Pod",
+				FieldSelector: "status.phase",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: status.phase: resources[0].fieldSelector"),
+	}, {
+		name: "scale subresource",
+		spec: ApiServerSourceSpec{
+			EventMode: "Reference",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:  "apps/v1",
+				Kind:        "Deployment",
+				Subresource: "scale",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid subresource",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:  "apps/v1",
+				Kind:        "Deployment",
+				Subresource: "status",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: status: resources[0].subresource"),
+	}, {
+		name: "verbs",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Verbs:      []string{"delete"},
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid verb",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Verbs:      []string{"add", "patch"},
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: patch: resources[0].verbs[1]"),
+	}, {
+		name: "event type template",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:        "apps/v1",
+				Kind:              "Deployment",
+				EventTypeTemplate: "com.mycorp.{kind}.{verb}",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid event type template",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion:        "apps/v1",
+				Kind:              "Deployment",
+				EventTypeTemplate: "com.mycorp.{name}",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: com.mycorp.{name}: resources[0].eventTypeTemplate\nunknown placeholder {name}"),
+	}, {
+		name: "KubernetesEvent format",
+		spec: ApiServerSourceSpec{
+			EventMode: "Reference",
+			Format:    "KubernetesEvent",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid format",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Format:    "Raw",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: Raw: format"),
+	}, {
+		name: "KubernetesEvent format in the Diff mode",
+		spec: ApiServerSourceSpec{
+			EventMode: "Diff",
+			Format:    "KubernetesEvent",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("the KubernetesEvent format can not be combined with the Diff mode: format, mode"),
+	}, {
+		name: "invalid fieldsToDrop",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			}},
+			FieldsToDrop: []string{"data.*", "metadata..name"},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: metadata..name: fieldsToDrop[1]\nempty field"),
+	}, {
+		name: "invalid dataContentType",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			DataContentType: "application/xml",
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: application/xml: dataContentType"),
+	}, {
+		name: "relative dataSchema",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			DataContentType: "application/avro",
+			DataSchema:      "schemas/pod.avsc",
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: errors.New("invalid value: schemas/pod.avsc: dataSchema"),
+	}, {
+		name: "invalid batch",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Batch: &ApiServerSourceBatch{
+				MaxSize: 0,
+				Window:  "0s",
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrOutOfBoundsValue(int32(0), 1, math.MaxInt32, "batch.maxSize"))
+			errs = errs.Also(apis.ErrOutOfBoundsValue("0s", "0s", "", "batch.window"))
+			return errs
+		}(),
+	}, {
+		name: "batch of protobuf events",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			DataContentType: "application/protobuf",
+			Batch: &ApiServerSourceBatch{
+				MaxSize: 10,
+				Window:  "1s",
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrGeneric("batching requires the application/json data content type", "batch", "dataContentType"),
+	}, {
+		name: "invalid checkpoint",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Checkpoint: &ApiServerSourceCheckpoint{
+				ConfigMapName: "Not_A_Name",
+				Interval:      "-1s",
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrInvalidValue("Not_A_Name", "checkpoint.configMapName",
+				"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"))
+			errs = errs.Also(apis.ErrOutOfBoundsValue("-1s", "0s", "", "checkpoint.interval"))
+			return errs
+		}(),
+	}, {
+		name: "invalid acknowledgement",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Acknowledgement: &ApiServerSourceAcknowledgement{PatchesPerSecond: -1},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(-1, 1, math.MaxInt32, "acknowledgement.patchesPerSecond"),
+	}, {
+		name: "invalid cluster",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Cluster: &ApiServerSourceCluster{
+				Name: "Remote",
+				KubeconfigSecretRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "remote-kubeconfig"},
+				},
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrInvalidValue("Remote", "cluster.name", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"))
+			errs = errs.Also(apis.ErrMissingField("cluster.kubeconfigSecretRef.key"))
+			return errs
+		}(),
+	}, {
+		name: "invalid rate limit",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			RateLimit: &ApiServerSourceRateLimit{
+				EventsPerSecond: 0,
+				Burst:           -1,
+				OverflowPolicy:  "Spill",
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrOutOfBoundsValue(int32(0), 1, math.MaxInt32, "rateLimit.eventsPerSecond"))
+			errs = errs.Also(apis.ErrOutOfBoundsValue(int32(-1), 1, math.MaxInt32, "rateLimit.burst"))
+			errs = errs.Also(apis.ErrInvalidValue("Spill", "rateLimit.overflowPolicy"))
+			return errs
+		}(),
+	}, {
+		name: "dead letter overflow policy without dead letter sink",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			RateLimit: &ApiServerSourceRateLimit{
+				EventsPerSecond: 10,
+				Burst:           10,
+				OverflowPolicy:  OverflowPolicyDeadLetter,
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrGeneric("the DeadLetter overflow policy requires a dead letter sink", "rateLimit.overflowPolicy", "delivery.deadLetterSink"),
+	}, {
+		name: "empty tls",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			TLS: &ApiServerSourceTLS{},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrMissingOneOf("tls.caCerts", "tls.clientCertSecretName"),
+	}, {
+		name: "tls with both config map and secret CA certificates",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			TLS: &ApiServerSourceTLS{
+				CACerts: &ApiServerSourceCABundle{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "bundle"},
+						Key:                  "ca.crt",
+					},
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "bundle"},
+						Key:                  "ca.crt",
+					},
+				},
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrMultipleOneOf("tls.caCerts.configMapKeyRef", "tls.caCerts.secretKeyRef"),
+	}, {
+		name: "invalid tls",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			TLS: &ApiServerSourceTLS{
+				CACerts: &ApiServerSourceCABundle{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{},
+				},
+				ClientCertSecretName: "Client_Cert",
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrMissingField("tls.caCerts.configMapKeyRef.name"))
+			errs = errs.Also(apis.ErrMissingField("tls.caCerts.configMapKeyRef.key"))
+			errs = errs.Also(apis.ErrInvalidValue("Client_Cert", "tls.clientCertSecretName",
+				"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"))
+			return errs
+		}(),
+	}, {
+		name: "valid tls",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
 			}},
+			TLS: &ApiServerSourceTLS{
+				CACerts: &ApiServerSourceCABundle{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "trust-bundle"},
+						Key:                  "trust-bundle.pem",
+					},
+				},
+				ClientCertSecretName: "client-cert",
+			},
 			SourceSpec: duckv1.SourceSpec{
 				Sink: duckv1.Destination{
 					Ref: &duckv1.KReference{
@@ -54,27 +693,111 @@ func TestAPIServerValidation(t *testing.T) {
 		},
 		want: nil,
 	}, {
-		name: "empty sink",
+		name: "valid rate limit",
 		spec: ApiServerSourceSpec{
 			EventMode: "Resource",
 			Resources: []APIVersionKindSelector{{
 				APIVersion: "v1",
-				Kind:       "Foo",
+				Kind:       "Pod",
+			}},
+			RateLimit: &ApiServerSourceRateLimit{
+				EventsPerSecond: 10,
+				Burst:           100,
+				OverflowPolicy:  OverflowPolicyDeadLetter,
+			},
+			Delivery: &eventingduckv1.DeliverySpec{
+				DeadLetterSink: &duckv1.Destination{
+					URI: apis.HTTP("dls.example.com"),
+				},
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid filters",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Filters: []eventingv1.SubscriptionsAPIFilter{{
+				Any: []eventingv1.SubscriptionsAPIFilter{{
+					Exact:  map[string]string{"type": "dev.knative.apiserver.resource.add"},
+					Prefix: map[string]string{"subject": "/apis/v1/namespaces/default"},
+				}},
+			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrGeneric("multiple dialects found, filters can have only one dialect set").
+			ViaIndex(0).ViaField("any").ViaFieldIndex("filters", 0),
+	}, {
+		name: "invalid sinks",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Sinks: []ApiServerSourceSink{{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "other",
+					},
+				},
+			}, {
+				Filters: []eventingv1.SubscriptionsAPIFilter{{
+					Exact:  map[string]string{"type": "dev.knative.apiserver.resource.add"},
+					Prefix: map[string]string{"subject": "/apis/v1/namespaces/default"},
+				}},
 			}},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
 		},
 		want: func() *apis.FieldError {
 			var errs *apis.FieldError
-			errs = errs.Also(apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("sink"))
+			errs = errs.Also(apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("sink").ViaFieldIndex("sinks", 1))
+			errs = errs.Also(apis.ErrGeneric("multiple dialects found, filters can have only one dialect set").
+				ViaIndex(0).ViaField("filters").ViaFieldIndex("sinks", 1))
 			return errs
 		}(),
 	}, {
-		name: "invalid mode",
+		name: "invalid owner references and resync period",
 		spec: ApiServerSourceSpec{
-			EventMode: "Test",
+			EventMode: "Resource",
 			Resources: []APIVersionKindSelector{{
 				APIVersion: "v1",
-				Kind:       "Foo",
+				Kind:       "Pod",
 			}},
+			OwnerRefMode:                "Owner",
+			OwnerRefMaxDepth:            ptr.Int32(0),
+			OwnerRefDeduplicationWindow: "-5s",
+			ResyncPeriod:                "hourly",
 			SourceSpec: duckv1.SourceSpec{
 				Sink: duckv1.Destination{
 					Ref: &duckv1.KReference{
@@ -87,17 +810,21 @@ func TestAPIServerValidation(t *testing.T) {
 		},
 		want: func() *apis.FieldError {
 			var errs *apis.FieldError
-			errs = errs.Also(apis.ErrInvalidValue("Test", "mode"))
+			errs = errs.Also(apis.ErrInvalidValue("Owner", "ownerRefMode"))
+			errs = errs.Also(apis.ErrOutOfBoundsValue(0, 1, maxOwnerRefMaxDepth, "ownerRefMaxDepth"))
+			errs = errs.Also(apis.ErrOutOfBoundsValue("-5s", "0s", "", "ownerRefDeduplicationWindow"))
+			errs = errs.Also(apis.ErrInvalidValue("hourly", "resyncPeriod"))
 			return errs
 		}(),
 	}, {
-		name: "invalid apiVersion",
+		name: "valid concurrency",
 		spec: ApiServerSourceSpec{
 			EventMode: "Resource",
 			Resources: []APIVersionKindSelector{{
-				APIVersion: "v1/v2/v3",
-				Kind:       "Foo",
+				APIVersion: "v1",
+				Kind:       "Pod",
 			}},
+			Concurrency: ptr.Int32(8),
 			SourceSpec: duckv1.SourceSpec{
 				Sink: duckv1.Destination{
 					Ref: &duckv1.KReference{
@@ -108,7 +835,116 @@ func TestAPIServerValidation(t *testing.T) {
 				},
 			},
 		},
-		want: errors.New("invalid value: v1/v2/v3: resources[0].apiVersion"),
+	}, {
+		name: "invalid concurrency",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Concurrency: ptr.Int32(0),
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, maxConcurrency, "concurrency"),
+	}, {
+		name: "invalid extension expressions",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			ExtensionExpressions: map[string]string{
+				"phase": "status.phase",
+				"app":   "labels[app]",
+				"App":   "labels['app']",
+				"type":  "spec.type",
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrInvalidValue("labels[app]", apis.CurrentField, "ERROR: <input>:1:8: undeclared reference to 'app' (in container '')\n | labels[app]\n | .......^").ViaKey("app").ViaField("extensionExpressions"))
+			errs = errs.Also(apis.ErrInvalidKeyName("App", "extensionExpressions", "keys MUST be lowercase alphanumeric"))
+			errs = errs.Also(apis.ErrInvalidKeyName("type", "extensionExpressions", "keys MUST NOT be CloudEvents attributes"))
+			return errs
+		}(),
+	}, {
+		name: "invalid namespaces",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Namespaces: []string{"apps", "Apps"},
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "team",
+					Operator: "Near",
+				}},
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrInvalidArrayValue("Apps", "namespaces", 1))
+			errs = errs.Also(apis.ErrGeneric(`"Near" is not a valid pod selector operator`, "namespaceSelector"))
+			return errs
+		}(),
+	}, {
+		name: "invalid delivery",
+		spec: ApiServerSourceSpec{
+			EventMode: "Resource",
+			Resources: []APIVersionKindSelector{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}},
+			Delivery: &eventingduckv1.DeliverySpec{
+				Retry:        ptr.Int32(-1),
+				BackoffDelay: ptr.String("50ms"),
+			},
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					Ref: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "broker",
+						Name:       "default",
+					},
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			var errs *apis.FieldError
+			errs = errs.Also(apis.ErrInvalidValue("-1", "delivery.retry"))
+			errs = errs.Also(apis.ErrInvalidValue("50ms", "delivery.backoffDelay"))
+			return errs
+		}(),
 	}, {
 		name: "missing kind",
 		spec: ApiServerSourceSpec{
@@ -246,6 +1082,279 @@ func TestAPIServerValidation(t *testing.T) {
 	}
 }
 
+func TestParseFieldPath(t *testing.T) {
+	tests := map[string]struct {
+		path    string
+		want    []string
+		wantErr bool
+	}{
+		"single field": {
+			path: "status",
+			want: []string{"status"},
+		},
+		"nested fields": {
+			path: "metadata.managedFields",
+			want: []string{"metadata", "managedFields"},
+		},
+		"wildcard": {
+			path: "data.*",
+			want: []string{"data", "*"},
+		},
+		"escaped dots": {
+			path: `metadata.annotations.kubectl\.kubernetes\.io/last-applied-configuration`,
+			want: []string{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+		},
+		"empty": {
+			path:    "",
+			wantErr: true,
+		},
+		"empty field": {
+			path:    "metadata.",
+			wantErr: true,
+		},
+		"trailing escape": {
+			path:    `metadata\`,
+			wantErr: true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, err := ParseFieldPath(tc.path)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseFieldPath(%q) error = %v, wantErr %t", tc.path, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseFieldPath(%q) (-want, +got) = %v", tc.path, diff)
+			}
+		})
+	}
+}
+
+func TestCompileExtensionExpression(t *testing.T) {
+	pod := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":        "unit",
+			"labels":      map[string]interface{}{"app": "web"},
+			"annotations": map[string]interface{}{"kubernetes.io/description": "test"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "unit"}},
+		},
+		"status": map[string]interface{}{
+			"phase":      "Running",
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready"}},
+		},
+	}
+	tests := map[string]struct {
+		expr        string
+		want        interface{}
+		wantErr     bool
+		wantEvalErr bool
+	}{
+		"nested fields": {
+			expr: "status.phase",
+			want: "Running",
+		},
+		"labels": {
+			expr: "labels['app']",
+			want: "web",
+		},
+		"annotations": {
+			expr: `annotations["kubernetes.io/description"]`,
+			want: "test",
+		},
+		"list index": {
+			expr: "status.conditions[0].type",
+			want: "Ready",
+		},
+		"object": {
+			expr: "object.kind",
+			want: "Pod",
+		},
+		"operator": {
+			expr: "status.phase == 'Running'",
+			want: true,
+		},
+		"function": {
+			expr: "size(spec.containers)",
+			want: int64(1),
+		},
+		"missing field": {
+			expr:        "status.conditions[1].type",
+			wantEvalErr: true,
+		},
+		"missing variable": {
+			expr:        "data.key",
+			wantEvalErr: true,
+		},
+		"empty": {
+			expr:    "",
+			wantErr: true,
+		},
+		"empty field": {
+			expr:    "status.",
+			wantErr: true,
+		},
+		"undeclared variable": {
+			expr:    "labels[app]",
+			wantErr: true,
+		},
+		"unterminated index": {
+			expr:    "labels['app'",
+			wantErr: true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			program, err := CompileExtensionExpression(tc.expr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("CompileExtensionExpression(%q) error = %v, wantErr %t", tc.expr, err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			out, _, err := program.Eval(ExtensionExpressionVariables(pod))
+			if (err != nil) != tc.wantEvalErr {
+				t.Fatalf("Eval(%q) error = %v, wantEvalErr %t", tc.expr, err, tc.wantEvalErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, out.Value()); diff != "" {
+				t.Errorf("Eval(%q) (-want, +got) = %v", tc.expr, diff)
+			}
+		})
+	}
+}
+
+func TestExpandEventTypeTemplate(t *testing.T) {
+	tests := map[string]struct {
+		tmpl       string
+		eventType  string
+		apiVersion string
+		kind       string
+		want       string
+		wantErr    bool
+	}{
+		"kind and verb": {
+			tmpl:       "com.mycorp.{kind}.{verb}",
+			eventType:  "dev.knative.apiserver.resource.update.status",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			want:       "com.mycorp.deployment.update.status",
+		},
+		"all placeholders": {
+			tmpl:       "{group}.{version}.{kind}.{mode}.{verb}",
+			eventType:  "dev.knative.apiserver.ref.add",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			want:       "apps.v1.deployment.ref.add",
+		},
+		"core group": {
+			tmpl:       "com.mycorp.{group}.{kind}",
+			eventType:  "dev.knative.apiserver.resource.delete",
+			apiVersion: "v1",
+			kind:       "Pod",
+			want:       "com.mycorp.core.pod",
+		},
+		"no placeholder": {
+			tmpl:       "com.mycorp.change",
+			eventType:  "dev.knative.apiserver.resource.add",
+			apiVersion: "v1",
+			kind:       "Pod",
+			want:       "com.mycorp.change",
+		},
+		"unknown placeholder": {
+			tmpl:       "com.mycorp.{name}",
+			eventType:  "dev.knative.apiserver.resource.add",
+			apiVersion: "v1",
+			kind:       "Pod",
+			wantErr:    true,
+		},
+		"unterminated placeholder": {
+			tmpl:       "com.mycorp.{kind",
+			eventType:  "dev.knative.apiserver.resource.add",
+			apiVersion: "v1",
+			kind:       "Pod",
+			wantErr:    true,
+		},
+		"nested placeholder": {
+			tmpl:       "com.mycorp.{kind{verb}}",
+			eventType:  "dev.knative.apiserver.resource.add",
+			apiVersion: "v1",
+			kind:       "Pod",
+			wantErr:    true,
+		},
+		"unexpected brace": {
+			tmpl:       "com.mycorp.kind}",
+			eventType:  "dev.knative.apiserver.resource.add",
+			apiVersion: "v1",
+			kind:       "Pod",
+			wantErr:    true,
+		},
+		"whitespace": {
+			tmpl:       "com.mycorp {kind}",
+			eventType:  "dev.knative.apiserver.resource.add",
+			apiVersion: "v1",
+			kind:       "Pod",
+			wantErr:    true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, err := ExpandEventTypeTemplate(tc.tmpl, tc.eventType, tc.apiVersion, tc.kind)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ExpandEventTypeTemplate(%q) error = %v, wantErr %t", tc.tmpl, err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("ExpandEventTypeTemplate(%q) = %q, want %q", tc.tmpl, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSendsEventType(t *testing.T) {
+	tests := map[string]struct {
+		verbs     []string
+		eventType string
+		want      bool
+	}{
+		"no verbs": {
+			eventType: "dev.knative.apiserver.resource.update",
+			want:      true,
+		},
+		"selected verb": {
+			verbs:     []string{"delete"},
+			eventType: "dev.knative.apiserver.ref.delete",
+			want:      true,
+		},
+		"other verb": {
+			verbs:     []string{"delete"},
+			eventType: "dev.knative.apiserver.resource.update",
+		},
+		"classified update": {
+			verbs:     []string{"update"},
+			eventType: "dev.knative.apiserver.resource.update.status",
+			want:      true,
+		},
+		"no verb": {
+			verbs:     []string{"delete"},
+			eventType: "dev.knative.apiserver.resource.sync",
+			want:      true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			s := &APIVersionKindSelector{APIVersion: "v1", Kind: "Pod", Verbs: tc.verbs}
+			if got := s.SendsEventType(tc.eventType); got != tc.want {
+				t.Errorf("SendsEventType(%q) = %t, want %t", tc.eventType, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestAPIServerValidationCallsSpecValidation(t *testing.T) {
 	source := ApiServerSource{
 		Spec: ApiServerSourceSpec{
@@ -266,3 +1375,48 @@ func TestAPIServerValidationCallsSpecValidation(t *testing.T) {
 	err := source.Validate(context.TODO())
 	assert.EqualError(t, err, "missing field(s): spec.resources", "Spec is not validated!")
 }
+
+func TestAPIServerValidationPauseAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations map[string]string
+		want        string
+	}{
+		"no annotations": {},
+		"paused": {
+			annotations: map[string]string{PausedAnnotationKey: "true", ReplayOnResumeAnnotationKey: "true"},
+		},
+		"resumed": {
+			annotations: map[string]string{PausedAnnotationKey: "false"},
+		},
+		"invalid paused": {
+			annotations: map[string]string{PausedAnnotationKey: "yes"},
+			want:        `invalid value: yes: metadata.annotations.[sources.knative.dev/paused]` + "\n" + `must be "true" or "false"`,
+		},
+		"invalid replay on resume": {
+			annotations: map[string]string{ReplayOnResumeAnnotationKey: ""},
+			want:        `invalid value: : metadata.annotations.[sources.knative.dev/replay-on-resume]` + "\n" + `must be "true" or "false"`,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			source := ApiServerSource{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec: ApiServerSourceSpec{
+					EventMode: "Resource",
+					Resources: []APIVersionKindSelector{{APIVersion: "v1", Kind: "Pod"}},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{APIVersion: "v1", Kind: "broker", Name: "default"},
+						},
+					},
+				},
+			}
+			err := source.Validate(context.TODO())
+			if tc.want == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.want)
+		})
+	}
+}
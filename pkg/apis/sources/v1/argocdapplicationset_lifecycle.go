@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// ArgoCDApplicationSetConditionReady has status True when the ArgoCDApplicationSetSource is ready to send events.
+	ArgoCDApplicationSetConditionReady = apis.ConditionReady
+
+	// ArgoCDApplicationSetConditionSinkProvided has status True when the ArgoCDApplicationSetSource has been configured with a sink target.
+	ArgoCDApplicationSetConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// ArgoCDApplicationSetConditionDeployed has status True when the ArgoCDApplicationSetSource has had it's receive adapter deployment created.
+	ArgoCDApplicationSetConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var argoCDApplicationSetCondSet = apis.NewLivingConditionSet(
+	ArgoCDApplicationSetConditionSinkProvided,
+	ArgoCDApplicationSetConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*ArgoCDApplicationSetSource) GetConditionSet() apis.ConditionSet {
+	return argoCDApplicationSetCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*ArgoCDApplicationSetSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ArgoCDApplicationSetSource")
+}
+
+// ArgoCDApplicationSetSourceSource returns the ArgoCDApplicationSetSource CloudEvent source.
+func ArgoCDApplicationSetSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/argocdapplicationsetsources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the ArgoCDApplicationSetSource.
+func (s *ArgoCDApplicationSetSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *ArgoCDApplicationSetSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return argoCDApplicationSetCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *ArgoCDApplicationSetSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return argoCDApplicationSetCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *ArgoCDApplicationSetSourceStatus) InitializeConditions() {
+	argoCDApplicationSetCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *ArgoCDApplicationSetSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		argoCDApplicationSetCondSet.Manage(s).MarkTrue(ArgoCDApplicationSetConditionSinkProvided)
+	} else {
+		argoCDApplicationSetCondSet.Manage(s).MarkFalse(ArgoCDApplicationSetConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *ArgoCDApplicationSetSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	argoCDApplicationSetCondSet.Manage(s).MarkFalse(ArgoCDApplicationSetConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// ArgoCDApplicationSetConditionDeployed should be marked as true or false.
+func (s *ArgoCDApplicationSetSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				argoCDApplicationSetCondSet.Manage(s).MarkTrue(ArgoCDApplicationSetConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				argoCDApplicationSetCondSet.Manage(s).MarkFalse(ArgoCDApplicationSetConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				argoCDApplicationSetCondSet.Manage(s).MarkUnknown(ArgoCDApplicationSetConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		argoCDApplicationSetCondSet.Manage(s).MarkUnknown(ArgoCDApplicationSetConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *ArgoCDApplicationSetSourceStatus) IsReady() bool {
+	return argoCDApplicationSetCondSet.Manage(s).IsHappy()
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func (s *ArgoCDApplicationSetSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ss *ArgoCDApplicationSetSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	// Validate sink
+	errs = errs.Also(ss.Sink.Validate(ctx).ViaField("sink"))
+
+	if ss.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(ss.Selector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), "selector"))
+		}
+	}
+
+	errs = errs.Also(ss.SourceSpec.Validate(ctx))
+	return errs
+}
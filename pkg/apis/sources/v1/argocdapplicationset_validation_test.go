@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestArgoCDApplicationSetSourceValidation(t *testing.T) {
+	sink := duckv1.SourceSpec{
+		Sink: duckv1.Destination{
+			Ref: &duckv1.KReference{
+				APIVersion: "v1",
+				Kind:       "broker",
+				Name:       "default",
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		spec ArgoCDApplicationSetSourceSpec
+		want *apis.FieldError
+	}{{
+		name: "valid spec",
+		spec: ArgoCDApplicationSetSourceSpec{
+			SourceSpec: sink,
+		},
+	}, {
+		name: "valid selector",
+		spec: ArgoCDApplicationSetSourceSpec{
+			SourceSpec: sink,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app.kubernetes.io/part-of": "payments"},
+			},
+		},
+	}, {
+		name: "empty sink",
+		spec: ArgoCDApplicationSetSourceSpec{},
+		want: apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("sink"),
+	}, {
+		name: "invalid selector",
+		spec: ArgoCDApplicationSetSourceSpec{
+			SourceSpec: sink,
+			Selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "zone",
+					Operator: "Near",
+				}},
+			},
+		},
+		want: apis.ErrInvalidValue(`"Near" is not a valid pod selector operator`, "selector"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.TODO())
+			if test.want != nil {
+				if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+					t.Errorf("ArgoCDApplicationSetSourceSpec.Validate (-want, +got) = %v", diff)
+				}
+			} else if got != nil {
+				t.Errorf("ArgoCDApplicationSetSourceSpec.Validate wanted nil, got = %v", got.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// BrokerStatusConditionReady has status True when the BrokerStatusSource is ready to send events.
+	BrokerStatusConditionReady = apis.ConditionReady
+
+	// BrokerStatusConditionSinkProvided has status True when the BrokerStatusSource has been configured with a sink target.
+	BrokerStatusConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// BrokerStatusConditionDeployed has status True when the BrokerStatusSource has had it's receive adapter deployment created.
+	BrokerStatusConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var brokerStatusCondSet = apis.NewLivingConditionSet(
+	BrokerStatusConditionSinkProvided,
+	BrokerStatusConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*BrokerStatusSource) GetConditionSet() apis.ConditionSet {
+	return brokerStatusCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*BrokerStatusSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("BrokerStatusSource")
+}
+
+// BrokerStatusSourceSource returns the BrokerStatusSource CloudEvent source.
+func BrokerStatusSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/brokerstatussources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the BrokerStatusSource.
+func (s *BrokerStatusSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *BrokerStatusSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return brokerStatusCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *BrokerStatusSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return brokerStatusCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *BrokerStatusSourceStatus) InitializeConditions() {
+	brokerStatusCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *BrokerStatusSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		brokerStatusCondSet.Manage(s).MarkTrue(BrokerStatusConditionSinkProvided)
+	} else {
+		brokerStatusCondSet.Manage(s).MarkFalse(BrokerStatusConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *BrokerStatusSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	brokerStatusCondSet.Manage(s).MarkFalse(BrokerStatusConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// BrokerStatusConditionDeployed should be marked as true or false.
+func (s *BrokerStatusSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				brokerStatusCondSet.Manage(s).MarkTrue(BrokerStatusConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				brokerStatusCondSet.Manage(s).MarkFalse(BrokerStatusConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				brokerStatusCondSet.Manage(s).MarkUnknown(BrokerStatusConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		brokerStatusCondSet.Manage(s).MarkUnknown(BrokerStatusConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *BrokerStatusSourceStatus) IsReady() bool {
+	return brokerStatusCondSet.Manage(s).IsHappy()
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// ChannelStatusConditionReady has status True when the ChannelStatusSource is ready to send events.
+	ChannelStatusConditionReady = apis.ConditionReady
+
+	// ChannelStatusConditionSinkProvided has status True when the ChannelStatusSource has been configured with a sink target.
+	ChannelStatusConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// ChannelStatusConditionDeployed has status True when the ChannelStatusSource has had it's receive adapter deployment created.
+	ChannelStatusConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var channelStatusCondSet = apis.NewLivingConditionSet(
+	ChannelStatusConditionSinkProvided,
+	ChannelStatusConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*ChannelStatusSource) GetConditionSet() apis.ConditionSet {
+	return channelStatusCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*ChannelStatusSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ChannelStatusSource")
+}
+
+// ChannelStatusSourceSource returns the ChannelStatusSource CloudEvent source.
+func ChannelStatusSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/channelstatussources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the ChannelStatusSource.
+func (s *ChannelStatusSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *ChannelStatusSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return channelStatusCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *ChannelStatusSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return channelStatusCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *ChannelStatusSourceStatus) InitializeConditions() {
+	channelStatusCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *ChannelStatusSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		channelStatusCondSet.Manage(s).MarkTrue(ChannelStatusConditionSinkProvided)
+	} else {
+		channelStatusCondSet.Manage(s).MarkFalse(ChannelStatusConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *ChannelStatusSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	channelStatusCondSet.Manage(s).MarkFalse(ChannelStatusConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// ChannelStatusConditionDeployed should be marked as true or false.
+func (s *ChannelStatusSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				channelStatusCondSet.Manage(s).MarkTrue(ChannelStatusConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				channelStatusCondSet.Manage(s).MarkFalse(ChannelStatusConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				channelStatusCondSet.Manage(s).MarkUnknown(ChannelStatusConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		channelStatusCondSet.Manage(s).MarkUnknown(ChannelStatusConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *ChannelStatusSourceStatus) IsReady() bool {
+	return channelStatusCondSet.Manage(s).IsHappy()
+}
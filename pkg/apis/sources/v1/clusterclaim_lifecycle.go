@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// ClusterClaimConditionReady has status True when the ClusterClaimSource is ready to send events.
+	ClusterClaimConditionReady = apis.ConditionReady
+
+	// ClusterClaimConditionSinkProvided has status True when the ClusterClaimSource has been configured with a sink target.
+	ClusterClaimConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// ClusterClaimConditionDeployed has status True when the ClusterClaimSource has had it's receive adapter deployment created.
+	ClusterClaimConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var clusterClaimCondSet = apis.NewLivingConditionSet(
+	ClusterClaimConditionSinkProvided,
+	ClusterClaimConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*ClusterClaimSource) GetConditionSet() apis.ConditionSet {
+	return clusterClaimCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*ClusterClaimSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ClusterClaimSource")
+}
+
+// ClusterClaimSourceSource returns the ClusterClaimSource CloudEvent source.
+func ClusterClaimSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/clusterclaimsources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the ClusterClaimSource.
+func (s *ClusterClaimSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *ClusterClaimSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return clusterClaimCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *ClusterClaimSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return clusterClaimCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *ClusterClaimSourceStatus) InitializeConditions() {
+	clusterClaimCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *ClusterClaimSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		clusterClaimCondSet.Manage(s).MarkTrue(ClusterClaimConditionSinkProvided)
+	} else {
+		clusterClaimCondSet.Manage(s).MarkFalse(ClusterClaimConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *ClusterClaimSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	clusterClaimCondSet.Manage(s).MarkFalse(ClusterClaimConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// ClusterClaimConditionDeployed should be marked as true or false.
+func (s *ClusterClaimSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				clusterClaimCondSet.Manage(s).MarkTrue(ClusterClaimConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				clusterClaimCondSet.Manage(s).MarkFalse(ClusterClaimConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				clusterClaimCondSet.Manage(s).MarkUnknown(ClusterClaimConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		clusterClaimCondSet.Manage(s).MarkUnknown(ClusterClaimConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *ClusterClaimSourceStatus) IsReady() bool {
+	return clusterClaimCondSet.Manage(s).IsHappy()
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestClusterClaimSourceGetConditionSet(t *testing.T) {
+	r := &ClusterClaimSource{}
+
+	if got, want := r.GetConditionSet().GetTopLevelConditionType(), apis.ConditionReady; got != want {
+		t.Errorf("GetTopLevelCondition=%v, want=%v", got, want)
+	}
+}
+
+func TestClusterClaimSourceGetGroupVersionKind(t *testing.T) {
+	r := &ClusterClaimSource{}
+	want := "ClusterClaimSource"
+	if got := r.GetGroupVersionKind().Kind; got != want {
+		t.Errorf("GetGroupVersionKind().Kind=%v, want=%v", got, want)
+	}
+}
+
+func TestClusterClaimSourceStatusIsReady(t *testing.T) {
+	sink := apis.HTTP("example")
+
+	tests := []struct {
+		name                string
+		s                   *ClusterClaimSourceStatus
+		wantConditionStatus corev1.ConditionStatus
+		want                bool
+	}{{
+		name: "uninitialized",
+		s:    &ClusterClaimSourceStatus{},
+		want: false,
+	}, {
+		name: "initialized",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionUnknown,
+		want:                false,
+	}, {
+		name: "mark deployed",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionUnknown,
+		want:                false,
+	}, {
+		name: "mark sink",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionUnknown,
+		want:                false,
+	}, {
+		name: "mark sink and deployed",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionTrue,
+		want:                true,
+	}, {
+		name: "mark sink and unavailable deployment",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(sink)
+			s.PropagateDeploymentAvailability(unavailableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		want:                false,
+	}, {
+		name: "mark empty sink and deployed",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(nil)
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		want:                false,
+	}, {
+		name: "mark no sink and deployed",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.MarkNoSink("Testing", "")
+			s.PropagateDeploymentAvailability(availableDeployment)
+			return s
+		}(),
+		wantConditionStatus: corev1.ConditionFalse,
+		want:                false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantConditionStatus != "" {
+				gotConditionStatus := test.s.GetTopLevelCondition().Status
+				if gotConditionStatus != test.wantConditionStatus {
+					t.Errorf("unexpected condition status: want %v, got %v", test.wantConditionStatus, gotConditionStatus)
+				}
+			}
+			got := test.s.IsReady()
+			if got != test.want {
+				t.Errorf("unexpected readiness: want %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestClusterClaimSourceStatusGetCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         *ClusterClaimSourceStatus
+		condQuery apis.ConditionType
+		want      *apis.Condition
+	}{{
+		name:      "uninitialized",
+		s:         &ClusterClaimSourceStatus{},
+		condQuery: ClusterClaimConditionReady,
+		want:      nil,
+	}, {
+		name: "initialized",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			return s
+		}(),
+		condQuery: ClusterClaimConditionReady,
+		want: &apis.Condition{
+			Type:   ClusterClaimConditionReady,
+			Status: corev1.ConditionUnknown,
+		},
+	}, {
+		name: "mark sink",
+		s: func() *ClusterClaimSourceStatus {
+			s := &ClusterClaimSourceStatus{}
+			s.InitializeConditions()
+			s.MarkSink(apis.HTTP("example"))
+			return s
+		}(),
+		condQuery: ClusterClaimConditionSinkProvided,
+		want: &apis.Condition{
+			Type:   ClusterClaimConditionSinkProvided,
+			Status: corev1.ConditionTrue,
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.s.GetCondition(test.condQuery)
+			ignoreTime := cmpopts.IgnoreFields(apis.Condition{},
+				"LastTransitionTime", "Severity")
+			if diff := cmp.Diff(test.want, got, ignoreTime); diff != "" {
+				t.Error("unexpected condition (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -41,6 +41,30 @@ func TestTypesImplements(t *testing.T) {
 		// ContainerSource
 		{instance: &ContainerSource{}, iface: &duckv1.Conditions{}},
 		{instance: &ContainerSource{}, iface: &duckv1.Source{}},
+		// ChannelStatusSource
+		{instance: &ChannelStatusSource{}, iface: &duckv1.Conditions{}},
+		{instance: &ChannelStatusSource{}, iface: &duckv1.Source{}},
+		// PermissionAuditSource
+		{instance: &PermissionAuditSource{}, iface: &duckv1.Conditions{}},
+		{instance: &PermissionAuditSource{}, iface: &duckv1.Source{}},
+		// ClusterClaimSource
+		{instance: &ClusterClaimSource{}, iface: &duckv1.Conditions{}},
+		{instance: &ClusterClaimSource{}, iface: &duckv1.Source{}},
+		// SecretRotationSource
+		{instance: &SecretRotationSource{}, iface: &duckv1.Conditions{}},
+		{instance: &SecretRotationSource{}, iface: &duckv1.Source{}},
+		// BrokerStatusSource
+		{instance: &BrokerStatusSource{}, iface: &duckv1.Conditions{}},
+		{instance: &BrokerStatusSource{}, iface: &duckv1.Source{}},
+		// WhereaboutsSource
+		{instance: &WhereaboutsSource{}, iface: &duckv1.Conditions{}},
+		{instance: &WhereaboutsSource{}, iface: &duckv1.Source{}},
+		// ArgoCDApplicationSetSource
+		{instance: &ArgoCDApplicationSetSource{}, iface: &duckv1.Conditions{}},
+		{instance: &ArgoCDApplicationSetSource{}, iface: &duckv1.Source{}},
+		// NodePressureSource
+		{instance: &NodePressureSource{}, iface: &duckv1.Conditions{}},
+		{instance: &NodePressureSource{}, iface: &duckv1.Source{}},
 	}
 	for _, tc := range testCases {
 		if err := duck.VerifyType(tc.instance, tc.iface); err != nil {
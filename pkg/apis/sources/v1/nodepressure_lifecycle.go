@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// NodePressureConditionReady has status True when the NodePressureSource is ready to send events.
+	NodePressureConditionReady = apis.ConditionReady
+
+	// NodePressureConditionSinkProvided has status True when the NodePressureSource has been configured with a sink target.
+	NodePressureConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// NodePressureConditionDeployed has status True when the NodePressureSource has had it's receive adapter deployment created.
+	NodePressureConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var nodePressureCondSet = apis.NewLivingConditionSet(
+	NodePressureConditionSinkProvided,
+	NodePressureConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*NodePressureSource) GetConditionSet() apis.ConditionSet {
+	return nodePressureCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*NodePressureSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("NodePressureSource")
+}
+
+// NodePressureSourceSource returns the NodePressureSource CloudEvent source.
+func NodePressureSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/nodepressuresources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the NodePressureSource.
+func (s *NodePressureSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *NodePressureSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return nodePressureCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *NodePressureSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return nodePressureCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *NodePressureSourceStatus) InitializeConditions() {
+	nodePressureCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *NodePressureSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		nodePressureCondSet.Manage(s).MarkTrue(NodePressureConditionSinkProvided)
+	} else {
+		nodePressureCondSet.Manage(s).MarkFalse(NodePressureConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *NodePressureSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	nodePressureCondSet.Manage(s).MarkFalse(NodePressureConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// NodePressureConditionDeployed should be marked as true or false.
+func (s *NodePressureSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				nodePressureCondSet.Manage(s).MarkTrue(NodePressureConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				nodePressureCondSet.Manage(s).MarkFalse(NodePressureConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				nodePressureCondSet.Manage(s).MarkUnknown(NodePressureConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		nodePressureCondSet.Manage(s).MarkUnknown(NodePressureConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *NodePressureSourceStatus) IsReady() bool {
+	return nodePressureCondSet.Manage(s).IsHappy()
+}
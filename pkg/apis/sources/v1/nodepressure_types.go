@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+
+// NodePressureSource is the Schema for the nodepressuresources API
+type NodePressureSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodePressureSourceSpec   `json:"spec,omitempty"`
+	Status NodePressureSourceStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces that NodePressureSource should be implementing.
+var (
+	_ runtime.Object     = (*NodePressureSource)(nil)
+	_ kmeta.OwnerRefable = (*NodePressureSource)(nil)
+	_ apis.Validatable   = (*NodePressureSource)(nil)
+	_ apis.Defaultable   = (*NodePressureSource)(nil)
+	_ apis.HasSpec       = (*NodePressureSource)(nil)
+	_ duckv1.KRShaped    = (*NodePressureSource)(nil)
+)
+
+// NodePressureSourceSpec defines the desired state of NodePressureSource
+type NodePressureSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// NodeSelector filters this source to the Nodes matching the label
+	// selector. All Nodes are watched when it is not set.
+	// More info: http://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// ServiceAccountName is the name of the ServiceAccount to use to run this
+	// source. Defaults to default if not set.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// NodePressureSourceStatus defines the observed state of NodePressureSource
+type NodePressureSourceStatus struct {
+	// inherits duck/v1 SourceStatus, which currently provides:
+	// * ObservedGeneration - the 'Generation' of the Service that was last
+	//   processed by the controller.
+	// * Conditions - the latest available observations of a resource's current
+	//   state.
+	// * SinkURI - the current active sink URI that has been configured for the
+	//   Source.
+	duckv1.SourceStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodePressureSourceList contains a list of NodePressureSource
+type NodePressureSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodePressureSource `json:"items"`
+}
+
+// GetStatus retrieves the status of the NodePressureSource. Implements the KRShaped interface.
+func (s *NodePressureSource) GetStatus() *duckv1.Status {
+	return &s.Status.Status
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// PermissionAuditConditionReady has status True when the PermissionAuditSource is ready to send events.
+	PermissionAuditConditionReady = apis.ConditionReady
+
+	// PermissionAuditConditionSinkProvided has status True when the PermissionAuditSource has been configured with a sink target.
+	PermissionAuditConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// PermissionAuditConditionDeployed has status True when the PermissionAuditSource has had it's receive adapter deployment created.
+	PermissionAuditConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var permissionAuditCondSet = apis.NewLivingConditionSet(
+	PermissionAuditConditionSinkProvided,
+	PermissionAuditConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*PermissionAuditSource) GetConditionSet() apis.ConditionSet {
+	return permissionAuditCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*PermissionAuditSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("PermissionAuditSource")
+}
+
+// PermissionAuditSourceSource returns the PermissionAuditSource CloudEvent source.
+func PermissionAuditSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/permissionauditsources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the PermissionAuditSource.
+func (s *PermissionAuditSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *PermissionAuditSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return permissionAuditCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *PermissionAuditSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return permissionAuditCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *PermissionAuditSourceStatus) InitializeConditions() {
+	permissionAuditCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *PermissionAuditSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		permissionAuditCondSet.Manage(s).MarkTrue(PermissionAuditConditionSinkProvided)
+	} else {
+		permissionAuditCondSet.Manage(s).MarkFalse(PermissionAuditConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *PermissionAuditSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	permissionAuditCondSet.Manage(s).MarkFalse(PermissionAuditConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// PermissionAuditConditionDeployed should be marked as true or false.
+func (s *PermissionAuditSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				permissionAuditCondSet.Manage(s).MarkTrue(PermissionAuditConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				permissionAuditCondSet.Manage(s).MarkFalse(PermissionAuditConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				permissionAuditCondSet.Manage(s).MarkUnknown(PermissionAuditConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		permissionAuditCondSet.Manage(s).MarkUnknown(PermissionAuditConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *PermissionAuditSourceStatus) IsReady() bool {
+	return permissionAuditCondSet.Manage(s).IsHappy()
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+
+// PermissionAuditSource is the Schema for the permissionauditsources API
+type PermissionAuditSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionAuditSourceSpec   `json:"spec,omitempty"`
+	Status PermissionAuditSourceStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces that PermissionAuditSource should be implementing.
+var (
+	_ runtime.Object     = (*PermissionAuditSource)(nil)
+	_ kmeta.OwnerRefable = (*PermissionAuditSource)(nil)
+	_ apis.Validatable   = (*PermissionAuditSource)(nil)
+	_ apis.Defaultable   = (*PermissionAuditSource)(nil)
+	_ apis.HasSpec       = (*PermissionAuditSource)(nil)
+	_ duckv1.KRShaped    = (*PermissionAuditSource)(nil)
+)
+
+// PermissionAuditSourceSpec defines the desired state of PermissionAuditSource
+type PermissionAuditSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Permissions is the list of the permissions to audit.
+	Permissions []PermissionAuditPermission `json:"permissions"`
+
+	// Interval is the time between two audits of the permissions, as a
+	// duration string such as "5m". Defaults to 5m if not set.
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// ServiceAccountName is the name of the ServiceAccount to use to run this
+	// source. It must be allowed to create SubjectAccessReviews. Defaults to
+	// default if not set.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// PermissionAuditPermission is a permission a ServiceAccount is expected to
+// have, or not.
+type PermissionAuditPermission struct {
+	// ServiceAccount is the name of the audited ServiceAccount, in the
+	// namespace of the source.
+	ServiceAccount string `json:"serviceAccount"`
+
+	// Verb is the audited verb, e.g. get or create.
+	Verb string `json:"verb"`
+
+	// APIGroup is the API group of the resource. The core API group is
+	// used if not set.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+
+	// Resource is the plural name of the audited resource, e.g. pods.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the permission is audited in. The
+	// permission is audited cluster wide when it is not set.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PermissionAuditSourceStatus defines the observed state of PermissionAuditSource
+type PermissionAuditSourceStatus struct {
+	// inherits duck/v1 SourceStatus, which currently provides:
+	// * ObservedGeneration - the 'Generation' of the Service that was last
+	//   processed by the controller.
+	// * Conditions - the latest available observations of a resource's current
+	//   state.
+	// * SinkURI - the current active sink URI that has been configured for the
+	//   Source.
+	duckv1.SourceStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PermissionAuditSourceList contains a list of PermissionAuditSource
+type PermissionAuditSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PermissionAuditSource `json:"items"`
+}
+
+// GetStatus retrieves the status of the PermissionAuditSource. Implements the KRShaped interface.
+func (s *PermissionAuditSource) GetStatus() *duckv1.Status {
+	return &s.Status.Status
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+)
+
+// minPermissionAuditInterval bounds the load the audits put on the API server.
+const minPermissionAuditInterval = 10 * time.Second
+
+func (s *PermissionAuditSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ss *PermissionAuditSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	// Validate sink
+	errs = errs.Also(ss.Sink.Validate(ctx).ViaField("sink"))
+
+	if len(ss.Permissions) == 0 {
+		errs = errs.Also(apis.ErrMissingField("permissions"))
+	}
+	for i, p := range ss.Permissions {
+		errs = errs.Also(p.Validate(ctx).ViaFieldIndex("permissions", i))
+	}
+
+	if ss.Interval != "" {
+		if interval, err := time.ParseDuration(ss.Interval); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(ss.Interval, "interval"))
+		} else if interval < minPermissionAuditInterval {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(ss.Interval, minPermissionAuditInterval.String(), "", "interval"))
+		}
+	}
+
+	errs = errs.Also(ss.SourceSpec.Validate(ctx))
+	return errs
+}
+
+func (p *PermissionAuditPermission) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if p.ServiceAccount == "" {
+		errs = errs.Also(apis.ErrMissingField("serviceAccount"))
+	}
+	if p.Verb == "" {
+		errs = errs.Also(apis.ErrMissingField("verb"))
+	}
+	if p.Resource == "" {
+		errs = errs.Also(apis.ErrMissingField("resource"))
+	}
+	return errs
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestPermissionAuditSourceValidation(t *testing.T) {
+	sink := duckv1.SourceSpec{
+		Sink: duckv1.Destination{
+			Ref: &duckv1.KReference{
+				APIVersion: "v1",
+				Kind:       "broker",
+				Name:       "default",
+			},
+		},
+	}
+
+	permissions := []PermissionAuditPermission{{
+		ServiceAccount: "deployer",
+		Verb:           "create",
+		APIGroup:       "apps",
+		Resource:       "deployments",
+		Namespace:      "production",
+	}}
+
+	tests := []struct {
+		name string
+		spec PermissionAuditSourceSpec
+		want *apis.FieldError
+	}{{
+		name: "valid spec",
+		spec: PermissionAuditSourceSpec{
+			SourceSpec:  sink,
+			Permissions: permissions,
+		},
+	}, {
+		name: "valid interval",
+		spec: PermissionAuditSourceSpec{
+			SourceSpec:  sink,
+			Permissions: permissions,
+			Interval:    "1h30m",
+		},
+	}, {
+		name: "empty sink",
+		spec: PermissionAuditSourceSpec{
+			Permissions: permissions,
+		},
+		want: apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("sink"),
+	}, {
+		name: "no permissions",
+		spec: PermissionAuditSourceSpec{
+			SourceSpec: sink,
+		},
+		want: apis.ErrMissingField("permissions"),
+	}, {
+		name: "incomplete permission",
+		spec: PermissionAuditSourceSpec{
+			SourceSpec: sink,
+			Permissions: []PermissionAuditPermission{permissions[0], {
+				ServiceAccount: "deployer",
+			}},
+		},
+		want: apis.ErrMissingField("verb", "resource").ViaFieldIndex("permissions", 1),
+	}, {
+		name: "invalid interval",
+		spec: PermissionAuditSourceSpec{
+			SourceSpec:  sink,
+			Permissions: permissions,
+			Interval:    "every hour",
+		},
+		want: apis.ErrInvalidValue("every hour", "interval"),
+	}, {
+		name: "interval too short",
+		spec: PermissionAuditSourceSpec{
+			SourceSpec:  sink,
+			Permissions: permissions,
+			Interval:    "1s",
+		},
+		want: apis.ErrOutOfBoundsValue("1s", "10s", "", "interval"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.TODO())
+			if test.want != nil {
+				if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+					t.Errorf("PermissionAuditSourceSpec.Validate (-want, +got) = %v", diff)
+				}
+			} else if got != nil {
+				t.Errorf("PermissionAuditSourceSpec.Validate wanted nil, got = %v", got.Error())
+			}
+		})
+	}
+}
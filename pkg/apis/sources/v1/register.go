@@ -53,6 +53,22 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ContainerSourceList{},
 		&PingSource{},
 		&PingSourceList{},
+		&ChannelStatusSource{},
+		&ChannelStatusSourceList{},
+		&PermissionAuditSource{},
+		&PermissionAuditSourceList{},
+		&ClusterClaimSource{},
+		&ClusterClaimSourceList{},
+		&SecretRotationSource{},
+		&SecretRotationSourceList{},
+		&BrokerStatusSource{},
+		&BrokerStatusSourceList{},
+		&WhereaboutsSource{},
+		&WhereaboutsSourceList{},
+		&ArgoCDApplicationSetSource{},
+		&ArgoCDApplicationSetSourceList{},
+		&NodePressureSource{},
+		&NodePressureSourceList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
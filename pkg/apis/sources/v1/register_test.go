@@ -68,6 +68,22 @@ func TestKnownTypes(t *testing.T) {
 		"SinkBindingList",
 		"ContainerSource",
 		"ContainerSourceList",
+		"ChannelStatusSource",
+		"ChannelStatusSourceList",
+		"PermissionAuditSource",
+		"PermissionAuditSourceList",
+		"ClusterClaimSource",
+		"ClusterClaimSourceList",
+		"SecretRotationSource",
+		"SecretRotationSourceList",
+		"BrokerStatusSource",
+		"BrokerStatusSourceList",
+		"WhereaboutsSource",
+		"WhereaboutsSourceList",
+		"ArgoCDApplicationSetSource",
+		"ArgoCDApplicationSetSourceList",
+		"NodePressureSource",
+		"NodePressureSourceList",
 	} {
 		if _, ok := types[name]; !ok {
 			t.Errorf("Did not find %q as registered type", name)
@@ -68,6 +68,78 @@ var FuzzerFuncs = fuzzer.MergeFuzzerFuncs(
 				// Clear the random fuzzed condition
 				source.Status.SetConditions(nil)
 
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *NodePressureSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *ArgoCDApplicationSetSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *WhereaboutsSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *BrokerStatusSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *SecretRotationSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *ClusterClaimSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *PermissionAuditSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
+				// Fuzz the known conditions except their type value
+				source.Status.InitializeConditions()
+				pkgfuzzer.FuzzConditions(&source.Status, c)
+			},
+			func(source *ChannelStatusSource, c fuzz.Continue) {
+				c.FuzzNoCustom(source) // fuzz the source
+				// Clear the random fuzzed condition
+				source.Status.SetConditions(nil)
+
 				// Fuzz the known conditions except their type value
 				source.Status.InitializeConditions()
 				pkgfuzzer.FuzzConditions(&source.Status, c)
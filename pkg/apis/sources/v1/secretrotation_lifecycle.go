@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// SecretRotationConditionReady has status True when the SecretRotationSource is ready to send events.
+	SecretRotationConditionReady = apis.ConditionReady
+
+	// SecretRotationConditionSinkProvided has status True when the SecretRotationSource has been configured with a sink target.
+	SecretRotationConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// SecretRotationConditionDeployed has status True when the SecretRotationSource has had it's receive adapter deployment created.
+	SecretRotationConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var secretRotationCondSet = apis.NewLivingConditionSet(
+	SecretRotationConditionSinkProvided,
+	SecretRotationConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*SecretRotationSource) GetConditionSet() apis.ConditionSet {
+	return secretRotationCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*SecretRotationSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("SecretRotationSource")
+}
+
+// SecretRotationSourceSource returns the SecretRotationSource CloudEvent source.
+func SecretRotationSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/secretrotationsources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the SecretRotationSource.
+func (s *SecretRotationSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *SecretRotationSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return secretRotationCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *SecretRotationSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return secretRotationCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *SecretRotationSourceStatus) InitializeConditions() {
+	secretRotationCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *SecretRotationSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		secretRotationCondSet.Manage(s).MarkTrue(SecretRotationConditionSinkProvided)
+	} else {
+		secretRotationCondSet.Manage(s).MarkFalse(SecretRotationConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *SecretRotationSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	secretRotationCondSet.Manage(s).MarkFalse(SecretRotationConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// SecretRotationConditionDeployed should be marked as true or false.
+func (s *SecretRotationSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				secretRotationCondSet.Manage(s).MarkTrue(SecretRotationConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				secretRotationCondSet.Manage(s).MarkFalse(SecretRotationConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				secretRotationCondSet.Manage(s).MarkUnknown(SecretRotationConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		secretRotationCondSet.Manage(s).MarkUnknown(SecretRotationConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *SecretRotationSourceStatus) IsReady() bool {
+	return secretRotationCondSet.Manage(s).IsHappy()
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// WhereaboutsConditionReady has status True when the WhereaboutsSource is ready to send events.
+	WhereaboutsConditionReady = apis.ConditionReady
+
+	// WhereaboutsConditionSinkProvided has status True when the WhereaboutsSource has been configured with a sink target.
+	WhereaboutsConditionSinkProvided apis.ConditionType = "SinkProvided"
+
+	// WhereaboutsConditionDeployed has status True when the WhereaboutsSource has had it's receive adapter deployment created.
+	WhereaboutsConditionDeployed apis.ConditionType = "Deployed"
+)
+
+var whereaboutsCondSet = apis.NewLivingConditionSet(
+	WhereaboutsConditionSinkProvided,
+	WhereaboutsConditionDeployed,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*WhereaboutsSource) GetConditionSet() apis.ConditionSet {
+	return whereaboutsCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*WhereaboutsSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("WhereaboutsSource")
+}
+
+// WhereaboutsSourceSource returns the WhereaboutsSource CloudEvent source.
+func WhereaboutsSourceSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1/namespaces/%s/whereaboutssources/%s", namespace, name)
+}
+
+// GetUntypedSpec returns the spec of the WhereaboutsSource.
+func (s *WhereaboutsSource) GetUntypedSpec() interface{} {
+	return s.Spec
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *WhereaboutsSourceStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return whereaboutsCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level condition.
+func (s *WhereaboutsSourceStatus) GetTopLevelCondition() *apis.Condition {
+	return whereaboutsCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *WhereaboutsSourceStatus) InitializeConditions() {
+	whereaboutsCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkSink sets the condition that the source has a sink configured.
+func (s *WhereaboutsSourceStatus) MarkSink(uri *apis.URL) {
+	s.SinkURI = uri
+	if uri != nil {
+		whereaboutsCondSet.Manage(s).MarkTrue(WhereaboutsConditionSinkProvided)
+	} else {
+		whereaboutsCondSet.Manage(s).MarkFalse(WhereaboutsConditionSinkProvided, "SinkEmpty", "Sink has resolved to empty.%s", "")
+	}
+}
+
+// MarkNoSink sets the condition that the source does not have a sink configured.
+func (s *WhereaboutsSourceStatus) MarkNoSink(reason, messageFormat string, messageA ...interface{}) {
+	whereaboutsCondSet.Manage(s).MarkFalse(WhereaboutsConditionSinkProvided, reason, messageFormat, messageA...)
+}
+
+// PropagateDeploymentAvailability uses the availability of the provided Deployment to determine if
+// WhereaboutsConditionDeployed should be marked as true or false.
+func (s *WhereaboutsSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment) {
+	deploymentAvailableFound := false
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			deploymentAvailableFound = true
+			if cond.Status == corev1.ConditionTrue {
+				whereaboutsCondSet.Manage(s).MarkTrue(WhereaboutsConditionDeployed)
+			} else if cond.Status == corev1.ConditionFalse {
+				whereaboutsCondSet.Manage(s).MarkFalse(WhereaboutsConditionDeployed, cond.Reason, cond.Message)
+			} else if cond.Status == corev1.ConditionUnknown {
+				whereaboutsCondSet.Manage(s).MarkUnknown(WhereaboutsConditionDeployed, cond.Reason, cond.Message)
+			}
+		}
+	}
+	if !deploymentAvailableFound {
+		whereaboutsCondSet.Manage(s).MarkUnknown(WhereaboutsConditionDeployed, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+	}
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *WhereaboutsSourceStatus) IsReady() bool {
+	return whereaboutsCondSet.Manage(s).IsHappy()
+}
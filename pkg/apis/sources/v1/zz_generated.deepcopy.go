@@ -22,8 +22,12 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	apis "knative.dev/pkg/apis"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -50,21 +54,879 @@ func (in *APIVersionKindSelector) DeepCopyInto(out *APIVersionKindSelector) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIVersionKindSelector.
+func (in *APIVersionKindSelector) DeepCopy() *APIVersionKindSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(APIVersionKindSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSource) DeepCopyInto(out *ApiServerSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSource.
+func (in *ApiServerSource) DeepCopy() *ApiServerSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApiServerSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceAcknowledgement) DeepCopyInto(out *ApiServerSourceAcknowledgement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceAcknowledgement.
+func (in *ApiServerSourceAcknowledgement) DeepCopy() *ApiServerSourceAcknowledgement {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceAcknowledgement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceBatch) DeepCopyInto(out *ApiServerSourceBatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceBatch.
+func (in *ApiServerSourceBatch) DeepCopy() *ApiServerSourceBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceCABundle) DeepCopyInto(out *ApiServerSourceCABundle) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceCABundle.
+func (in *ApiServerSourceCABundle) DeepCopy() *ApiServerSourceCABundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceCABundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceCheckpoint) DeepCopyInto(out *ApiServerSourceCheckpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceCheckpoint.
+func (in *ApiServerSourceCheckpoint) DeepCopy() *ApiServerSourceCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceCluster) DeepCopyInto(out *ApiServerSourceCluster) {
+	*out = *in
+	in.KubeconfigSecretRef.DeepCopyInto(&out.KubeconfigSecretRef)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceCluster.
+func (in *ApiServerSourceCluster) DeepCopy() *ApiServerSourceCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceList) DeepCopyInto(out *ApiServerSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApiServerSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceList.
+func (in *ApiServerSourceList) DeepCopy() *ApiServerSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApiServerSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceRateLimit) DeepCopyInto(out *ApiServerSourceRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceRateLimit.
+func (in *ApiServerSourceRateLimit) DeepCopy() *ApiServerSourceRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceSink) DeepCopyInto(out *ApiServerSourceSink) {
+	*out = *in
+	in.Sink.DeepCopyInto(&out.Sink)
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]eventingv1.SubscriptionsAPIFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceSink.
+func (in *ApiServerSourceSink) DeepCopy() *ApiServerSourceSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceSpec) DeepCopyInto(out *ApiServerSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]APIVersionKindSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceOwner != nil {
+		in, out := &in.ResourceOwner, &out.ResourceOwner
+		*out = new(APIVersionKind)
+		**out = **in
+	}
+	if in.OwnerRefMaxDepth != nil {
+		in, out := &in.OwnerRefMaxDepth, &out.OwnerRefMaxDepth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtensionExpressions != nil {
+		in, out := &in.ExtensionExpressions, &out.ExtensionExpressions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FieldsToDrop != nil {
+		in, out := &in.FieldsToDrop, &out.FieldsToDrop
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(ApiServerSourceBatch)
+		**out = **in
+	}
+	if in.Delivery != nil {
+		in, out := &in.Delivery, &out.Delivery
+		*out = new(duckv1.DeliverySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ApiServerSourceRateLimit)
+		**out = **in
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(ApiServerSourceCheckpoint)
+		**out = **in
+	}
+	if in.Acknowledgement != nil {
+		in, out := &in.Acknowledgement, &out.Acknowledgement
+		*out = new(ApiServerSourceAcknowledgement)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]eventingv1.SubscriptionsAPIFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]ApiServerSourceSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ApiServerSourceTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(ApiServerSourceCluster)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceSpec.
+func (in *ApiServerSourceSpec) DeepCopy() *ApiServerSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceStatus) DeepCopyInto(out *ApiServerSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	in.DeliveryStatus.DeepCopyInto(&out.DeliveryStatus)
+	if in.SinkURIs != nil {
+		in, out := &in.SinkURIs, &out.SinkURIs
+		*out = make([]*apis.URL, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apis.URL)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ForbiddenNamespaces != nil {
+		in, out := &in.ForbiddenNamespaces, &out.ForbiddenNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceStatus.
+func (in *ApiServerSourceStatus) DeepCopy() *ApiServerSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiServerSourceTLS) DeepCopyInto(out *ApiServerSourceTLS) {
+	*out = *in
+	if in.CACerts != nil {
+		in, out := &in.CACerts, &out.CACerts
+		*out = new(ApiServerSourceCABundle)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceTLS.
+func (in *ApiServerSourceTLS) DeepCopy() *ApiServerSourceTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiServerSourceTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDApplicationSetSource) DeepCopyInto(out *ArgoCDApplicationSetSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDApplicationSetSource.
+func (in *ArgoCDApplicationSetSource) DeepCopy() *ArgoCDApplicationSetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDApplicationSetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArgoCDApplicationSetSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDApplicationSetSourceList) DeepCopyInto(out *ArgoCDApplicationSetSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArgoCDApplicationSetSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDApplicationSetSourceList.
+func (in *ArgoCDApplicationSetSourceList) DeepCopy() *ArgoCDApplicationSetSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDApplicationSetSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArgoCDApplicationSetSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDApplicationSetSourceSpec) DeepCopyInto(out *ArgoCDApplicationSetSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDApplicationSetSourceSpec.
+func (in *ArgoCDApplicationSetSourceSpec) DeepCopy() *ArgoCDApplicationSetSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDApplicationSetSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDApplicationSetSourceStatus) DeepCopyInto(out *ArgoCDApplicationSetSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDApplicationSetSourceStatus.
+func (in *ArgoCDApplicationSetSourceStatus) DeepCopy() *ArgoCDApplicationSetSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDApplicationSetSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerStatusSource) DeepCopyInto(out *BrokerStatusSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerStatusSource.
+func (in *BrokerStatusSource) DeepCopy() *BrokerStatusSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerStatusSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BrokerStatusSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerStatusSourceList) DeepCopyInto(out *BrokerStatusSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BrokerStatusSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerStatusSourceList.
+func (in *BrokerStatusSourceList) DeepCopy() *BrokerStatusSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerStatusSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BrokerStatusSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerStatusSourceSpec) DeepCopyInto(out *BrokerStatusSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerStatusSourceSpec.
+func (in *BrokerStatusSourceSpec) DeepCopy() *BrokerStatusSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerStatusSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerStatusSourceStatus) DeepCopyInto(out *BrokerStatusSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerStatusSourceStatus.
+func (in *BrokerStatusSourceStatus) DeepCopy() *BrokerStatusSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerStatusSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelStatusSource) DeepCopyInto(out *ChannelStatusSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatusSource.
+func (in *ChannelStatusSource) DeepCopy() *ChannelStatusSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelStatusSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChannelStatusSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelStatusSourceList) DeepCopyInto(out *ChannelStatusSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChannelStatusSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatusSourceList.
+func (in *ChannelStatusSourceList) DeepCopy() *ChannelStatusSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelStatusSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChannelStatusSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelStatusSourceSpec) DeepCopyInto(out *ChannelStatusSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatusSourceSpec.
+func (in *ChannelStatusSourceSpec) DeepCopy() *ChannelStatusSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelStatusSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelStatusSourceStatus) DeepCopyInto(out *ChannelStatusSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatusSourceStatus.
+func (in *ChannelStatusSourceStatus) DeepCopy() *ChannelStatusSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelStatusSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClaimSource) DeepCopyInto(out *ClusterClaimSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClaimSource.
+func (in *ClusterClaimSource) DeepCopy() *ClusterClaimSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClaimSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterClaimSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClaimSourceList) DeepCopyInto(out *ClusterClaimSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterClaimSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClaimSourceList.
+func (in *ClusterClaimSourceList) DeepCopy() *ClusterClaimSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClaimSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterClaimSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClaimSourceSpec) DeepCopyInto(out *ClusterClaimSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClaimSourceSpec.
+func (in *ClusterClaimSourceSpec) DeepCopy() *ClusterClaimSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClaimSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClaimSourceStatus) DeepCopyInto(out *ClusterClaimSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClaimSourceStatus.
+func (in *ClusterClaimSourceStatus) DeepCopy() *ClusterClaimSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClaimSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSource) DeepCopyInto(out *ContainerSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSource.
+func (in *ContainerSource) DeepCopy() *ContainerSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContainerSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSourceList) DeepCopyInto(out *ContainerSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContainerSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceList.
+func (in *ContainerSourceList) DeepCopy() *ContainerSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContainerSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSourceSpec) DeepCopyInto(out *ContainerSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.Template.DeepCopyInto(&out.Template)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIVersionKindSelector.
-func (in *APIVersionKindSelector) DeepCopy() *APIVersionKindSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceSpec.
+func (in *ContainerSourceSpec) DeepCopy() *ContainerSourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(APIVersionKindSelector)
+	out := new(ContainerSourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApiServerSource) DeepCopyInto(out *ApiServerSource) {
+func (in *ContainerSourceStatus) DeepCopyInto(out *ContainerSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceStatus.
+func (in *ContainerSourceStatus) DeepCopy() *ContainerSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePressureSource) DeepCopyInto(out *NodePressureSource) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -73,18 +935,18 @@ func (in *ApiServerSource) DeepCopyInto(out *ApiServerSource) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSource.
-func (in *ApiServerSource) DeepCopy() *ApiServerSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePressureSource.
+func (in *NodePressureSource) DeepCopy() *NodePressureSource {
 	if in == nil {
 		return nil
 	}
-	out := new(ApiServerSource)
+	out := new(NodePressureSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ApiServerSource) DeepCopyObject() runtime.Object {
+func (in *NodePressureSource) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -92,13 +954,13 @@ func (in *ApiServerSource) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApiServerSourceList) DeepCopyInto(out *ApiServerSourceList) {
+func (in *NodePressureSourceList) DeepCopyInto(out *NodePressureSourceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ApiServerSource, len(*in))
+		*out = make([]NodePressureSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -106,18 +968,18 @@ func (in *ApiServerSourceList) DeepCopyInto(out *ApiServerSourceList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceList.
-func (in *ApiServerSourceList) DeepCopy() *ApiServerSourceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePressureSourceList.
+func (in *NodePressureSourceList) DeepCopy() *NodePressureSourceList {
 	if in == nil {
 		return nil
 	}
-	out := new(ApiServerSourceList)
+	out := new(NodePressureSourceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ApiServerSourceList) DeepCopyObject() runtime.Object {
+func (in *NodePressureSourceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -125,53 +987,62 @@ func (in *ApiServerSourceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApiServerSourceSpec) DeepCopyInto(out *ApiServerSourceSpec) {
+func (in *NodePressureSourceSpec) DeepCopyInto(out *NodePressureSourceSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]APIVersionKindSelector, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ResourceOwner != nil {
-		in, out := &in.ResourceOwner, &out.ResourceOwner
-		*out = new(APIVersionKind)
-		**out = **in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceSpec.
-func (in *ApiServerSourceSpec) DeepCopy() *ApiServerSourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePressureSourceSpec.
+func (in *NodePressureSourceSpec) DeepCopy() *NodePressureSourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ApiServerSourceSpec)
+	out := new(NodePressureSourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApiServerSourceStatus) DeepCopyInto(out *ApiServerSourceStatus) {
+func (in *NodePressureSourceStatus) DeepCopyInto(out *NodePressureSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiServerSourceStatus.
-func (in *ApiServerSourceStatus) DeepCopy() *ApiServerSourceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePressureSourceStatus.
+func (in *NodePressureSourceStatus) DeepCopy() *NodePressureSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ApiServerSourceStatus)
+	out := new(NodePressureSourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerSource) DeepCopyInto(out *ContainerSource) {
+func (in *PermissionAuditPermission) DeepCopyInto(out *PermissionAuditPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionAuditPermission.
+func (in *PermissionAuditPermission) DeepCopy() *PermissionAuditPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionAuditPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionAuditSource) DeepCopyInto(out *PermissionAuditSource) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -180,18 +1051,18 @@ func (in *ContainerSource) DeepCopyInto(out *ContainerSource) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSource.
-func (in *ContainerSource) DeepCopy() *ContainerSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionAuditSource.
+func (in *PermissionAuditSource) DeepCopy() *PermissionAuditSource {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerSource)
+	out := new(PermissionAuditSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ContainerSource) DeepCopyObject() runtime.Object {
+func (in *PermissionAuditSource) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -199,13 +1070,13 @@ func (in *ContainerSource) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerSourceList) DeepCopyInto(out *ContainerSourceList) {
+func (in *PermissionAuditSourceList) DeepCopyInto(out *PermissionAuditSourceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ContainerSource, len(*in))
+		*out = make([]PermissionAuditSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -213,18 +1084,18 @@ func (in *ContainerSourceList) DeepCopyInto(out *ContainerSourceList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceList.
-func (in *ContainerSourceList) DeepCopy() *ContainerSourceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionAuditSourceList.
+func (in *PermissionAuditSourceList) DeepCopy() *PermissionAuditSourceList {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerSourceList)
+	out := new(PermissionAuditSourceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ContainerSourceList) DeepCopyObject() runtime.Object {
+func (in *PermissionAuditSourceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -232,36 +1103,40 @@ func (in *ContainerSourceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerSourceSpec) DeepCopyInto(out *ContainerSourceSpec) {
+func (in *PermissionAuditSourceSpec) DeepCopyInto(out *PermissionAuditSourceSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
-	in.Template.DeepCopyInto(&out.Template)
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]PermissionAuditPermission, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceSpec.
-func (in *ContainerSourceSpec) DeepCopy() *ContainerSourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionAuditSourceSpec.
+func (in *PermissionAuditSourceSpec) DeepCopy() *PermissionAuditSourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerSourceSpec)
+	out := new(PermissionAuditSourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerSourceStatus) DeepCopyInto(out *ContainerSourceStatus) {
+func (in *PermissionAuditSourceStatus) DeepCopyInto(out *PermissionAuditSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceStatus.
-func (in *ContainerSourceStatus) DeepCopy() *ContainerSourceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionAuditSourceStatus.
+func (in *PermissionAuditSourceStatus) DeepCopy() *PermissionAuditSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerSourceStatus)
+	out := new(PermissionAuditSourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -361,6 +1236,106 @@ func (in *PingSourceStatus) DeepCopy() *PingSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotationSource) DeepCopyInto(out *SecretRotationSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotationSource.
+func (in *SecretRotationSource) DeepCopy() *SecretRotationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretRotationSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotationSourceList) DeepCopyInto(out *SecretRotationSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretRotationSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotationSourceList.
+func (in *SecretRotationSourceList) DeepCopy() *SecretRotationSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretRotationSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotationSourceSpec) DeepCopyInto(out *SecretRotationSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotationSourceSpec.
+func (in *SecretRotationSourceSpec) DeepCopy() *SecretRotationSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotationSourceStatus) DeepCopyInto(out *SecretRotationSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotationSourceStatus.
+func (in *SecretRotationSourceStatus) DeepCopy() *SecretRotationSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SinkBinding) DeepCopyInto(out *SinkBinding) {
 	*out = *in
@@ -456,3 +1431,103 @@ func (in *SinkBindingStatus) DeepCopy() *SinkBindingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhereaboutsSource) DeepCopyInto(out *WhereaboutsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhereaboutsSource.
+func (in *WhereaboutsSource) DeepCopy() *WhereaboutsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WhereaboutsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WhereaboutsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhereaboutsSourceList) DeepCopyInto(out *WhereaboutsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WhereaboutsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhereaboutsSourceList.
+func (in *WhereaboutsSourceList) DeepCopy() *WhereaboutsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(WhereaboutsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WhereaboutsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhereaboutsSourceSpec) DeepCopyInto(out *WhereaboutsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhereaboutsSourceSpec.
+func (in *WhereaboutsSourceSpec) DeepCopy() *WhereaboutsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WhereaboutsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhereaboutsSourceStatus) DeepCopyInto(out *WhereaboutsSourceStatus) {
+	*out = *in
+	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhereaboutsSourceStatus.
+func (in *WhereaboutsSourceStatus) DeepCopy() *WhereaboutsSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WhereaboutsSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -32,18 +32,50 @@ func (c *FakeSourcesV1) ApiServerSources(namespace string) v1.ApiServerSourceInt
 	return &FakeApiServerSources{c, namespace}
 }
 
+func (c *FakeSourcesV1) ArgoCDApplicationSetSources(namespace string) v1.ArgoCDApplicationSetSourceInterface {
+	return &FakeArgoCDApplicationSetSources{c, namespace}
+}
+
+func (c *FakeSourcesV1) BrokerStatusSources(namespace string) v1.BrokerStatusSourceInterface {
+	return &FakeBrokerStatusSources{c, namespace}
+}
+
+func (c *FakeSourcesV1) ChannelStatusSources(namespace string) v1.ChannelStatusSourceInterface {
+	return &FakeChannelStatusSources{c, namespace}
+}
+
+func (c *FakeSourcesV1) ClusterClaimSources(namespace string) v1.ClusterClaimSourceInterface {
+	return &FakeClusterClaimSources{c, namespace}
+}
+
 func (c *FakeSourcesV1) ContainerSources(namespace string) v1.ContainerSourceInterface {
 	return &FakeContainerSources{c, namespace}
 }
 
+func (c *FakeSourcesV1) NodePressureSources(namespace string) v1.NodePressureSourceInterface {
+	return &FakeNodePressureSources{c, namespace}
+}
+
+func (c *FakeSourcesV1) PermissionAuditSources(namespace string) v1.PermissionAuditSourceInterface {
+	return &FakePermissionAuditSources{c, namespace}
+}
+
 func (c *FakeSourcesV1) PingSources(namespace string) v1.PingSourceInterface {
 	return &FakePingSources{c, namespace}
 }
 
+func (c *FakeSourcesV1) SecretRotationSources(namespace string) v1.SecretRotationSourceInterface {
+	return &FakeSecretRotationSources{c, namespace}
+}
+
 func (c *FakeSourcesV1) SinkBindings(namespace string) v1.SinkBindingInterface {
 	return &FakeSinkBindings{c, namespace}
 }
 
+func (c *FakeSourcesV1) WhereaboutsSources(namespace string) v1.WhereaboutsSourceInterface {
+	return &FakeWhereaboutsSources{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSourcesV1) RESTClient() rest.Interface {
@@ -20,8 +20,24 @@ package v1
 
 type ApiServerSourceExpansion interface{}
 
+type ArgoCDApplicationSetSourceExpansion interface{}
+
+type BrokerStatusSourceExpansion interface{}
+
+type ChannelStatusSourceExpansion interface{}
+
+type ClusterClaimSourceExpansion interface{}
+
 type ContainerSourceExpansion interface{}
 
+type NodePressureSourceExpansion interface{}
+
+type PermissionAuditSourceExpansion interface{}
+
 type PingSourceExpansion interface{}
 
+type SecretRotationSourceExpansion interface{}
+
 type SinkBindingExpansion interface{}
+
+type WhereaboutsSourceExpansion interface{}
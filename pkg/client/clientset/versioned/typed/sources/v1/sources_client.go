@@ -29,9 +29,17 @@ import (
 type SourcesV1Interface interface {
 	RESTClient() rest.Interface
 	ApiServerSourcesGetter
+	ArgoCDApplicationSetSourcesGetter
+	BrokerStatusSourcesGetter
+	ChannelStatusSourcesGetter
+	ClusterClaimSourcesGetter
 	ContainerSourcesGetter
+	NodePressureSourcesGetter
+	PermissionAuditSourcesGetter
 	PingSourcesGetter
+	SecretRotationSourcesGetter
 	SinkBindingsGetter
+	WhereaboutsSourcesGetter
 }
 
 // SourcesV1Client is used to interact with features provided by the sources.knative.dev group.
@@ -43,18 +51,50 @@ func (c *SourcesV1Client) ApiServerSources(namespace string) ApiServerSourceInte
 	return newApiServerSources(c, namespace)
 }
 
+func (c *SourcesV1Client) ArgoCDApplicationSetSources(namespace string) ArgoCDApplicationSetSourceInterface {
+	return newArgoCDApplicationSetSources(c, namespace)
+}
+
+func (c *SourcesV1Client) BrokerStatusSources(namespace string) BrokerStatusSourceInterface {
+	return newBrokerStatusSources(c, namespace)
+}
+
+func (c *SourcesV1Client) ChannelStatusSources(namespace string) ChannelStatusSourceInterface {
+	return newChannelStatusSources(c, namespace)
+}
+
+func (c *SourcesV1Client) ClusterClaimSources(namespace string) ClusterClaimSourceInterface {
+	return newClusterClaimSources(c, namespace)
+}
+
 func (c *SourcesV1Client) ContainerSources(namespace string) ContainerSourceInterface {
 	return newContainerSources(c, namespace)
 }
 
+func (c *SourcesV1Client) NodePressureSources(namespace string) NodePressureSourceInterface {
+	return newNodePressureSources(c, namespace)
+}
+
+func (c *SourcesV1Client) PermissionAuditSources(namespace string) PermissionAuditSourceInterface {
+	return newPermissionAuditSources(c, namespace)
+}
+
 func (c *SourcesV1Client) PingSources(namespace string) PingSourceInterface {
 	return newPingSources(c, namespace)
 }
 
+func (c *SourcesV1Client) SecretRotationSources(namespace string) SecretRotationSourceInterface {
+	return newSecretRotationSources(c, namespace)
+}
+
 func (c *SourcesV1Client) SinkBindings(namespace string) SinkBindingInterface {
 	return newSinkBindings(c, namespace)
 }
 
+func (c *SourcesV1Client) WhereaboutsSources(namespace string) WhereaboutsSourceInterface {
+	return newWhereaboutsSources(c, namespace)
+}
+
 // NewForConfig creates a new SourcesV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
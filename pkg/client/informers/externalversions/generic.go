@@ -84,12 +84,28 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=sources.knative.dev, Version=v1
 	case sourcesv1.SchemeGroupVersion.WithResource("apiserversources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().ApiServerSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("argocdapplicationsetsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().ArgoCDApplicationSetSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("brokerstatussources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().BrokerStatusSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("channelstatussources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().ChannelStatusSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("clusterclaimsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().ClusterClaimSources().Informer()}, nil
 	case sourcesv1.SchemeGroupVersion.WithResource("containersources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().ContainerSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("nodepressuresources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().NodePressureSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("permissionauditsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().PermissionAuditSources().Informer()}, nil
 	case sourcesv1.SchemeGroupVersion.WithResource("pingsources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().PingSources().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("secretrotationsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().SecretRotationSources().Informer()}, nil
 	case sourcesv1.SchemeGroupVersion.WithResource("sinkbindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().SinkBindings().Informer()}, nil
+	case sourcesv1.SchemeGroupVersion.WithResource("whereaboutssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1().WhereaboutsSources().Informer()}, nil
 
 		// Group=sources.knative.dev, Version=v1beta2
 	case v1beta2.SchemeGroupVersion.WithResource("pingsources"):
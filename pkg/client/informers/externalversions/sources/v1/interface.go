@@ -26,12 +26,28 @@ import (
 type Interface interface {
 	// ApiServerSources returns a ApiServerSourceInformer.
 	ApiServerSources() ApiServerSourceInformer
+	// ArgoCDApplicationSetSources returns a ArgoCDApplicationSetSourceInformer.
+	ArgoCDApplicationSetSources() ArgoCDApplicationSetSourceInformer
+	// BrokerStatusSources returns a BrokerStatusSourceInformer.
+	BrokerStatusSources() BrokerStatusSourceInformer
+	// ChannelStatusSources returns a ChannelStatusSourceInformer.
+	ChannelStatusSources() ChannelStatusSourceInformer
+	// ClusterClaimSources returns a ClusterClaimSourceInformer.
+	ClusterClaimSources() ClusterClaimSourceInformer
 	// ContainerSources returns a ContainerSourceInformer.
 	ContainerSources() ContainerSourceInformer
+	// NodePressureSources returns a NodePressureSourceInformer.
+	NodePressureSources() NodePressureSourceInformer
+	// PermissionAuditSources returns a PermissionAuditSourceInformer.
+	PermissionAuditSources() PermissionAuditSourceInformer
 	// PingSources returns a PingSourceInformer.
 	PingSources() PingSourceInformer
+	// SecretRotationSources returns a SecretRotationSourceInformer.
+	SecretRotationSources() SecretRotationSourceInformer
 	// SinkBindings returns a SinkBindingInformer.
 	SinkBindings() SinkBindingInformer
+	// WhereaboutsSources returns a WhereaboutsSourceInformer.
+	WhereaboutsSources() WhereaboutsSourceInformer
 }
 
 type version struct {
@@ -50,17 +66,57 @@ func (v *version) ApiServerSources() ApiServerSourceInformer {
 	return &apiServerSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ArgoCDApplicationSetSources returns a ArgoCDApplicationSetSourceInformer.
+func (v *version) ArgoCDApplicationSetSources() ArgoCDApplicationSetSourceInformer {
+	return &argoCDApplicationSetSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// BrokerStatusSources returns a BrokerStatusSourceInformer.
+func (v *version) BrokerStatusSources() BrokerStatusSourceInformer {
+	return &brokerStatusSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ChannelStatusSources returns a ChannelStatusSourceInformer.
+func (v *version) ChannelStatusSources() ChannelStatusSourceInformer {
+	return &channelStatusSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterClaimSources returns a ClusterClaimSourceInformer.
+func (v *version) ClusterClaimSources() ClusterClaimSourceInformer {
+	return &clusterClaimSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ContainerSources returns a ContainerSourceInformer.
 func (v *version) ContainerSources() ContainerSourceInformer {
 	return &containerSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NodePressureSources returns a NodePressureSourceInformer.
+func (v *version) NodePressureSources() NodePressureSourceInformer {
+	return &nodePressureSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// PermissionAuditSources returns a PermissionAuditSourceInformer.
+func (v *version) PermissionAuditSources() PermissionAuditSourceInformer {
+	return &permissionAuditSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PingSources returns a PingSourceInformer.
 func (v *version) PingSources() PingSourceInformer {
 	return &pingSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SecretRotationSources returns a SecretRotationSourceInformer.
+func (v *version) SecretRotationSources() SecretRotationSourceInformer {
+	return &secretRotationSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SinkBindings returns a SinkBindingInformer.
 func (v *version) SinkBindings() SinkBindingInformer {
 	return &sinkBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// WhereaboutsSources returns a WhereaboutsSourceInformer.
+func (v *version) WhereaboutsSources() WhereaboutsSourceInformer {
+	return &whereaboutsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
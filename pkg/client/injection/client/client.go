@@ -1506,31 +1506,817 @@ func (w *wrapSourcesV1ApiServerSourceImpl) Watch(ctx context.Context, opts v1.Li
 	return nil, errors.New("NYI: Watch")
 }
 
+func (w *wrapSourcesV1) ArgoCDApplicationSetSources(namespace string) typedsourcesv1.ArgoCDApplicationSetSourceInterface {
+	return &wrapSourcesV1ArgoCDApplicationSetSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "argocdapplicationsetsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1ArgoCDApplicationSetSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.ArgoCDApplicationSetSourceInterface = (*wrapSourcesV1ArgoCDApplicationSetSourceImpl)(nil)
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) Create(ctx context.Context, in *sourcesv1.ArgoCDApplicationSetSource, opts v1.CreateOptions) (*sourcesv1.ArgoCDApplicationSetSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ArgoCDApplicationSetSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ArgoCDApplicationSetSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.ArgoCDApplicationSetSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ArgoCDApplicationSetSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.ArgoCDApplicationSetSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ArgoCDApplicationSetSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.ArgoCDApplicationSetSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ArgoCDApplicationSetSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) Update(ctx context.Context, in *sourcesv1.ArgoCDApplicationSetSource, opts v1.UpdateOptions) (*sourcesv1.ArgoCDApplicationSetSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ArgoCDApplicationSetSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ArgoCDApplicationSetSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.ArgoCDApplicationSetSource, opts v1.UpdateOptions) (*sourcesv1.ArgoCDApplicationSetSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ArgoCDApplicationSetSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ArgoCDApplicationSetSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ArgoCDApplicationSetSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1) BrokerStatusSources(namespace string) typedsourcesv1.BrokerStatusSourceInterface {
+	return &wrapSourcesV1BrokerStatusSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "brokerstatussources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1BrokerStatusSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.BrokerStatusSourceInterface = (*wrapSourcesV1BrokerStatusSourceImpl)(nil)
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) Create(ctx context.Context, in *sourcesv1.BrokerStatusSource, opts v1.CreateOptions) (*sourcesv1.BrokerStatusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "BrokerStatusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.BrokerStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.BrokerStatusSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.BrokerStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.BrokerStatusSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.BrokerStatusSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.BrokerStatusSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.BrokerStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) Update(ctx context.Context, in *sourcesv1.BrokerStatusSource, opts v1.UpdateOptions) (*sourcesv1.BrokerStatusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "BrokerStatusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.BrokerStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.BrokerStatusSource, opts v1.UpdateOptions) (*sourcesv1.BrokerStatusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "BrokerStatusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.BrokerStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1BrokerStatusSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1) ChannelStatusSources(namespace string) typedsourcesv1.ChannelStatusSourceInterface {
+	return &wrapSourcesV1ChannelStatusSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "channelstatussources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1ChannelStatusSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.ChannelStatusSourceInterface = (*wrapSourcesV1ChannelStatusSourceImpl)(nil)
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) Create(ctx context.Context, in *sourcesv1.ChannelStatusSource, opts v1.CreateOptions) (*sourcesv1.ChannelStatusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ChannelStatusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ChannelStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.ChannelStatusSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ChannelStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.ChannelStatusSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ChannelStatusSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.ChannelStatusSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ChannelStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) Update(ctx context.Context, in *sourcesv1.ChannelStatusSource, opts v1.UpdateOptions) (*sourcesv1.ChannelStatusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ChannelStatusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ChannelStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.ChannelStatusSource, opts v1.UpdateOptions) (*sourcesv1.ChannelStatusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ChannelStatusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ChannelStatusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ChannelStatusSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1) ClusterClaimSources(namespace string) typedsourcesv1.ClusterClaimSourceInterface {
+	return &wrapSourcesV1ClusterClaimSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "clusterclaimsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1ClusterClaimSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.ClusterClaimSourceInterface = (*wrapSourcesV1ClusterClaimSourceImpl)(nil)
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) Create(ctx context.Context, in *sourcesv1.ClusterClaimSource, opts v1.CreateOptions) (*sourcesv1.ClusterClaimSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ClusterClaimSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ClusterClaimSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.ClusterClaimSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ClusterClaimSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.ClusterClaimSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ClusterClaimSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.ClusterClaimSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ClusterClaimSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) Update(ctx context.Context, in *sourcesv1.ClusterClaimSource, opts v1.UpdateOptions) (*sourcesv1.ClusterClaimSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ClusterClaimSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ClusterClaimSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.ClusterClaimSource, opts v1.UpdateOptions) (*sourcesv1.ClusterClaimSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ClusterClaimSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ClusterClaimSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ClusterClaimSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
 func (w *wrapSourcesV1) ContainerSources(namespace string) typedsourcesv1.ContainerSourceInterface {
 	return &wrapSourcesV1ContainerSourceImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "sources.knative.dev",
 			Version:  "v1",
-			Resource: "containersources",
+			Resource: "containersources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1ContainerSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.ContainerSourceInterface = (*wrapSourcesV1ContainerSourceImpl)(nil)
+
+func (w *wrapSourcesV1ContainerSourceImpl) Create(ctx context.Context, in *sourcesv1.ContainerSource, opts v1.CreateOptions) (*sourcesv1.ContainerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ContainerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ContainerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.ContainerSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ContainerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.ContainerSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ContainerSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.ContainerSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ContainerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) Update(ctx context.Context, in *sourcesv1.ContainerSource, opts v1.UpdateOptions) (*sourcesv1.ContainerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ContainerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ContainerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.ContainerSource, opts v1.UpdateOptions) (*sourcesv1.ContainerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "ContainerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.ContainerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1ContainerSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1) NodePressureSources(namespace string) typedsourcesv1.NodePressureSourceInterface {
+	return &wrapSourcesV1NodePressureSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "nodepressuresources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1NodePressureSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.NodePressureSourceInterface = (*wrapSourcesV1NodePressureSourceImpl)(nil)
+
+func (w *wrapSourcesV1NodePressureSourceImpl) Create(ctx context.Context, in *sourcesv1.NodePressureSource, opts v1.CreateOptions) (*sourcesv1.NodePressureSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "NodePressureSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.NodePressureSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.NodePressureSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.NodePressureSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.NodePressureSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.NodePressureSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.NodePressureSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.NodePressureSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) Update(ctx context.Context, in *sourcesv1.NodePressureSource, opts v1.UpdateOptions) (*sourcesv1.NodePressureSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "NodePressureSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.NodePressureSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.NodePressureSource, opts v1.UpdateOptions) (*sourcesv1.NodePressureSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "NodePressureSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.NodePressureSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1NodePressureSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1) PermissionAuditSources(namespace string) typedsourcesv1.PermissionAuditSourceInterface {
+	return &wrapSourcesV1PermissionAuditSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "permissionauditsources",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1ContainerSourceImpl struct {
+type wrapSourcesV1PermissionAuditSourceImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1.ContainerSourceInterface = (*wrapSourcesV1ContainerSourceImpl)(nil)
+var _ typedsourcesv1.PermissionAuditSourceInterface = (*wrapSourcesV1PermissionAuditSourceImpl)(nil)
 
-func (w *wrapSourcesV1ContainerSourceImpl) Create(ctx context.Context, in *sourcesv1.ContainerSource, opts v1.CreateOptions) (*sourcesv1.ContainerSource, error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) Create(ctx context.Context, in *sourcesv1.PermissionAuditSource, opts v1.CreateOptions) (*sourcesv1.PermissionAuditSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.knative.dev",
 		Version: "v1",
-		Kind:    "ContainerSource",
+		Kind:    "PermissionAuditSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1540,62 +2326,62 @@ func (w *wrapSourcesV1ContainerSourceImpl) Create(ctx context.Context, in *sourc
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1.ContainerSource{}
+	out := &sourcesv1.PermissionAuditSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.ContainerSource, error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.PermissionAuditSource, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1.ContainerSource{}
+	out := &sourcesv1.PermissionAuditSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.ContainerSourceList, error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.PermissionAuditSourceList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1.ContainerSourceList{}
+	out := &sourcesv1.PermissionAuditSourceList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.ContainerSource, err error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.PermissionAuditSource, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1.ContainerSource{}
+	out := &sourcesv1.PermissionAuditSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) Update(ctx context.Context, in *sourcesv1.ContainerSource, opts v1.UpdateOptions) (*sourcesv1.ContainerSource, error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) Update(ctx context.Context, in *sourcesv1.PermissionAuditSource, opts v1.UpdateOptions) (*sourcesv1.PermissionAuditSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.knative.dev",
 		Version: "v1",
-		Kind:    "ContainerSource",
+		Kind:    "PermissionAuditSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1605,18 +2391,18 @@ func (w *wrapSourcesV1ContainerSourceImpl) Update(ctx context.Context, in *sourc
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1.ContainerSource{}
+	out := &sourcesv1.PermissionAuditSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.ContainerSource, opts v1.UpdateOptions) (*sourcesv1.ContainerSource, error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.PermissionAuditSource, opts v1.UpdateOptions) (*sourcesv1.PermissionAuditSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.knative.dev",
 		Version: "v1",
-		Kind:    "ContainerSource",
+		Kind:    "PermissionAuditSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1626,14 +2412,14 @@ func (w *wrapSourcesV1ContainerSourceImpl) UpdateStatus(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1.ContainerSource{}
+	out := &sourcesv1.PermissionAuditSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1ContainerSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapSourcesV1PermissionAuditSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
@@ -1768,6 +2554,137 @@ func (w *wrapSourcesV1PingSourceImpl) Watch(ctx context.Context, opts v1.ListOpt
 	return nil, errors.New("NYI: Watch")
 }
 
+func (w *wrapSourcesV1) SecretRotationSources(namespace string) typedsourcesv1.SecretRotationSourceInterface {
+	return &wrapSourcesV1SecretRotationSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "secretrotationsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1SecretRotationSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.SecretRotationSourceInterface = (*wrapSourcesV1SecretRotationSourceImpl)(nil)
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) Create(ctx context.Context, in *sourcesv1.SecretRotationSource, opts v1.CreateOptions) (*sourcesv1.SecretRotationSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "SecretRotationSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.SecretRotationSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.SecretRotationSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.SecretRotationSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.SecretRotationSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.SecretRotationSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.SecretRotationSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.SecretRotationSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) Update(ctx context.Context, in *sourcesv1.SecretRotationSource, opts v1.UpdateOptions) (*sourcesv1.SecretRotationSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "SecretRotationSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.SecretRotationSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.SecretRotationSource, opts v1.UpdateOptions) (*sourcesv1.SecretRotationSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "SecretRotationSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.SecretRotationSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1SecretRotationSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
 func (w *wrapSourcesV1) SinkBindings(namespace string) typedsourcesv1.SinkBindingInterface {
 	return &wrapSourcesV1SinkBindingImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
@@ -1898,3 +2815,134 @@ func (w *wrapSourcesV1SinkBindingImpl) UpdateStatus(ctx context.Context, in *sou
 func (w *wrapSourcesV1SinkBindingImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
+
+func (w *wrapSourcesV1) WhereaboutsSources(namespace string) typedsourcesv1.WhereaboutsSourceInterface {
+	return &wrapSourcesV1WhereaboutsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.knative.dev",
+			Version:  "v1",
+			Resource: "whereaboutssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1WhereaboutsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1.WhereaboutsSourceInterface = (*wrapSourcesV1WhereaboutsSourceImpl)(nil)
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) Create(ctx context.Context, in *sourcesv1.WhereaboutsSource, opts v1.CreateOptions) (*sourcesv1.WhereaboutsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "WhereaboutsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.WhereaboutsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1.WhereaboutsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.WhereaboutsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1.WhereaboutsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.WhereaboutsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1.WhereaboutsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.WhereaboutsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) Update(ctx context.Context, in *sourcesv1.WhereaboutsSource, opts v1.UpdateOptions) (*sourcesv1.WhereaboutsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "WhereaboutsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.WhereaboutsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1.WhereaboutsSource, opts v1.UpdateOptions) (*sourcesv1.WhereaboutsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.knative.dev",
+		Version: "v1",
+		Kind:    "WhereaboutsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1.WhereaboutsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1WhereaboutsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
@@ -26,6 +26,38 @@ type ApiServerSourceListerExpansion interface{}
 // ApiServerSourceNamespaceLister.
 type ApiServerSourceNamespaceListerExpansion interface{}
 
+// ArgoCDApplicationSetSourceListerExpansion allows custom methods to be added to
+// ArgoCDApplicationSetSourceLister.
+type ArgoCDApplicationSetSourceListerExpansion interface{}
+
+// ArgoCDApplicationSetSourceNamespaceListerExpansion allows custom methods to be added to
+// ArgoCDApplicationSetSourceNamespaceLister.
+type ArgoCDApplicationSetSourceNamespaceListerExpansion interface{}
+
+// BrokerStatusSourceListerExpansion allows custom methods to be added to
+// BrokerStatusSourceLister.
+type BrokerStatusSourceListerExpansion interface{}
+
+// BrokerStatusSourceNamespaceListerExpansion allows custom methods to be added to
+// BrokerStatusSourceNamespaceLister.
+type BrokerStatusSourceNamespaceListerExpansion interface{}
+
+// ChannelStatusSourceListerExpansion allows custom methods to be added to
+// ChannelStatusSourceLister.
+type ChannelStatusSourceListerExpansion interface{}
+
+// ChannelStatusSourceNamespaceListerExpansion allows custom methods to be added to
+// ChannelStatusSourceNamespaceLister.
+type ChannelStatusSourceNamespaceListerExpansion interface{}
+
+// ClusterClaimSourceListerExpansion allows custom methods to be added to
+// ClusterClaimSourceLister.
+type ClusterClaimSourceListerExpansion interface{}
+
+// ClusterClaimSourceNamespaceListerExpansion allows custom methods to be added to
+// ClusterClaimSourceNamespaceLister.
+type ClusterClaimSourceNamespaceListerExpansion interface{}
+
 // ContainerSourceListerExpansion allows custom methods to be added to
 // ContainerSourceLister.
 type ContainerSourceListerExpansion interface{}
@@ -34,6 +66,22 @@ type ContainerSourceListerExpansion interface{}
 // ContainerSourceNamespaceLister.
 type ContainerSourceNamespaceListerExpansion interface{}
 
+// NodePressureSourceListerExpansion allows custom methods to be added to
+// NodePressureSourceLister.
+type NodePressureSourceListerExpansion interface{}
+
+// NodePressureSourceNamespaceListerExpansion allows custom methods to be added to
+// NodePressureSourceNamespaceLister.
+type NodePressureSourceNamespaceListerExpansion interface{}
+
+// PermissionAuditSourceListerExpansion allows custom methods to be added to
+// PermissionAuditSourceLister.
+type PermissionAuditSourceListerExpansion interface{}
+
+// PermissionAuditSourceNamespaceListerExpansion allows custom methods to be added to
+// PermissionAuditSourceNamespaceLister.
+type PermissionAuditSourceNamespaceListerExpansion interface{}
+
 // PingSourceListerExpansion allows custom methods to be added to
 // PingSourceLister.
 type PingSourceListerExpansion interface{}
@@ -42,6 +90,14 @@ type PingSourceListerExpansion interface{}
 // PingSourceNamespaceLister.
 type PingSourceNamespaceListerExpansion interface{}
 
+// SecretRotationSourceListerExpansion allows custom methods to be added to
+// SecretRotationSourceLister.
+type SecretRotationSourceListerExpansion interface{}
+
+// SecretRotationSourceNamespaceListerExpansion allows custom methods to be added to
+// SecretRotationSourceNamespaceLister.
+type SecretRotationSourceNamespaceListerExpansion interface{}
+
 // SinkBindingListerExpansion allows custom methods to be added to
 // SinkBindingLister.
 type SinkBindingListerExpansion interface{}
@@ -49,3 +105,11 @@ type SinkBindingListerExpansion interface{}
 // SinkBindingNamespaceListerExpansion allows custom methods to be added to
 // SinkBindingNamespaceLister.
 type SinkBindingNamespaceListerExpansion interface{}
+
+// WhereaboutsSourceListerExpansion allows custom methods to be added to
+// WhereaboutsSourceLister.
+type WhereaboutsSourceListerExpansion interface{}
+
+// WhereaboutsSourceNamespaceListerExpansion allows custom methods to be added to
+// WhereaboutsSourceNamespaceLister.
+type WhereaboutsSourceNamespaceListerExpansion interface{}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriptionsapi
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/eventfilter"
+)
+
+// CreateSubscriptionsAPIFilters returns an event filter which passes if all
+// the given filters pass.
+func CreateSubscriptionsAPIFilters(ctx context.Context, filters []eventingv1.SubscriptionsAPIFilter) eventfilter.Filter {
+	return NewAllFilter(MaterializeFiltersList(ctx, filters)...)
+}
+
+// MaterializeSubscriptionsAPIFilter returns the event filter of filter, or nil
+// if filter is invalid.
+func MaterializeSubscriptionsAPIFilter(ctx context.Context, filter eventingv1.SubscriptionsAPIFilter) eventfilter.Filter {
+	var materializedFilter eventfilter.Filter
+	var err error
+	switch {
+	case len(filter.Exact) > 0:
+		// The webhook validates that this map has only a single key:value pair.
+		materializedFilter, err = NewExactFilter(filter.Exact)
+		if err != nil {
+			logging.FromContext(ctx).Debugw("Invalid exact expression", zap.Any("filters", filter.Exact), zap.Error(err))
+			return nil
+		}
+	case len(filter.Prefix) > 0:
+		// The webhook validates that this map has only a single key:value pair.
+		materializedFilter, err = NewPrefixFilter(filter.Prefix)
+		if err != nil {
+			logging.FromContext(ctx).Debugw("Invalid prefix expression", zap.Any("filters", filter.Exact), zap.Error(err))
+			return nil
+		}
+	case len(filter.Suffix) > 0:
+		// The webhook validates that this map has only a single key:value pair.
+		materializedFilter, err = NewSuffixFilter(filter.Suffix)
+		if err != nil {
+			logging.FromContext(ctx).Debugw("Invalid suffix expression", zap.Any("filters", filter.Exact), zap.Error(err))
+			return nil
+		}
+	case len(filter.All) > 0:
+		materializedFilter = NewAllFilter(MaterializeFiltersList(ctx, filter.All)...)
+	case len(filter.Any) > 0:
+		materializedFilter = NewAnyFilter(MaterializeFiltersList(ctx, filter.Any)...)
+	case filter.Not != nil:
+		materializedFilter = NewNotFilter(MaterializeSubscriptionsAPIFilter(ctx, *filter.Not))
+	case filter.CESQL != "":
+		if materializedFilter, err = NewCESQLFilter(filter.CESQL); err != nil {
+			// This is weird, CESQL expression should be validated when Trigger's are created.
+			logging.FromContext(ctx).Debugw("Found an Invalid CE SQL expression", zap.String("expression", filter.CESQL))
+			return nil
+		}
+	}
+	return materializedFilter
+}
+
+// MaterializeFiltersList returns the event filters of filters, skipping the
+// invalid ones.
+func MaterializeFiltersList(ctx context.Context, filters []eventingv1.SubscriptionsAPIFilter) []eventfilter.Filter {
+	materializedFilters := make([]eventfilter.Filter, 0, len(filters))
+	for _, f := range filters {
+		f := MaterializeSubscriptionsAPIFilter(ctx, f)
+		if f == nil {
+			logging.FromContext(ctx).Warnw("Failed to parse filter. Skipping filter.", zap.Any("filter", f))
+			continue
+		}
+		materializedFilters = append(materializedFilters, f)
+	}
+	return materializedFilters
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriptionsapi
+
+import (
+	"context"
+	"testing"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/eventfilter"
+)
+
+func TestCreateSubscriptionsAPIFilters(t *testing.T) {
+	tests := map[string]struct {
+		filters []eventingv1.SubscriptionsAPIFilter
+		want    eventfilter.FilterResult
+	}{
+		"No filters": {
+			want: eventfilter.NoFilter,
+		},
+		"Exact pass": {
+			filters: []eventingv1.SubscriptionsAPIFilter{{
+				Exact: map[string]string{"type": eventType},
+			}},
+			want: eventfilter.PassFilter,
+		},
+		"Prefix and suffix fail": {
+			filters: []eventingv1.SubscriptionsAPIFilter{{
+				Prefix: map[string]string{"type": eventType[:1]},
+			}, {
+				Suffix: map[string]string{"source": "other"},
+			}},
+			want: eventfilter.FailFilter,
+		},
+		"Not CESQL fail": {
+			filters: []eventingv1.SubscriptionsAPIFilter{{
+				Not: &eventingv1.SubscriptionsAPIFilter{
+					CESQL: "type = '" + eventType + "'",
+				},
+			}},
+			want: eventfilter.FailFilter,
+		},
+		"Any pass": {
+			filters: []eventingv1.SubscriptionsAPIFilter{{
+				Any: []eventingv1.SubscriptionsAPIFilter{{
+					Exact: map[string]string{"type": "other"},
+				}, {
+					Exact: map[string]string{"source": eventSource},
+				}},
+			}},
+			want: eventfilter.PassFilter,
+		},
+		"Invalid filter skipped": {
+			filters: []eventingv1.SubscriptionsAPIFilter{{
+				Exact: map[string]string{"type": ""},
+			}, {
+				Exact: map[string]string{"type": eventType},
+			}},
+			want: eventfilter.PassFilter,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := makeEvent()
+			f := CreateSubscriptionsAPIFilters(context.TODO(), tc.filters)
+			if got := f.Filter(context.TODO(), *e); got != tc.want {
+				t.Errorf("Filter() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -48,6 +48,16 @@ const (
 	// LabelEventSource is the label for the name of the event source.
 	LabelEventSource = "event_source"
 
+	// LabelSink is the label for the URI of the sink the events are sent to.
+	LabelSink = "sink"
+
+	// LabelResource is the label for the group, version and resource of the
+	// Kubernetes resources the events are about.
+	LabelResource = "resource"
+
+	// LabelReason is the label for the reason the events are dropped for.
+	LabelReason = "reason"
+
 	// LabelFilterType is the label for the Trigger filter attribute "type".
 	LabelFilterType = "filter_type"
 
@@ -0,0 +1,290 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	eventingmetrics "knative.dev/eventing/pkg/metrics"
+	"knative.dev/pkg/metrics"
+)
+
+// The reasons the ApiServerSource events are dropped for.
+const (
+	DropReasonFiltered       = "filtered"
+	DropReasonDuplicate      = "duplicate"
+	DropReasonOwnerDuplicate = "owner_duplicate"
+	DropReasonRateLimited    = "rate_limited"
+	DropReasonUndeliverable  = "undeliverable"
+	DropReasonShutdown       = "shutdown"
+	DropReasonPaused         = "paused"
+)
+
+var (
+	// apiServerGeneratedCountM is a counter which records the number of
+	// events the ApiServerSource generates from the changes of the resources.
+	apiServerGeneratedCountM = stats.Int64(
+		"apiserver_generated_event_count",
+		"Number of events generated from the changes of the resources",
+		stats.UnitDimensionless,
+	)
+
+	// apiServerDeliveredCountM is a counter which records the number of
+	// deliveries of the events to the sinks, by response code.
+	apiServerDeliveredCountM = stats.Int64(
+		"apiserver_delivered_event_count",
+		"Number of events delivered to the sinks",
+		stats.UnitDimensionless,
+	)
+
+	// apiServerRetriedCountM is a counter which records the number of
+	// retries of the deliveries of the events to the sinks.
+	apiServerRetriedCountM = stats.Int64(
+		"apiserver_retried_event_count",
+		"Number of retries of the deliveries of the events to the sinks",
+		stats.UnitDimensionless,
+	)
+
+	// apiServerDroppedCountM is a counter which records the number of events
+	// which are not delivered, by reason.
+	apiServerDroppedCountM = stats.Int64(
+		"apiserver_dropped_event_count",
+		"Number of events dropped",
+		stats.UnitDimensionless,
+	)
+
+	// apiServerLatencyInMsecM records the time from the generation of the
+	// events to the response of the sinks, in milliseconds.
+	apiServerLatencyInMsecM = stats.Float64(
+		"apiserver_event_delivery_latencies",
+		"The time from the generation of the events to the response of the sinks",
+		stats.UnitMilliseconds,
+	)
+
+	// apiServerDispatchQueueDepthM records the number of events queued for
+	// the workers they are dispatched by.
+	apiServerDispatchQueueDepthM = stats.Int64(
+		"apiserver_dispatch_queue_depth",
+		"Number of events queued for the workers they are dispatched by",
+		stats.UnitDimensionless,
+	)
+
+	// apiServerWorkerUtilizationM records the ratio of the workers busy
+	// dispatching events.
+	apiServerWorkerUtilizationM = stats.Float64(
+		"apiserver_dispatch_worker_utilization",
+		"Ratio of the workers busy dispatching events",
+		stats.UnitDimensionless,
+	)
+
+	resourceKey = tag.MustNewKey(eventingmetrics.LabelResource)
+	reasonKey   = tag.MustNewKey(eventingmetrics.LabelReason)
+)
+
+// ApiServerReportArgs defines the arguments for reporting the metrics of the
+// ApiServerSource events.
+type ApiServerReportArgs struct {
+	Namespace string
+	Name      string
+	// Resource is the group, version and resource of the Kubernetes
+	// resources the event is about, e.g. `apps/v1/deployments`.
+	Resource  string
+	EventType string
+	// Sink is the URI of the sink the event is delivered to.
+	Sink string
+}
+
+func init() {
+	registerApiServer()
+}
+
+// ApiServerStatsReporter defines the interface for sending the metrics of
+// the ApiServerSource events, labeled by resource and event type.
+type ApiServerStatsReporter interface {
+	// ReportGenerated captures an event generated from a change of a
+	// resource.
+	ReportGenerated(args *ApiServerReportArgs) error
+	// ReportDelivered captures a delivery of an event to a sink, which
+	// responded with responseCode after latency since the event was
+	// generated. The responseCode is 0 when the sink did not respond.
+	ReportDelivered(args *ApiServerReportArgs, responseCode int, latency time.Duration) error
+	// ReportRetried captures the retries of a delivery of an event.
+	ReportRetried(args *ApiServerReportArgs, retries int) error
+	// ReportDropped captures an event which is not delivered for reason.
+	ReportDropped(args *ApiServerReportArgs, reason string) error
+}
+
+// ApiServerDispatchStatsReporter defines the interface for sending the
+// metrics of the workers the ApiServerSource events are dispatched by,
+// labeled by source.
+type ApiServerDispatchStatsReporter interface {
+	// ReportDispatch captures the number of events queued for the workers,
+	// and the number of the workers busy dispatching events out of workers.
+	ReportDispatch(args *ApiServerReportArgs, queued, busy, workers int) error
+}
+
+var _ ApiServerDispatchStatsReporter = (*apiServerReporter)(nil)
+
+var _ ApiServerStatsReporter = (*apiServerReporter)(nil)
+
+type apiServerReporter struct{}
+
+// NewApiServerStatsReporter creates a reporter that collects and reports the
+// metrics of the ApiServerSource events.
+func NewApiServerStatsReporter() ApiServerStatsReporter {
+	return &apiServerReporter{}
+}
+
+// NewApiServerDispatchStatsReporter creates a reporter that collects and
+// reports the metrics of the workers the ApiServerSource events are
+// dispatched by.
+func NewApiServerDispatchStatsReporter() ApiServerDispatchStatsReporter {
+	return &apiServerReporter{}
+}
+
+func (r *apiServerReporter) ReportGenerated(args *ApiServerReportArgs) error {
+	ctx, err := r.generateTag(args)
+	if err != nil {
+		return err
+	}
+	metrics.Record(ctx, apiServerGeneratedCountM.M(1))
+	return nil
+}
+
+func (r *apiServerReporter) ReportDelivered(args *ApiServerReportArgs, responseCode int, latency time.Duration) error {
+	ctx, err := r.generateTag(args,
+		tag.Insert(sinkKey, args.Sink),
+		metrics.MaybeInsertIntTag(responseCodeKey, responseCode, responseCode > 0),
+		metrics.MaybeInsertStringTag(responseCodeClassKey, metrics.ResponseCodeClass(responseCode), responseCode > 0))
+	if err != nil {
+		return err
+	}
+	metrics.Record(ctx, apiServerDeliveredCountM.M(1))
+	// convert time.Duration in nanoseconds to milliseconds.
+	metrics.Record(ctx, apiServerLatencyInMsecM.M(float64(latency/time.Millisecond)))
+	return nil
+}
+
+func (r *apiServerReporter) ReportRetried(args *ApiServerReportArgs, retries int) error {
+	if retries <= 0 {
+		return nil
+	}
+	ctx, err := r.generateTag(args, tag.Insert(sinkKey, args.Sink))
+	if err != nil {
+		return err
+	}
+	metrics.Record(ctx, apiServerRetriedCountM.M(int64(retries)))
+	return nil
+}
+
+func (r *apiServerReporter) ReportDropped(args *ApiServerReportArgs, reason string) error {
+	ctx, err := r.generateTag(args, tag.Insert(reasonKey, reason))
+	if err != nil {
+		return err
+	}
+	metrics.Record(ctx, apiServerDroppedCountM.M(1))
+	return nil
+}
+
+func (r *apiServerReporter) ReportDispatch(args *ApiServerReportArgs, queued, busy, workers int) error {
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(namespaceKey, args.Namespace),
+		tag.Insert(sourceNameKey, args.Name))
+	if err != nil {
+		return err
+	}
+	metrics.Record(ctx, apiServerDispatchQueueDepthM.M(int64(queued)))
+	if workers > 0 {
+		metrics.Record(ctx, apiServerWorkerUtilizationM.M(float64(busy)/float64(workers)))
+	}
+	return nil
+}
+
+func (r *apiServerReporter) generateTag(args *ApiServerReportArgs, mutators ...tag.Mutator) (context.Context, error) {
+	return tag.New(
+		context.Background(),
+		append([]tag.Mutator{
+			tag.Insert(namespaceKey, args.Namespace),
+			tag.Insert(sourceNameKey, args.Name),
+			tag.Insert(resourceKey, args.Resource),
+			tag.Insert(eventTypeKey, args.EventType),
+		}, mutators...)...)
+}
+
+func registerApiServer() {
+	tagKeys := []tag.Key{
+		namespaceKey,
+		sourceNameKey,
+		resourceKey,
+		eventTypeKey}
+	deliveryTagKeys := append(tagKeys[:len(tagKeys):len(tagKeys)],
+		sinkKey,
+		responseCodeKey,
+		responseCodeClassKey)
+
+	if err := view.Register(
+		&view.View{
+			Description: apiServerGeneratedCountM.Description(),
+			Measure:     apiServerGeneratedCountM,
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
+		&view.View{
+			Description: apiServerDeliveredCountM.Description(),
+			Measure:     apiServerDeliveredCountM,
+			Aggregation: view.Count(),
+			TagKeys:     deliveryTagKeys,
+		},
+		&view.View{
+			Description: apiServerRetriedCountM.Description(),
+			Measure:     apiServerRetriedCountM,
+			Aggregation: view.Sum(),
+			TagKeys:     append(tagKeys[:len(tagKeys):len(tagKeys)], sinkKey),
+		},
+		&view.View{
+			Description: apiServerDroppedCountM.Description(),
+			Measure:     apiServerDroppedCountM,
+			Aggregation: view.Count(),
+			TagKeys:     append(tagKeys[:len(tagKeys):len(tagKeys)], reasonKey),
+		},
+		&view.View{
+			Description: apiServerLatencyInMsecM.Description(),
+			Measure:     apiServerLatencyInMsecM,
+			Aggregation: view.Distribution(metrics.Buckets125(1, 10000)...), // 1, 2, 5, 10, 20, 50, 100, 500, 1000, 5000, 10000
+			TagKeys:     deliveryTagKeys,
+		},
+		&view.View{
+			Description: apiServerDispatchQueueDepthM.Description(),
+			Measure:     apiServerDispatchQueueDepthM,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{namespaceKey, sourceNameKey},
+		},
+		&view.View{
+			Description: apiServerWorkerUtilizationM.Description(),
+			Measure:     apiServerWorkerUtilizationM,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{namespaceKey, sourceNameKey},
+		},
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"knative.dev/eventing/pkg/metrics"
+	"knative.dev/pkg/metrics/metricstest"
+	_ "knative.dev/pkg/metrics/testing"
+)
+
+func TestApiServerStatsReporter(t *testing.T) {
+	resetApiServerMetrics()
+
+	args := &ApiServerReportArgs{
+		Namespace: "testns",
+		Name:      "testsource",
+		Resource:  "apps/v1/deployments",
+		EventType: "dev.knative.apiserver.resource.update",
+		Sink:      "http://sink.example.com",
+	}
+	r := NewApiServerStatsReporter()
+
+	tags := map[string]string{
+		metrics.LabelNamespaceName: "testns",
+		metrics.LabelName:          "testsource",
+		metrics.LabelResource:      "apps/v1/deployments",
+		metrics.LabelEventType:     "dev.knative.apiserver.resource.update",
+	}
+	with := func(extra map[string]string) map[string]string {
+		all := make(map[string]string, len(tags)+len(extra))
+		for k, v := range tags {
+			all[k] = v
+		}
+		for k, v := range extra {
+			all[k] = v
+		}
+		return all
+	}
+
+	expectSuccess(t, func() error {
+		return r.ReportGenerated(args)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportDelivered(args, http.StatusAccepted, 20*time.Millisecond)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportDelivered(args, http.StatusAccepted, 40*time.Millisecond)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportRetried(args, 3)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportRetried(args, 0)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportDropped(args, DropReasonFiltered)
+	})
+
+	delivered := with(map[string]string{
+		metrics.LabelSink:              "http://sink.example.com",
+		metrics.LabelResponseCode:      "202",
+		metrics.LabelResponseCodeClass: "2xx",
+	})
+	metricstest.CheckCountData(t, "apiserver_generated_event_count", tags, 1)
+	metricstest.CheckCountData(t, "apiserver_delivered_event_count", delivered, 2)
+	metricstest.CheckDistributionData(t, "apiserver_event_delivery_latencies", delivered, 2, 20, 40)
+	metricstest.CheckSumData(t, "apiserver_retried_event_count", with(map[string]string{metrics.LabelSink: "http://sink.example.com"}), 3)
+	metricstest.CheckCountData(t, "apiserver_dropped_event_count", with(map[string]string{metrics.LabelReason: DropReasonFiltered}), 1)
+	metricstest.CheckStatsNotReported(t, "event_count", "retry_event_count")
+}
+
+func TestApiServerStatsReporterNoResponse(t *testing.T) {
+	resetApiServerMetrics()
+
+	args := &ApiServerReportArgs{
+		Namespace: "testns",
+		Name:      "testsource",
+		Resource:  "v1/pods",
+		EventType: "dev.knative.apiserver.resource.add",
+		Sink:      "http://sink.example.com",
+	}
+	expectSuccess(t, func() error {
+		return NewApiServerStatsReporter().ReportDelivered(args, 0, time.Second)
+	})
+	metricstest.CheckCountData(t, "apiserver_delivered_event_count", map[string]string{
+		metrics.LabelNamespaceName: "testns",
+		metrics.LabelName:          "testsource",
+		metrics.LabelResource:      "v1/pods",
+		metrics.LabelEventType:     "dev.knative.apiserver.resource.add",
+		metrics.LabelSink:          "http://sink.example.com",
+	}, 1)
+}
+
+func TestApiServerDispatchStatsReporter(t *testing.T) {
+	resetApiServerMetrics()
+
+	args := &ApiServerReportArgs{
+		Namespace: "testns",
+		Name:      "testsource",
+	}
+	r := NewApiServerDispatchStatsReporter()
+	expectSuccess(t, func() error {
+		return r.ReportDispatch(args, 10, 1, 4)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportDispatch(args, 3, 2, 4)
+	})
+
+	tags := map[string]string{
+		metrics.LabelNamespaceName: "testns",
+		metrics.LabelName:          "testsource",
+	}
+	metricstest.CheckLastValueData(t, "apiserver_dispatch_queue_depth", tags, 3)
+	metricstest.CheckLastValueData(t, "apiserver_dispatch_worker_utilization", tags, 0.5)
+}
+
+func resetApiServerMetrics() {
+	metricstest.Unregister(
+		"apiserver_generated_event_count",
+		"apiserver_delivered_event_count",
+		"apiserver_retried_event_count",
+		"apiserver_dropped_event_count",
+		"apiserver_event_delivery_latencies",
+		"apiserver_dispatch_queue_depth",
+		"apiserver_dispatch_worker_utilization")
+	registerApiServer()
+}
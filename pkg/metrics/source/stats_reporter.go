@@ -41,6 +41,34 @@ var (
 		"Number of retry events sent",
 		stats.UnitDimensionless,
 	)
+
+	// deadLetterEventCountM is a counter which records the number of events sent by the source to its dead letter sink.
+	deadLetterEventCountM = stats.Int64(
+		"dead_letter_event_count",
+		"Number of events sent to the dead letter sink",
+		stats.UnitDimensionless,
+	)
+
+	// deadLetterRetryEventCountM is a counter which records the number of events sent by the source to its dead letter sink in retries.
+	deadLetterRetryEventCountM = stats.Int64(
+		"dead_letter_retry_event_count",
+		"Number of retry events sent to the dead letter sink",
+		stats.UnitDimensionless,
+	)
+
+	// duplicateEventCountM is a counter which records the number of duplicate events suppressed by the source.
+	duplicateEventCountM = stats.Int64(
+		"duplicate_event_count",
+		"Number of duplicate events suppressed",
+		stats.UnitDimensionless,
+	)
+
+	// rateLimitedEventCountM is a counter which records the number of events dropped by the source above its rate limit.
+	rateLimitedEventCountM = stats.Int64(
+		"rate_limited_event_count",
+		"Number of events dropped above the rate limit",
+		stats.UnitDimensionless,
+	)
 	// Create the tag keys that will be used to add tags to our measurements.
 	// Tag keys must conform to the restrictions described in
 	// go.opencensus.io/tag/validate.go. Currently those restrictions are:
@@ -55,6 +83,7 @@ var (
 	responseCodeClassKey   = tag.MustNewKey(eventingmetrics.LabelResponseCodeClass)
 	responseError          = tag.MustNewKey(eventingmetrics.LabelResponseError)
 	responseTimeout        = tag.MustNewKey(eventingmetrics.LabelResponseTimeout)
+	sinkKey                = tag.MustNewKey(eventingmetrics.LabelSink)
 )
 
 // ReportArgs defines the arguments for reporting metrics.
@@ -84,17 +113,54 @@ var _ StatsReporter = (*reporter)(nil)
 // reporter holds cached metric objects to report source metrics.
 type reporter struct {
 	ctx context.Context
+
+	eventCount      *stats.Int64Measure
+	retryEventCount *stats.Int64Measure
 }
 
 // NewStatsReporter creates a reporter that collects and reports source metrics.
 func NewStatsReporter() (StatsReporter, error) {
+	return newStatsReporter(eventCountM, retryEventCountM)
+}
+
+// NewDeadLetterStatsReporter creates a reporter that collects and reports the
+// metrics of the events a source sends to its dead letter sink.
+func NewDeadLetterStatsReporter() (StatsReporter, error) {
+	return newStatsReporter(deadLetterEventCountM, deadLetterRetryEventCountM)
+}
+
+// NewSinkStatsReporter creates a reporter that collects and reports the
+// metrics of the events a source sends to one of its additional sinks, tagged
+// with the URI of the sink.
+func NewSinkStatsReporter(sink string) (StatsReporter, error) {
+	return newStatsReporter(eventCountM, retryEventCountM, tag.Insert(sinkKey, sink))
+}
+
+// NewDuplicateStatsReporter creates a reporter that collects and reports the
+// metrics of the duplicate events a source suppresses rather than sending
+// them. The duplicate events are reported without a response code, and are
+// never retried.
+func NewDuplicateStatsReporter() (StatsReporter, error) {
+	return newStatsReporter(duplicateEventCountM, duplicateEventCountM)
+}
+
+// NewRateLimitedStatsReporter creates a reporter that collects and reports
+// the metrics of the events a source drops above its rate limit rather than
+// sending them. The events are reported without a response code, and are
+// never retried.
+func NewRateLimitedStatsReporter() (StatsReporter, error) {
+	return newStatsReporter(rateLimitedEventCountM, rateLimitedEventCountM)
+}
+
+func newStatsReporter(eventCount, retryEventCount *stats.Int64Measure, mutators ...tag.Mutator) (StatsReporter, error) {
 	ctx, err := tag.New(
 		context.Background(),
+		mutators...,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return &reporter{ctx: ctx}, nil
+	return &reporter{ctx: ctx, eventCount: eventCount, retryEventCount: retryEventCount}, nil
 }
 
 func (r *reporter) ReportEventCount(args *ReportArgs, responseCode int) error {
@@ -102,7 +168,7 @@ func (r *reporter) ReportEventCount(args *ReportArgs, responseCode int) error {
 	if err != nil {
 		return err
 	}
-	metrics.Record(ctx, eventCountM.M(1))
+	metrics.Record(ctx, r.eventCount.M(1))
 	return nil
 }
 
@@ -111,7 +177,7 @@ func (r *reporter) ReportRetryEventCount(args *ReportArgs, responseCode int) err
 	if err != nil {
 		return err
 	}
-	metrics.Record(ctx, retryEventCountM.M(1))
+	metrics.Record(ctx, r.retryEventCount.M(1))
 	return nil
 }
 
@@ -139,7 +205,8 @@ func register() {
 		responseCodeKey,
 		responseCodeClassKey,
 		responseError,
-		responseTimeout}
+		responseTimeout,
+		sinkKey}
 
 	// Create view to see our measurements.
 	if err := view.Register(
@@ -155,6 +222,30 @@ func register() {
 			Aggregation: view.Count(),
 			TagKeys:     tagKeys,
 		},
+		&view.View{
+			Description: deadLetterEventCountM.Description(),
+			Measure:     deadLetterEventCountM,
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
+		&view.View{
+			Description: deadLetterRetryEventCountM.Description(),
+			Measure:     deadLetterRetryEventCountM,
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
+		&view.View{
+			Description: duplicateEventCountM.Description(),
+			Measure:     duplicateEventCountM,
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
+		&view.View{
+			Description: rateLimitedEventCountM.Description(),
+			Measure:     rateLimitedEventCountM,
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
 	); err != nil {
 		panic(err)
 	}
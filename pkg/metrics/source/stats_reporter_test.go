@@ -78,6 +78,148 @@ func TestStatsReporter(t *testing.T) {
 	metricstest.CheckCountData(t, "retry_event_count", retryWantTags, 2)
 }
 
+func TestDeadLetterStatsReporter(t *testing.T) {
+	setup()
+
+	args := &ReportArgs{
+		Namespace:     "testns",
+		EventType:     "dev.knative.event",
+		EventSource:   "unit-test",
+		Name:          "testsource",
+		ResourceGroup: "testresourcegroup",
+	}
+
+	r, err := NewDeadLetterStatsReporter()
+	if err != nil {
+		t.Fatal("Failed to create a new reporter:", err)
+	}
+
+	wantTags := map[string]string{
+		metrics.LabelNamespaceName:     "testns",
+		metrics.LabelEventType:         "dev.knative.event",
+		metrics.LabelEventSource:       "unit-test",
+		metrics.LabelName:              "testsource",
+		metrics.LabelResourceGroup:     "testresourcegroup",
+		metrics.LabelResponseCode:      "202",
+		metrics.LabelResponseCodeClass: "2xx",
+	}
+
+	retryWantTags := map[string]string{
+		metrics.LabelNamespaceName:     "testns",
+		metrics.LabelEventType:         "dev.knative.event",
+		metrics.LabelEventSource:       "unit-test",
+		metrics.LabelName:              "testsource",
+		metrics.LabelResourceGroup:     "testresourcegroup",
+		metrics.LabelResponseCode:      "503",
+		metrics.LabelResponseCodeClass: "5xx",
+	}
+
+	expectSuccess(t, func() error {
+		return r.ReportEventCount(args, http.StatusAccepted)
+	})
+	expectSuccess(t, func() error {
+		return r.ReportRetryEventCount(args, http.StatusServiceUnavailable)
+	})
+	metricstest.CheckCountData(t, "dead_letter_event_count", wantTags, 1)
+	metricstest.CheckCountData(t, "dead_letter_retry_event_count", retryWantTags, 1)
+	metricstest.CheckStatsNotReported(t, "event_count", "retry_event_count")
+}
+
+func TestSinkStatsReporter(t *testing.T) {
+	setup()
+
+	args := &ReportArgs{
+		Namespace:     "testns",
+		EventType:     "dev.knative.event",
+		EventSource:   "unit-test",
+		Name:          "testsource",
+		ResourceGroup: "testresourcegroup",
+	}
+
+	r, err := NewSinkStatsReporter("http://sink.example.com")
+	if err != nil {
+		t.Fatal("Failed to create a new reporter:", err)
+	}
+
+	wantTags := map[string]string{
+		metrics.LabelNamespaceName:     "testns",
+		metrics.LabelEventType:         "dev.knative.event",
+		metrics.LabelEventSource:       "unit-test",
+		metrics.LabelName:              "testsource",
+		metrics.LabelResourceGroup:     "testresourcegroup",
+		metrics.LabelSink:              "http://sink.example.com",
+		metrics.LabelResponseCode:      "202",
+		metrics.LabelResponseCodeClass: "2xx",
+	}
+
+	expectSuccess(t, func() error {
+		return r.ReportEventCount(args, http.StatusAccepted)
+	})
+	metricstest.CheckCountData(t, "event_count", wantTags, 1)
+}
+
+func TestDuplicateStatsReporter(t *testing.T) {
+	setup()
+
+	args := &ReportArgs{
+		Namespace:     "testns",
+		EventType:     "dev.knative.event",
+		EventSource:   "unit-test",
+		Name:          "testsource",
+		ResourceGroup: "testresourcegroup",
+	}
+
+	r, err := NewDuplicateStatsReporter()
+	if err != nil {
+		t.Fatal("Failed to create a new reporter:", err)
+	}
+
+	wantTags := map[string]string{
+		metrics.LabelNamespaceName: "testns",
+		metrics.LabelEventType:     "dev.knative.event",
+		metrics.LabelEventSource:   "unit-test",
+		metrics.LabelName:          "testsource",
+		metrics.LabelResourceGroup: "testresourcegroup",
+	}
+
+	expectSuccess(t, func() error {
+		return r.ReportEventCount(args, 0)
+	})
+	metricstest.CheckCountData(t, "duplicate_event_count", wantTags, 1)
+	metricstest.CheckStatsNotReported(t, "event_count", "retry_event_count")
+}
+
+func TestRateLimitedStatsReporter(t *testing.T) {
+	setup()
+
+	args := &ReportArgs{
+		Namespace:     "testns",
+		EventType:     "dev.knative.event",
+		EventSource:   "unit-test",
+		Name:          "testsource",
+		ResourceGroup: "testresourcegroup",
+	}
+
+	r, err := NewRateLimitedStatsReporter()
+	if err != nil {
+		t.Fatal("Failed to create a new reporter:", err)
+	}
+
+	wantTags := map[string]string{
+		metrics.LabelNamespaceName: "testns",
+		metrics.LabelEventType:     "dev.knative.event",
+		metrics.LabelEventSource:   "unit-test",
+		metrics.LabelName:          "testsource",
+		metrics.LabelResourceGroup: "testresourcegroup",
+	}
+
+	expectSuccess(t, func() error {
+		return r.ReportEventCount(args, 0)
+	})
+	metricstest.CheckCountData(t, "rate_limited_event_count", wantTags, 1)
+	metricstest.CheckStatsNotReported(t, "event_count", "duplicate_event_count")
+}
+
 func TestBadValues(t *testing.T) {
 	r, err := NewStatsReporter()
 	if err != nil {
@@ -112,5 +254,9 @@ func resetMetrics() {
 	// OpenCensus metrics carry global state that need to be reset between unit tests.
 	metricstest.Unregister("event_count")
 	metricstest.Unregister("retry_event_count")
+	metricstest.Unregister("dead_letter_event_count")
+	metricstest.Unregister("dead_letter_retry_event_count")
+	metricstest.Unregister("duplicate_event_count")
+	metricstest.Unregister("rate_limited_event_count")
 	register()
 }
@@ -66,7 +66,14 @@ func (k knativeObservabilityService) RecordSendingEvent(ctx context.Context, eve
 		spanKind = spanData.Kind
 	}
 
-	ctx, span := trace.StartSpan(ctx, spanName, trace.WithSpanKind(spanKind))
+	var span *trace.Span
+	if parent, ok := observability.RemoteParentFromContext(ctx); ok && trace.FromContext(ctx) == nil {
+		// The event continues the trace of the remote parent, e.g. the
+		// trace of the change of the Kubernetes object it is about.
+		ctx, span = trace.StartSpanWithRemoteParent(ctx, spanName, parent, trace.WithSpanKind(spanKind))
+	} else {
+		ctx, span = trace.StartSpan(ctx, spanName, trace.WithSpanKind(spanKind))
+	}
 	span.AddAttributes(obsclient.EventTraceAttributes(&event)...)
 	if spanData != nil && len(spanData.Attributes) > 0 {
 		span.AddAttributes(spanData.Attributes...)
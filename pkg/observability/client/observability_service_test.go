@@ -36,6 +36,7 @@ func (m mockExporter) ExportSpan(s *trace.SpanData) {
 func TestKnativeObservabilityServiceRequestSend(t *testing.T) {
 	mockExp := make(mockExporter, 1)
 	trace.RegisterExporter(mockExp)
+	defer trace.UnregisterExporter(mockExp)
 
 	trace.ApplyConfig(trace.Config{
 		DefaultSampler: trace.AlwaysSample(),
@@ -63,3 +64,34 @@ func TestKnativeObservabilityServiceRequestSend(t *testing.T) {
 	}
 	require.Equal(t, expectedAttributes, trace.Attributes)
 }
+
+func TestKnativeObservabilityServiceRequestSendWithRemoteParent(t *testing.T) {
+	mockExp := make(mockExporter, 1)
+	trace.RegisterExporter(mockExp)
+	defer trace.UnregisterExporter(mockExp)
+
+	trace.ApplyConfig(trace.Config{
+		DefaultSampler: trace.AlwaysSample(),
+	})
+
+	event := event.New(event.CloudEventsVersionV1)
+	event.SetID("aaa")
+	event.SetType("hello.world")
+	event.SetSource("example.com")
+
+	parent, ok := observability.SpanContextFromTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "")
+	require.True(t, ok)
+
+	ctx := context.Background()
+	ctx = observability.WithSpanData(ctx, "spanname", 1, nil)
+	ctx = observability.WithRemoteParent(ctx, parent)
+
+	_, callback := New().RecordSendingEvent(ctx, event)
+	callback(nil)
+
+	span := <-mockExp
+
+	require.Equal(t, parent.TraceID, span.TraceID)
+	require.Equal(t, parent.SpanID, span.ParentSpanID)
+	require.True(t, span.HasRemoteParent)
+}
@@ -18,7 +18,9 @@ package observability
 
 import (
 	"context"
+	"net/http"
 
+	"go.opencensus.io/plugin/ochttp/propagation/tracecontext"
 	"go.opencensus.io/trace"
 )
 
@@ -53,3 +55,30 @@ func SpanDataFromContext(ctx context.Context) *SpanData {
 	}
 	return val.(*SpanData)
 }
+
+type remoteParentKey struct{}
+
+// WithRemoteParent extends the given context with the span context of a
+// remote parent, which the spans created with it are children of.
+func WithRemoteParent(ctx context.Context, parent trace.SpanContext) context.Context {
+	return context.WithValue(ctx, remoteParentKey{}, parent)
+}
+
+// RemoteParentFromContext gets the span context of the remote parent from
+// the context, if any.
+func RemoteParentFromContext(ctx context.Context) (trace.SpanContext, bool) {
+	parent, ok := ctx.Value(remoteParentKey{}).(trace.SpanContext)
+	return parent, ok
+}
+
+// SpanContextFromTraceParent parses the W3C Trace Context traceparent and
+// tracestate values into a span context. It reports whether traceparent is
+// valid. An invalid tracestate is ignored.
+func SpanContextFromTraceParent(traceparent, tracestate string) (trace.SpanContext, bool) {
+	header := http.Header{}
+	header.Set("traceparent", traceparent)
+	if tracestate != "" {
+		header.Set("tracestate", tracestate)
+	}
+	return (&tracecontext.HTTPFormat{}).SpanContextFromRequest(&http.Request{Header: header})
+}
@@ -42,3 +42,35 @@ func TestSpanData(t *testing.T) {
 		t.Errorf("SpanDataFromContext(). got %v, wanted %v", sd, want)
 	}
 }
+
+func TestRemoteParent(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := RemoteParentFromContext(ctx); ok {
+		t.Error("RemoteParentFromContext() found a remote parent, wanted none")
+	}
+
+	want, ok := SpanContextFromTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "vendor=value")
+	if !ok {
+		t.Fatal("SpanContextFromTraceParent() did not parse a valid traceparent")
+	}
+	if got := want.TraceID.String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("TraceID = %s, wanted 4bf92f3577b34da6a3ce929d0e0e4736", got)
+	}
+	if got := want.SpanID.String(); got != "00f067aa0ba902b7" {
+		t.Errorf("SpanID = %s, wanted 00f067aa0ba902b7", got)
+	}
+
+	ctx = WithRemoteParent(ctx, want)
+	got, ok := RemoteParentFromContext(ctx)
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("RemoteParentFromContext(). got %v, wanted %v", got, want)
+	}
+}
+
+func TestSpanContextFromTraceParentInvalid(t *testing.T) {
+	for _, tp := range []string{"", "garbage", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7"} {
+		if sc, ok := SpanContextFromTraceParent(tp, ""); ok {
+			t.Errorf("SpanContextFromTraceParent(%q) = %v, wanted invalid", tp, sc)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
@@ -29,15 +30,23 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 	"knative.dev/pkg/resolver"
 
+	"knative.dev/eventing/pkg/adapter/apiserver"
+	"knative.dev/eventing/pkg/apis/eventing"
 	apisources "knative.dev/eventing/pkg/apis/sources"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	apiserversourcereconciler "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/apiserversource"
@@ -51,6 +60,12 @@ const (
 	apiserversourceDeploymentUpdated = "ApiServerSourceDeploymentUpdated"
 
 	component = "apiserversource"
+
+	// The group, kind and resource of the KafkaSinks, whose topic the events
+	// are written to directly.
+	kafkaSinkGroup    = "eventing.knative.dev"
+	kafkaSinkKind     = "KafkaSink"
+	kafkaSinkResource = "kafkasinks"
 )
 
 func newWarningSinkNotFound(sink *duckv1.Destination) pkgreconciler.Event {
@@ -58,9 +73,29 @@ func newWarningSinkNotFound(sink *duckv1.Destination) pkgreconciler.Event {
 	return pkgreconciler.NewEvent(corev1.EventTypeWarning, "SinkNotFound", "Sink not found: %s", string(b))
 }
 
+func newWarningDeadLetterSinkNotFound(sink *duckv1.Destination) pkgreconciler.Event {
+	b, _ := json.Marshal(sink)
+	return pkgreconciler.NewEvent(corev1.EventTypeWarning, "DeadLetterSinkNotFound", "Dead letter sink not found: %s", string(b))
+}
+
+// eventTypeEntry is an entry of the eventing.EventTypesAnnotationKey
+// annotation of the status of the source, which completes the entries of its
+// CRD when the EventTypes of the source are registered.
+type eventTypeEntry struct {
+	Type   string `json:"type"`
+	Schema string `json:"schema,omitempty"`
+}
+
 // Reconciler reconciles a ApiServerSource object
 type Reconciler struct {
-	kubeClientSet kubernetes.Interface
+	kubeClientSet    kubernetes.Interface
+	dynamicClientSet dynamic.Interface
+	namespaceLister  corev1listers.NamespaceLister
+	secretLister     corev1listers.SecretLister
+
+	// probeCluster probes the connection to the remote clusters of the
+	// sources.
+	probeCluster clusterProbe
 
 	receiveAdapterImage string
 
@@ -98,13 +133,61 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, source *v1.ApiServerSour
 	}
 	source.Status.MarkSink(sinkURI)
 
-	err = r.runAccessCheck(ctx, source)
+	kafka, err := r.resolveKafkaSink(ctx, dest)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to get the KafkaSink", zap.Error(err))
+		return err
+	}
+
+	sinkURIs, err := r.resolveSinks(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	deadLetterSinkURI, err := r.resolveDeadLetterSink(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	audiences, err := r.resolveAudiences(ctx, source)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to resolve the OIDC audiences of the sinks", zap.Error(err))
+		return err
+	}
+
+	namespaces, err := r.selectedNamespaces(source)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to select the namespaces", zap.Error(err))
+		return err
+	}
+
+	if err := r.reconcileCluster(ctx, source); err != nil {
+		logging.FromContext(ctx).Errorw("Unable to connect to the remote cluster", zap.Error(err))
+		return err
+	}
+
+	err = r.runAccessCheck(ctx, source, namespaces)
 	if err != nil {
 		logging.FromContext(ctx).Errorw("Not enough permission", zap.Error(err))
 		return err
 	}
 
-	ra, err := r.createReceiveAdapter(ctx, source, sinkURI.String())
+	if err := r.reconcileCheckpointConfigMap(ctx, source); err != nil {
+		logging.FromContext(ctx).Errorw("Unable to create the checkpoint ConfigMap", zap.Error(err))
+		return err
+	}
+
+	if err := r.reconcileThrottlingConfigMap(ctx, source); err != nil {
+		logging.FromContext(ctx).Errorw("Unable to reconcile the throttling ConfigMap", zap.Error(err))
+		return err
+	}
+
+	if err := r.reconcilePauseConfigMap(ctx, source); err != nil {
+		logging.FromContext(ctx).Errorw("Unable to reconcile the pause ConfigMap", zap.Error(err))
+		return err
+	}
+
+	ra, err := r.createReceiveAdapter(ctx, source, sinkURI.String(), sinkURIs, deadLetterSinkURI, kafka, audiences)
 	if err != nil {
 		logging.FromContext(ctx).Errorw("Unable to create the receive adapter", zap.Error(err))
 		return err
@@ -118,21 +201,166 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, source *v1.ApiServerSour
 	}
 	source.Status.CloudEventAttributes = cloudEventAttributes
 
+	if err := propagateEventTypeSchemas(source, r.ceSource); err != nil {
+		logging.FromContext(ctx).Errorw("Unable to propagate the schemas of the EventTypes", zap.Error(err))
+		return err
+	}
+
+	if source.Spec.Cluster != nil {
+		// The connection to the remote cluster is probed again.
+		return controller.NewRequeueAfter(clusterProbeInterval)
+	}
 	return nil
 }
 
-func (r *Reconciler) createReceiveAdapter(ctx context.Context, src *v1.ApiServerSource, sinkURI string) (*appsv1.Deployment, error) {
+// resolveSinks resolves the URIs of the additional sinks of source, in the
+// order of its spec.
+func (r *Reconciler) resolveSinks(ctx context.Context, source *v1.ApiServerSource) ([]string, error) {
+	if len(source.Spec.Sinks) == 0 {
+		source.Status.MarkSinks(nil)
+		return nil, nil
+	}
+	uris := make([]*apis.URL, 0, len(source.Spec.Sinks))
+	for _, sink := range source.Spec.Sinks {
+		dest := sink.Sink.DeepCopy()
+		if dest.Ref != nil && dest.Ref.Namespace == "" {
+			dest.Ref.Namespace = source.GetNamespace()
+		}
+		uri, err := r.sinkResolver.URIFromDestinationV1(ctx, *dest, source)
+		if err != nil {
+			b, _ := json.Marshal(dest)
+			source.Status.MarkSinksResolvedFailed("SinksNotFound", "Sink not found: %s", string(b))
+			return nil, newWarningSinkNotFound(dest)
+		}
+		uris = append(uris, uri)
+	}
+	source.Status.MarkSinks(uris)
+
+	sinkURIs := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		sinkURIs = append(sinkURIs, uri.String())
+	}
+	return sinkURIs, nil
+}
+
+// resolveDeadLetterSink resolves the dead letter sink of source, and returns
+// its URI or "" when source has no dead letter sink.
+func (r *Reconciler) resolveDeadLetterSink(ctx context.Context, source *v1.ApiServerSource) (string, error) {
+	if source.Spec.Delivery == nil || source.Spec.Delivery.DeadLetterSink == nil {
+		source.Status.MarkDeadLetterSinkNotConfigured()
+		return "", nil
+	}
+	dest := source.Spec.Delivery.DeadLetterSink.DeepCopy()
+	if dest.Ref != nil && dest.Ref.Namespace == "" {
+		dest.Ref.Namespace = source.GetNamespace()
+	}
+	deadLetterSinkURI, err := r.sinkResolver.URIFromDestinationV1(ctx, *dest, source)
+	if err != nil {
+		b, _ := json.Marshal(dest)
+		source.Status.MarkDeadLetterSinkResolvedFailed("NotFound", "Dead letter sink not found: %s", string(b))
+		return "", newWarningDeadLetterSinkNotFound(dest)
+	}
+	source.Status.MarkDeadLetterSinkResolvedSucceeded(deadLetterSinkURI)
+	return deadLetterSinkURI.String(), nil
+}
+
+// resolveKafkaSink returns the Kafka topic of the sink dest, when it is a
+// KafkaSink, or nil otherwise. The receive adapter writes the events to that
+// topic rather than sending them to the sink over HTTP.
+func (r *Reconciler) resolveKafkaSink(ctx context.Context, dest *duckv1.Destination) (*resources.KafkaSinkArgs, error) {
+	if dest.Ref == nil || dest.Ref.Kind != kafkaSinkKind {
+		return nil, nil
+	}
+	gv, err := schema.ParseGroupVersion(dest.Ref.APIVersion)
+	if err != nil || gv.Group != kafkaSinkGroup {
+		return nil, nil
+	}
+
+	sink, err := r.dynamicClientSet.Resource(gv.WithResource(kafkaSinkResource)).Namespace(dest.Ref.Namespace).Get(ctx, dest.Ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting KafkaSink %s/%s: %w", dest.Ref.Namespace, dest.Ref.Name, err)
+	}
+	topic, _, _ := unstructured.NestedString(sink.Object, "spec", "topic")
+	bootstrapServers, _, _ := unstructured.NestedStringSlice(sink.Object, "spec", "bootstrapServers")
+	if topic == "" || len(bootstrapServers) == 0 {
+		return nil, fmt.Errorf("KafkaSink %s/%s has no topic or bootstrap servers", dest.Ref.Namespace, dest.Ref.Name)
+	}
+	contentMode, _, _ := unstructured.NestedString(sink.Object, "spec", "contentMode")
+	secretName, _, _ := unstructured.NestedString(sink.Object, "spec", "auth", "secret", "ref", "name")
+	return &resources.KafkaSinkArgs{
+		BootstrapServers: bootstrapServers,
+		Topic:            topic,
+		ContentMode:      contentMode,
+		AuthSecretName:   secretName,
+	}, nil
+}
+
+// resolveAudiences returns the OIDC audiences of the sink, the additional
+// sinks and the dead letter sink of source.
+func (r *Reconciler) resolveAudiences(ctx context.Context, source *v1.ApiServerSource) (resources.SinkAudiences, error) {
+	var audiences resources.SinkAudiences
+	var err error
+	if audiences.Sink, err = r.resolveAudience(ctx, source, &source.Spec.Sink); err != nil {
+		return audiences, err
+	}
+	for i := range source.Spec.Sinks {
+		audience, err := r.resolveAudience(ctx, source, &source.Spec.Sinks[i].Sink)
+		if err != nil {
+			return audiences, err
+		}
+		audiences.Sinks = append(audiences.Sinks, audience)
+	}
+	if d := source.Spec.Delivery; d != nil && d.DeadLetterSink != nil {
+		if audiences.DeadLetterSink, err = r.resolveAudience(ctx, source, d.DeadLetterSink); err != nil {
+			return audiences, err
+		}
+	}
+	return audiences, nil
+}
+
+// resolveAudience returns the OIDC audience the Addressable referred to by
+// dest publishes in its .status.address.audience, or "" when dest is a URI
+// or the Addressable does not require authentication.
+func (r *Reconciler) resolveAudience(ctx context.Context, source *v1.ApiServerSource, dest *duckv1.Destination) (string, error) {
+	if dest.Ref == nil {
+		return "", nil
+	}
+	gv, err := schema.ParseGroupVersion(dest.Ref.APIVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the APIVersion of the sink %s: %w", dest.Ref.Name, err)
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gv.WithKind(dest.Ref.Kind))
+	namespace := dest.Ref.Namespace
+	if namespace == "" {
+		namespace = source.GetNamespace()
+	}
+
+	sink, err := r.dynamicClientSet.Resource(gvr).Namespace(namespace).Get(ctx, dest.Ref.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("error getting the sink %s/%s: %w", namespace, dest.Ref.Name, err)
+	}
+	audience, _, _ := unstructured.NestedString(sink.Object, "status", "address", "audience")
+	return audience, nil
+}
+
+func (r *Reconciler) createReceiveAdapter(ctx context.Context, src *v1.ApiServerSource, sinkURI string, sinkURIs []string, deadLetterSinkURI string, kafka *resources.KafkaSinkArgs, audiences resources.SinkAudiences) (*appsv1.Deployment, error) {
 	// TODO: missing.
 	// if err := checkResourcesStatus(src); err != nil {
 	// 	return nil, err
 	// }
 
 	adapterArgs := resources.ReceiveAdapterArgs{
-		Image:   r.receiveAdapterImage,
-		Source:  src,
-		Labels:  resources.Labels(src.Name),
-		SinkURI: sinkURI,
-		Configs: r.configs,
+		Image:             r.receiveAdapterImage,
+		Source:            src,
+		Labels:            resources.Labels(src.Name),
+		SinkURI:           sinkURI,
+		SinkURIs:          sinkURIs,
+		DeadLetterSinkURI: deadLetterSinkURI,
+		Configs:           r.configs,
+		Kafka:             kafka,
+		Audiences:         audiences,
 	}
 	expected, err := resources.MakeReceiveAdapter(&adapterArgs)
 	if err != nil {
@@ -152,8 +380,10 @@ func (r *Reconciler) createReceiveAdapter(ctx context.Context, src *v1.ApiServer
 		return nil, fmt.Errorf("error getting receive adapter: %v", err)
 	} else if !metav1.IsControlledBy(ra, src) {
 		return nil, fmt.Errorf("deployment %q is not owned by ApiServerSource %q", ra.Name, src.Name)
-	} else if r.podSpecChanged(ra.Spec.Template.Spec, expected.Spec.Template.Spec) {
+	} else if r.podSpecChanged(ra.Spec.Template.Spec, expected.Spec.Template.Spec) ||
+		!equality.Semantic.DeepDerivative(expected.Spec.Template.Annotations, ra.Spec.Template.Annotations) {
 		ra.Spec.Template.Spec = expected.Spec.Template.Spec
+		ra.Spec.Template.Annotations = kmeta.UnionMaps(ra.Spec.Template.Annotations, expected.Spec.Template.Annotations)
 		if ra, err = r.kubeClientSet.AppsV1().Deployments(src.Namespace).Update(ctx, ra, metav1.UpdateOptions{}); err != nil {
 			return ra, err
 		}
@@ -165,6 +395,92 @@ func (r *Reconciler) createReceiveAdapter(ctx context.Context, src *v1.ApiServer
 	return ra, nil
 }
 
+// reconcileCheckpointConfigMap creates the ConfigMap the receive adapter
+// stores its checkpoint in. It is owned by src, so it is deleted with it.
+func (r *Reconciler) reconcileCheckpointConfigMap(ctx context.Context, src *v1.ApiServerSource) error {
+	if src.Spec.Checkpoint == nil {
+		return nil
+	}
+	name := resources.CheckpointConfigMapName(src)
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Create(ctx, resources.MakeCheckpointConfigMap(src), metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return fmt.Errorf("error getting checkpoint ConfigMap: %v", err)
+	} else if !metav1.IsControlledBy(cm, src) {
+		return fmt.Errorf("ConfigMap %q is not owned by ApiServerSource %q", name, src.Name)
+	}
+	return nil
+}
+
+// reconcilePauseConfigMap creates or updates the ConfigMap the emission of
+// the events of the receive adapter is paused and resumed through, from the
+// annotations of src. It is owned by src, so it is deleted with it.
+func (r *Reconciler) reconcilePauseConfigMap(ctx context.Context, src *v1.ApiServerSource) error {
+	if !resources.IsPausable(src) {
+		return nil
+	}
+	expected := resources.MakePauseConfigMap(src)
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Get(ctx, expected.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Create(ctx, expected, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return fmt.Errorf("error getting pause ConfigMap: %v", err)
+	} else if !metav1.IsControlledBy(cm, src) {
+		return fmt.Errorf("ConfigMap %q is not owned by ApiServerSource %q", expected.Name, src.Name)
+	}
+	if equality.Semantic.DeepEqual(cm.Data, expected.Data) {
+		return nil
+	}
+	cm = cm.DeepCopy()
+	cm.Data = expected.Data
+	_, err = r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// reconcileThrottlingConfigMap creates the ConfigMap the receive adapter
+// reports its throttling in, and reflects the throttling it reported in the
+// status of src. It is owned by src, so it is deleted with it.
+func (r *Reconciler) reconcileThrottlingConfigMap(ctx context.Context, src *v1.ApiServerSource) error {
+	if src.Spec.RateLimit == nil {
+		src.Status.MarkNoRateLimit()
+		return nil
+	}
+	name := resources.ThrottlingConfigMapName(src)
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = r.kubeClientSet.CoreV1().ConfigMaps(src.Namespace).Create(ctx, resources.MakeThrottlingConfigMap(src), metav1.CreateOptions{})
+		if err == nil {
+			src.Status.MarkNotThrottled()
+		}
+		return err
+	} else if err != nil {
+		return fmt.Errorf("error getting throttling ConfigMap: %v", err)
+	} else if !metav1.IsControlledBy(cm, src) {
+		return fmt.Errorf("ConfigMap %q is not owned by ApiServerSource %q", name, src.Name)
+	}
+
+	if cm.Data[apiserver.ThrottledKey] != "true" {
+		src.Status.MarkNotThrottled()
+		return nil
+	}
+	limit := src.Spec.RateLimit
+	var overflow string
+	switch limit.OverflowPolicy {
+	case v1.OverflowPolicyDrop:
+		overflow = "dropped"
+	case v1.OverflowPolicyDeadLetter:
+		overflow = "sent to the dead letter sink"
+	default:
+		overflow = "held"
+	}
+	src.Status.MarkThrottled("RateLimited", "The events above %d per second are %s since %s.",
+		limit.EventsPerSecond, overflow, cm.Data[apiserver.ThrottledSinceKey])
+	return nil
+}
+
 func (r *Reconciler) podSpecChanged(oldPodSpec corev1.PodSpec, newPodSpec corev1.PodSpec) bool {
 	if !equality.Semantic.DeepDerivative(newPodSpec, oldPodSpec) {
 		return true
@@ -180,8 +496,36 @@ func (r *Reconciler) podSpecChanged(oldPodSpec corev1.PodSpec, newPodSpec corev1
 	return false
 }
 
-func (r *Reconciler) runAccessCheck(ctx context.Context, src *v1.ApiServerSource) error {
-	if src.Spec.Resources == nil || len(src.Spec.Resources) == 0 {
+// selectedNamespaces returns the sorted namespaces the resources of src are
+// watched in, or nil when they are watched in the namespace of src.
+func (r *Reconciler) selectedNamespaces(src *v1.ApiServerSource) ([]string, error) {
+	if len(src.Spec.Namespaces) == 0 && src.Spec.NamespaceSelector == nil {
+		return nil, nil
+	}
+	selected := sets.NewString(src.Spec.Namespaces...)
+	if src.Spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(src.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		namespaces, err := r.namespaceLister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range namespaces {
+			selected.Insert(ns.Name)
+		}
+	}
+	return selected.List(), nil
+}
+
+// runAccessCheck checks the ServiceAccount of src can watch its resources in
+// the namespaces, or in the namespace of src when namespaces is nil. The
+// namespaces it cannot watch them in are reported in the status, and are
+// only insufficient permissions when it cannot watch them in any.
+func (r *Reconciler) runAccessCheck(ctx context.Context, src *v1.ApiServerSource, namespaces []string) error {
+	if (src.Spec.Resources == nil || len(src.Spec.Resources) == 0) && src.Spec.Checkpoint == nil && src.Spec.RateLimit == nil && !resources.IsPausable(src) {
+		src.Status.MarkForbiddenNamespaces(nil)
 		src.Status.MarkSufficientPermissions()
 		return nil
 	}
@@ -194,48 +538,77 @@ func (r *Reconciler) runAccessCheck(ctx context.Context, src *v1.ApiServerSource
 	}
 
 	verbs := []string{"get", "list", "watch"}
+	if src.Spec.Acknowledgement != nil {
+		// The receive adapter records the deliveries on the watched objects.
+		verbs = append(verbs, "patch")
+	}
 	lastReason := ""
 
 	// Collect all missing permissions.
 	missing := ""
 	sep := ""
 
-	for _, res := range src.Spec.Resources {
-		gv, err := schema.ParseGroupVersion(res.APIVersion)
+	watched := namespaces
+	if watched == nil {
+		watched = []string{src.Namespace}
+	}
+	if src.Spec.Cluster != nil {
+		// The resources of the remote cluster are watched with the
+		// credentials of its kubeconfig rather than the ServiceAccount.
+		watched = nil
+	}
+	var forbidden []string
+	for _, namespace := range watched {
+		missingResources, err := r.missingResourceVerbs(ctx, src, namespace, user, verbs)
 		if err != nil {
 			return err
 		}
-		gvr, _ := meta.UnsafeGuessKindToResource(schema.GroupVersionKind{Kind: res.Kind, Group: gv.Group, Version: gv.Version}) // TODO: Test for nil Kind.
-		missingVerbs := ""
-		sep1 := ""
-		for _, verb := range verbs {
-			sar := &authorizationv1.SubjectAccessReview{
-				Spec: authorizationv1.SubjectAccessReviewSpec{
-					ResourceAttributes: &authorizationv1.ResourceAttributes{
-						Namespace: src.Namespace,
-						Verb:      verb,
-						Group:     gv.Group,
-						Resource:  gvr.Resource,
-					},
-					User: user,
-				},
-			}
-
-			response, err := r.kubeClientSet.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
-			if err != nil {
-				return err
-			}
-
-			if !response.Status.Allowed {
-				missingVerbs += sep1 + verb
-				sep1 = ", "
+		if missingResources != "" {
+			forbidden = append(forbidden, namespace)
+			if len(forbidden) == len(watched) {
+				missing += sep + missingResources
+				sep = ", "
 			}
 		}
+	}
+	if namespaces == nil {
+		// The namespace of src is not selected.
+		forbidden = nil
+	}
+	src.Status.MarkForbiddenNamespaces(forbidden)
+	if src.Spec.NamespaceSelector != nil && src.Spec.Cluster == nil {
+		// The receive adapter watches the namespaces the selector selects.
+		missingVerbs, err := r.missingVerbs(ctx, "", user, "", "namespaces", []string{"list", "watch"})
+		if err != nil {
+			return err
+		}
 		if missingVerbs != "" {
-			missing += sep + missingVerbs + ` resource "` + gvr.Resource + `" in API group "` + gv.Group + `"`
+			missing += sep + missingVerbs + ` resource "namespaces" in API group ""`
 			sep = ", "
 		}
 	}
+	var configMapVerbs []string
+	if src.Spec.Checkpoint != nil || src.Spec.RateLimit != nil {
+		// The receive adapter stores the checkpoint, and reports its
+		// throttling, in their ConfigMaps.
+		configMapVerbs = append(configMapVerbs, "get", "update")
+	}
+	if resources.IsPausable(src) {
+		// The receive adapter watches the ConfigMap it is paused through.
+		if len(configMapVerbs) == 0 {
+			configMapVerbs = append(configMapVerbs, "get")
+		}
+		configMapVerbs = append(configMapVerbs, "list", "watch")
+	}
+	if len(configMapVerbs) > 0 {
+		missingVerbs, err := r.missingVerbs(ctx, src.Namespace, user, "", "configmaps", configMapVerbs)
+		if err != nil {
+			return err
+		}
+		if missingVerbs != "" {
+			missing += sep + missingVerbs + ` resource "configmaps" in API group ""`
+		}
+	}
 	if missing == "" {
 		src.Status.MarkSufficientPermissions()
 		return nil
@@ -246,15 +619,258 @@ func (r *Reconciler) runAccessCheck(ctx context.Context, src *v1.ApiServerSource
 
 }
 
+// missingResourceVerbs returns the verbs user misses on the resources of src
+// in the namespace, or "" when it misses none.
+func (r *Reconciler) missingResourceVerbs(ctx context.Context, src *v1.ApiServerSource, namespace, user string, verbs []string) (string, error) {
+	missing := ""
+	sep := ""
+	for _, res := range src.Spec.Resources {
+		gv, err := schema.ParseGroupVersion(res.APIVersion)
+		if err != nil {
+			return "", err
+		}
+		gvr, _ := meta.UnsafeGuessKindToResource(schema.GroupVersionKind{Kind: res.Kind, Group: gv.Group, Version: gv.Version}) // TODO: Test for nil Kind.
+		missingVerbs, err := r.missingVerbs(ctx, namespace, user, gv.Group, gvr.Resource, verbs)
+		if err != nil {
+			return "", err
+		}
+		if missingVerbs != "" {
+			missing += sep + missingVerbs + ` resource "` + gvr.Resource + `" in API group "` + gv.Group + `"`
+			sep = ", "
+		}
+	}
+	return missing, nil
+}
+
+func (r *Reconciler) missingVerbs(ctx context.Context, namespace, user, group, resource string, verbs []string) (string, error) {
+	missingVerbs := ""
+	sep := ""
+	for _, verb := range verbs {
+		sar := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      verb,
+					Group:     group,
+					Resource:  resource,
+				},
+				User: user,
+			},
+		}
+
+		response, err := r.kubeClientSet.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+		if err != nil {
+			return "", err
+		}
+
+		if !response.Status.Allowed {
+			missingVerbs += sep + verb
+			sep = ", "
+		}
+	}
+	return missingVerbs, nil
+}
+
+// watchesScale returns whether the scale subresource of any resource of src
+// is watched.
+func watchesScale(src *v1.ApiServerSource) bool {
+	for _, r := range src.Spec.Resources {
+		if r.Subresource == v1.ScaleSubresource {
+			return true
+		}
+	}
+	return false
+}
+
+// templatedEventTypes returns the types of the events of the resources of
+// src, expanded from the default eventTypes with the EventTypeTemplates of
+// the resources which have one. The batch events keep their default types.
+// The types of the verbs which no resource selects are left out.
+func templatedEventTypes(src *v1.ApiServerSource, eventTypes []string) []string {
+	if !hasEventTypeTemplates(src) && !selectsVerbs(src) {
+		return eventTypes
+	}
+	var defaults, templated []string
+	seen := sets.NewString()
+	add := func(types *[]string, t string) {
+		if !seen.Has(t) {
+			seen.Insert(t)
+			*types = append(*types, t)
+		}
+	}
+	for _, r := range src.Spec.Resources {
+		for _, t := range eventTypes {
+			if !r.SendsEventType(t) {
+				continue
+			}
+			if r.EventTypeTemplate == "" || t == apisources.ApiServerSourceBatchEventType || t == apisources.ApiServerSourceBatchRefEventType {
+				add(&defaults, t)
+				continue
+			}
+			if expanded, err := v1.ExpandEventTypeTemplate(r.EventTypeTemplate, t, r.APIVersion, r.Kind); err == nil {
+				add(&templated, expanded)
+			}
+		}
+	}
+	return append(defaults, templated...)
+}
+
+// eventTypeSchemas returns the entries of the types of the events of the
+// resources of src which carry the objects, with the schema of their kind in
+// the OpenAPI v3 document served by the API server at host. The types which
+// are shared by resources of different kinds are left without schema.
+func eventTypeSchemas(src *v1.ApiServerSource, host string) []eventTypeEntry {
+	if src.Spec.EventMode != v1.ResourceMode {
+		return nil
+	}
+	eventTypes := apisources.ApiServerSourceEventResourceModeTypes
+	if classifiesUpdates(src) {
+		eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)],
+			apisources.ApiServerSourceUpdateStatusEventType, apisources.ApiServerSourceUpdateSpecEventType)
+	}
+	if src.Spec.ResyncPeriod != "" {
+		eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)], apisources.ApiServerSourceSyncEventType)
+	}
+	var types []string
+	schemas := make(map[string]string)
+	for _, r := range src.Spec.Resources {
+		s, err := openAPISchema(host, r.APIVersion, r.Kind)
+		if err != nil {
+			continue
+		}
+		for _, t := range eventTypes {
+			if !r.SendsEventType(t) {
+				continue
+			}
+			if r.EventTypeTemplate != "" {
+				if t, err = v1.ExpandEventTypeTemplate(r.EventTypeTemplate, t, r.APIVersion, r.Kind); err != nil {
+					continue
+				}
+			}
+			if prev, ok := schemas[t]; !ok {
+				types = append(types, t)
+				schemas[t] = s
+			} else if prev != s {
+				schemas[t] = ""
+			}
+		}
+	}
+	var entries []eventTypeEntry
+	for _, t := range types {
+		if schemas[t] != "" {
+			entries = append(entries, eventTypeEntry{Type: t, Schema: schemas[t]})
+		}
+	}
+	return entries
+}
+
+// propagateEventTypeSchemas records the schemas of the types of the events of
+// src in the eventing.EventTypesAnnotationKey annotation of its status, for
+// them to be set on the EventTypes registered for src.
+func propagateEventTypeSchemas(src *v1.ApiServerSource, host string) error {
+	entries := eventTypeSchemas(src, host)
+	if len(entries) == 0 {
+		delete(src.Status.Annotations, eventing.EventTypesAnnotationKey)
+		return nil
+	}
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	if src.Status.Annotations == nil {
+		src.Status.Annotations = make(map[string]string, 1)
+	}
+	src.Status.Annotations[eventing.EventTypesAnnotationKey] = string(b)
+	return nil
+}
+
+// openAPISchema returns the URI of the schema of kind in the OpenAPI v3
+// document of its group version served by the API server at host.
+func openAPISchema(host, apiVersion, kind string) (string, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return "", err
+	}
+	path := "/openapi/v3/apis/" + gv.String()
+	if gv.Group == "" {
+		path = "/openapi/v3/api/" + gv.Version
+	}
+	return strings.TrimSuffix(host, "/") + path + "#" + kind, nil
+}
+
+// hasEventTypeTemplates returns whether any resource of src has an
+// EventTypeTemplate.
+func hasEventTypeTemplates(src *v1.ApiServerSource) bool {
+	for _, r := range src.Spec.Resources {
+		if r.EventTypeTemplate != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// selectsVerbs returns whether the events of any resource of src are limited
+// to some verbs.
+func selectsVerbs(src *v1.ApiServerSource) bool {
+	for _, r := range src.Spec.Resources {
+		if len(r.Verbs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// classifiesUpdates returns whether the update events of any resource of src
+// are classified by the sections of the objects which changed.
+func classifiesUpdates(src *v1.ApiServerSource) bool {
+	for _, r := range src.Spec.Resources {
+		if r.ClassifyUpdates {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Reconciler) createCloudEventAttributes(src *v1.ApiServerSource) ([]duckv1.CloudEventAttributes, error) {
 	var eventTypes []string
 	if src.Spec.EventMode == v1.ReferenceMode {
 		eventTypes = apisources.ApiServerSourceEventReferenceModeTypes
 	} else if src.Spec.EventMode == v1.ResourceMode {
 		eventTypes = apisources.ApiServerSourceEventResourceModeTypes
+	} else if src.Spec.EventMode == v1.DiffMode {
+		eventTypes = apisources.ApiServerSourceEventDiffModeTypes
 	} else {
 		return []duckv1.CloudEventAttributes{}, fmt.Errorf("no EventType available for EventMode: %s", src.Spec.EventMode)
 	}
+	if src.Spec.Batch != nil {
+		batchType := apisources.ApiServerSourceBatchEventType
+		if src.Spec.EventMode == v1.ReferenceMode {
+			batchType = apisources.ApiServerSourceBatchRefEventType
+		}
+		eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)], batchType)
+	}
+	// The diff updates keep their type when they are classified.
+	if classifiesUpdates(src) {
+		switch src.Spec.EventMode {
+		case v1.ReferenceMode:
+			eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)],
+				apisources.ApiServerSourceUpdateStatusRefEventType, apisources.ApiServerSourceUpdateSpecRefEventType)
+		case v1.ResourceMode:
+			eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)],
+				apisources.ApiServerSourceUpdateStatusEventType, apisources.ApiServerSourceUpdateSpecEventType)
+		}
+	}
+	if src.Spec.ResyncPeriod != "" {
+		syncType := apisources.ApiServerSourceSyncEventType
+		if src.Spec.EventMode == v1.ReferenceMode {
+			syncType = apisources.ApiServerSourceSyncRefEventType
+		}
+		eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)], syncType)
+	}
+	if watchesScale(src) {
+		eventTypes = append(eventTypes[:len(eventTypes):len(eventTypes)], apisources.ApiServerSourceScaleEventType)
+	}
+	eventTypes = templatedEventTypes(src, eventTypes)
 	ceAttributes := make([]duckv1.CloudEventAttributes, 0, len(eventTypes))
 	for _, apiServerSourceType := range eventTypes {
 		ceAttributes = append(ceAttributes, duckv1.CloudEventAttributes{
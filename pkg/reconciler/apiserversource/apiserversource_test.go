@@ -18,6 +18,7 @@ package apiserversource
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -25,10 +26,18 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	clientgotesting "k8s.io/client-go/testing"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/eventing"
+	apisources "knative.dev/eventing/pkg/apis/sources"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	"knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/apiserversource"
@@ -41,6 +50,7 @@ import (
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
+	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/network"
 	"knative.dev/pkg/resolver"
@@ -59,38 +69,681 @@ var (
 			APIVersion: "messaging.knative.dev/v1",
 		},
 	}
+	deadLetterSinkDest = duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Name:       "testdls",
+			Kind:       "Channel",
+			APIVersion: "messaging.knative.dev/v1",
+		},
+	}
+	otherSinkDest = duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Name:       "testothersink",
+			Kind:       "Channel",
+			APIVersion: "messaging.knative.dev/v1",
+		},
+	}
 	brokerDest = duckv1.Destination{
 		Ref: &duckv1.KReference{
 			Name:       sinkName,
 			Kind:       "Broker",
 			APIVersion: "eventing.knative.dev/v1",
 		},
-	}
-	sinkDNS          = "sink.mynamespace.svc." + network.GetClusterDomainName()
-	sinkURI          = apis.HTTP(sinkDNS)
-	sinkURIReference = "/foo"
-	sinkTargetURI    = func() *apis.URL {
-		u := apis.HTTP(sinkDNS)
-		u.Path = sinkURIReference
-		return u
-	}()
-)
-
-const (
-	image      = "github.com/knative/test/image"
-	sourceName = "test-apiserver-source"
-	sourceUID  = "1234"
-	testNS     = "testnamespace"
-
-	sinkName = "testsink"
-	source   = "apiserveraddr"
-
-	generation = 1
-)
-
-func TestReconcile(t *testing.T) {
-	table := TableTest{{
-		Name: "not enough permissions",
+	}
+	rateLimitedSpec = sourcesv1.ApiServerSourceSpec{
+		Resources: []sourcesv1.APIVersionKindSelector{{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+		}},
+		SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+		RateLimit: &sourcesv1.ApiServerSourceRateLimit{
+			EventsPerSecond: 10,
+			Burst:           10,
+			OverflowPolicy:  sourcesv1.OverflowPolicyDrop,
+		},
+	}
+	pausableSpec = sourcesv1.ApiServerSourceSpec{
+		Resources: []sourcesv1.APIVersionKindSelector{{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+		}},
+		SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+	}
+	acknowledgedSpec = sourcesv1.ApiServerSourceSpec{
+		Resources: []sourcesv1.APIVersionKindSelector{{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+		}},
+		SourceSpec:      duckv1.SourceSpec{Sink: sinkDest},
+		Acknowledgement: &sourcesv1.ApiServerSourceAcknowledgement{PatchesPerSecond: 10},
+	}
+	clusterSpec = sourcesv1.ApiServerSourceSpec{
+		Resources: []sourcesv1.APIVersionKindSelector{{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+		}},
+		SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+		Cluster: &sourcesv1.ApiServerSourceCluster{
+			Name: "remote",
+			KubeconfigSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: kubeconfigSecretName},
+				Key:                  "kubeconfig",
+			},
+		},
+	}
+	errUnreachable   = errors.New("connection refused")
+	sinkDNS          = "sink.mynamespace.svc." + network.GetClusterDomainName()
+	sinkURI          = apis.HTTP(sinkDNS)
+	sinkURIReference = "/foo"
+	sinkTargetURI    = func() *apis.URL {
+		u := apis.HTTP(sinkDNS)
+		u.Path = sinkURIReference
+		return u
+	}()
+)
+
+const (
+	image      = "github.com/knative/test/image"
+	sourceName = "test-apiserver-source"
+	sourceUID  = "1234"
+	testNS     = "testnamespace"
+
+	sinkName = "testsink"
+	source   = "apiserveraddr"
+
+	generation = 1
+
+	kubeconfigSecretName = "remote-kubeconfig"
+)
+
+func TestReconcile(t *testing.T) {
+	table := TableTest{{
+		Name: "not enough permissions",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeAvailableReceiveAdapter(t),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceNoSufficientPermissions,
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+		},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `Insufficient permission: user system:serviceaccount:testnamespace:default cannot get, list, watch resource "namespaces" in API group ""`),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(false)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "acknowledged without the permission to patch",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(acknowledgedSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(acknowledgedSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				func(s *sourcesv1.ApiServerSource) {
+					s.Status.MarkNoSufficientPermissions("", `User system:serviceaccount:testnamespace:default cannot get, list, watch, patch resource "namespaces" in API group ""`)
+				},
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+			makeSubjectAccessReview("namespaces", "patch", "default"),
+		},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `Insufficient permission: user system:serviceaccount:testnamespace:default cannot get, list, watch, patch resource "namespaces" in API group ""`),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(false)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "no permissions in the selected namespaces",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					Namespaces:        []string{"apps"},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}},
+					SourceSpec:        duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			rttestingv1.NewNamespace("web", rttestingv1.WithNamespaceLabeled(map[string]string{"team": "web"})),
+			rttestingv1.NewNamespace("other"),
+			makeAvailableReceiveAdapter(t),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					Namespaces:        []string{"apps"},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}},
+					SourceSpec:        duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceForbiddenNamespaces("apps", "web"),
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceNoSufficientPermissions,
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeNamespacedSubjectAccessReview("apps", "namespaces", "get", "default"),
+			makeNamespacedSubjectAccessReview("apps", "namespaces", "list", "default"),
+			makeNamespacedSubjectAccessReview("apps", "namespaces", "watch", "default"),
+			makeNamespacedSubjectAccessReview("web", "namespaces", "get", "default"),
+			makeNamespacedSubjectAccessReview("web", "namespaces", "list", "default"),
+			makeNamespacedSubjectAccessReview("web", "namespaces", "watch", "default"),
+			makeNamespacedSubjectAccessReview("", "namespaces", "list", "default"),
+			makeNamespacedSubjectAccessReview("", "namespaces", "watch", "default"),
+		},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `Insufficient permission: user system:serviceaccount:testnamespace:default cannot get, list, watch resource "namespaces" in API group ""`),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{forbiddenNamespacesReactor("apps", "web")},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "valid",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeAvailableReceiveAdapter(t),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceDeployed,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "kubeconfig Secret not found",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterDisconnected("KubeconfigNotFound", `Secret %q not found`, kubeconfigSecretName),
+			),
+		}},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `the kubeconfig Secret "remote-kubeconfig" of the cluster "remote" was not found`),
+		},
+	}, {
+		Name: "kubeconfig Secret without the key",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeKubeconfigSecret("config", "reachable"),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterDisconnected("KubeconfigNotFound", `Secret %q has no key %q`, kubeconfigSecretName, "kubeconfig"),
+			),
+		}},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `the kubeconfig Secret "remote-kubeconfig" of the cluster "remote" has no key "kubeconfig"`),
+		},
+	}, {
+		Name: "cluster unreachable",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeKubeconfigSecret("kubeconfig", "unreachable"),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterDisconnected("ClusterUnreachable", `Cluster %q cannot be reached: %v`, "remote", errUnreachable),
+			),
+		}},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `the cluster "remote" cannot be reached: connection refused`),
+		},
+	}, {
+		Name: "cluster connected",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeKubeconfigSecret("kubeconfig", "reachable"),
+			makeAvailableReceiveAdapterWithSpec(t, clusterSpec),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(clusterSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceDeployed,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterConnected,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		// The connection to the cluster is probed again later.
+		WantErr: true,
+	}, {
+		Name: "checkpoint ConfigMap not owned",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+					Checkpoint: &sourcesv1.ApiServerSourceCheckpoint{Interval: "10s"},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: sourceName + "-checkpoint"},
+			},
+		},
+		Key:     testNS + "/" + sourceName,
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `ConfigMap "test-apiserver-source-checkpoint" is not owned by ApiServerSource "test-apiserver-source"`),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+					Checkpoint: &sourcesv1.ApiServerSourceCheckpoint{Interval: "10s"},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+			makeSubjectAccessReview("configmaps", "get", "default"),
+			makeSubjectAccessReview("configmaps", "update", "default"),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "throttled",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(rateLimitedSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeThrottlingConfigMap(map[string]string{"throttled": "true", "since": "2022-11-01T10:00:00Z"}),
+			makeAvailableReceiveAdapterWithSpec(t, rateLimitedSpec),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(rateLimitedSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceDeployed,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceThrottled(10, "dropped", "2022-11-01T10:00:00Z"),
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+			makeSubjectAccessReview("configmaps", "get", "default"),
+			makeSubjectAccessReview("configmaps", "update", "default"),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "rate limited without throttling ConfigMap",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(rateLimitedSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeAvailableReceiveAdapterWithSpec(t, rateLimitedSpec),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(rateLimitedSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceDeployed,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceNotThrottled,
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+			makeSubjectAccessReview("configmaps", "get", "default"),
+			makeSubjectAccessReview("configmaps", "update", "default"),
+			makeThrottlingConfigMap(nil),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "paused",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(pausableSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, "true"),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeAvailableReceiveAdapterWithSpec(t, pausableSpec,
+				rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, "true")),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(pausableSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, "true"),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceDeployed,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+			makeSubjectAccessReview("configmaps", "get", "default"),
+			makeSubjectAccessReview("configmaps", "list", "default"),
+			makeSubjectAccessReview("configmaps", "watch", "default"),
+			makePauseConfigMap("true"),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "resumed",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(pausableSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, "false"),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makePauseConfigMap("true"),
+			makeAvailableReceiveAdapterWithSpec(t, pausableSpec,
+				rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, "false")),
+		},
+		Key: testNS + "/" + sourceName,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(pausableSpec),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, "false"),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceDeployed,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+			makeSubjectAccessReview("configmaps", "get", "default"),
+			makeSubjectAccessReview("configmaps", "list", "default"),
+			makeSubjectAccessReview("configmaps", "watch", "default"),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: makePauseConfigMap("false"),
+		}},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "valid with eventmode of resourcemode",
 		Objects: []runtime.Object{
 			rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -98,6 +751,7 @@ func TestReconcile(t *testing.T) {
 						APIVersion: "v1",
 						Kind:       "Namespace",
 					}},
+					EventMode:  sourcesv1.ResourceMode,
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
@@ -107,7 +761,7 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitChannelConditions,
 				rttestingv1.WithChannelAddress(sinkDNS),
 			),
-			makeAvailableReceiveAdapter(t),
+			makeAvailableReceiveAdapterWithEventMode(t, sourcesv1.ResourceMode),
 		},
 		Key: testNS + "/" + sourceName,
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -117,15 +771,25 @@ func TestReconcile(t *testing.T) {
 						APIVersion: "v1",
 						Kind:       "Namespace",
 					}},
+					EventMode:  sourcesv1.ResourceMode,
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
 				// Status Update:
 				rttestingv1.WithInitApiServerSourceConditions,
-				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceDeployed,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
-				rttestingv1.WithApiServerSourceNoSufficientPermissions,
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceResourceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceStatusAnnotation(eventing.EventTypesAnnotationKey, `[`+
+					`{"type":"dev.knative.apiserver.resource.add","schema":"apiserveraddr/openapi/v3/api/v1#Namespace"},`+
+					`{"type":"dev.knative.apiserver.resource.delete","schema":"apiserveraddr/openapi/v3/api/v1#Namespace"},`+
+					`{"type":"dev.knative.apiserver.resource.update","schema":"apiserveraddr/openapi/v3/api/v1#Namespace"}]`),
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
 			),
 		}},
 		WantCreates: []runtime.Object{
@@ -133,14 +797,10 @@ func TestReconcile(t *testing.T) {
 			makeSubjectAccessReview("namespaces", "list", "default"),
 			makeSubjectAccessReview("namespaces", "watch", "default"),
 		},
-		WantErr: true,
-		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "InternalError", `Insufficient permission: user system:serviceaccount:testnamespace:default cannot get, list, watch resource "namespaces" in API group ""`),
-		},
-		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(false)},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
 		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
 	}, {
-		Name: "valid",
+		Name: "valid with sink URI",
 		Objects: []runtime.Object{
 			rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -175,6 +835,9 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceDeployed,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
@@ -188,7 +851,7 @@ func TestReconcile(t *testing.T) {
 		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
 		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
 	}, {
-		Name: "valid with eventmode of resourcemode",
+		Name: "missing sink",
 		Objects: []runtime.Object{
 			rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -196,19 +859,17 @@ func TestReconcile(t *testing.T) {
 						APIVersion: "v1",
 						Kind:       "Namespace",
 					}},
-					EventMode:  sourcesv1.ResourceMode,
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
 			),
-			rttestingv1.NewChannel(sinkName, testNS,
-				rttestingv1.WithInitChannelConditions,
-				rttestingv1.WithChannelAddress(sinkDNS),
-			),
-			makeAvailableReceiveAdapterWithEventMode(t, sourcesv1.ResourceMode),
 		},
 		Key: testNS + "/" + sourceName,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "SinkNotFound",
+				`Sink not found: {"ref":{"kind":"Channel","namespace":"testnamespace","name":"testsink","apiVersion":"messaging.knative.dev/v1"}}`),
+		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -216,29 +877,20 @@ func TestReconcile(t *testing.T) {
 						APIVersion: "v1",
 						Kind:       "Namespace",
 					}},
-					EventMode:  sourcesv1.ResourceMode,
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
 				// Status Update:
 				rttestingv1.WithInitApiServerSourceConditions,
-				rttestingv1.WithApiServerSourceDeployed,
-				rttestingv1.WithApiServerSourceSink(sinkURI),
-				rttestingv1.WithApiServerSourceSufficientPermissions,
-				rttestingv1.WithApiServerSourceResourceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSinkNotFound,
 			),
 		}},
-		WantCreates: []runtime.Object{
-			makeSubjectAccessReview("namespaces", "get", "default"),
-			makeSubjectAccessReview("namespaces", "list", "default"),
-			makeSubjectAccessReview("namespaces", "watch", "default"),
-		},
 		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
 		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
 	}, {
-		Name: "valid with sink URI",
+		Name: "missing dead letter sink",
 		Objects: []runtime.Object{
 			rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -247,6 +899,7 @@ func TestReconcile(t *testing.T) {
 						Kind:       "Namespace",
 					}},
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+					Delivery:   &eventingduckv1.DeliverySpec{DeadLetterSink: &deadLetterSinkDest},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
@@ -255,9 +908,12 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitChannelConditions,
 				rttestingv1.WithChannelAddress(sinkDNS),
 			),
-			makeAvailableReceiveAdapter(t),
 		},
 		Key: testNS + "/" + sourceName,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "DeadLetterSinkNotFound",
+				`Dead letter sink not found: {"ref":{"kind":"Channel","namespace":"testnamespace","name":"testdls","apiVersion":"messaging.knative.dev/v1"}}`),
+		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -266,27 +922,23 @@ func TestReconcile(t *testing.T) {
 						Kind:       "Namespace",
 					}},
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+					Delivery:   &eventingduckv1.DeliverySpec{DeadLetterSink: &deadLetterSinkDest},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
 				// Status Update:
 				rttestingv1.WithInitApiServerSourceConditions,
-				rttestingv1.WithApiServerSourceDeployed,
-				rttestingv1.WithApiServerSourceSink(sinkURI),
-				rttestingv1.WithApiServerSourceSufficientPermissions,
-				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotFound("Dead letter sink not found: %s",
+					`{"ref":{"kind":"Channel","namespace":"testnamespace","name":"testdls","apiVersion":"messaging.knative.dev/v1"}}`),
 			),
 		}},
-		WantCreates: []runtime.Object{
-			makeSubjectAccessReview("namespaces", "get", "default"),
-			makeSubjectAccessReview("namespaces", "list", "default"),
-			makeSubjectAccessReview("namespaces", "watch", "default"),
-		},
 		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
 		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
 	}, {
-		Name: "missing sink",
+		Name: "missing additional sink",
 		Objects: []runtime.Object{
 			rttestingv1.NewApiServerSource(sourceName, testNS,
 				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
@@ -295,15 +947,20 @@ func TestReconcile(t *testing.T) {
 						Kind:       "Namespace",
 					}},
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+					Sinks:      []sourcesv1.ApiServerSourceSink{{Sink: otherSinkDest}},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
 			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
 		},
 		Key: testNS + "/" + sourceName,
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "SinkNotFound",
-				`Sink not found: {"ref":{"kind":"Channel","namespace":"testnamespace","name":"testsink","apiVersion":"messaging.knative.dev/v1"}}`),
+				`Sink not found: {"ref":{"kind":"Channel","namespace":"testnamespace","name":"testothersink","apiVersion":"messaging.knative.dev/v1"}}`),
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
@@ -313,13 +970,16 @@ func TestReconcile(t *testing.T) {
 						Kind:       "Namespace",
 					}},
 					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+					Sinks:      []sourcesv1.ApiServerSourceSink{{Sink: otherSinkDest}},
 				}),
 				rttestingv1.WithApiServerSourceUID(sourceUID),
 				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
 				// Status Update:
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
-				rttestingv1.WithApiServerSourceSinkNotFound,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotFound("Sink not found: %s",
+					`{"ref":{"kind":"Channel","namespace":"testnamespace","name":"testothersink","apiVersion":"messaging.knative.dev/v1"}}`),
 			),
 		}},
 		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
@@ -365,7 +1025,10 @@ func TestReconcile(t *testing.T) {
 				// Status Update:
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 			),
 		}},
@@ -427,6 +1090,9 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceDeployed,
 				rttestingv1.WithApiServerSourceSink(sinkTargetURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
@@ -477,6 +1143,66 @@ func TestReconcile(t *testing.T) {
 				// Status Update:
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
+				rttestingv1.WithApiServerSourceSufficientPermissions,
+				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
+				rttestingv1.WithApiServerSourceDeploymentUnavailable,
+				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
+			),
+		}},
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: makeReceiveAdapter(t),
+		}},
+		WantCreates: []runtime.Object{
+			makeSubjectAccessReview("namespaces", "get", "default"),
+			makeSubjectAccessReview("namespaces", "list", "default"),
+			makeSubjectAccessReview("namespaces", "watch", "default"),
+		},
+		WithReactors:            []clientgotesting.ReactionFunc{subjectAccessReviewCreateReactor(true)},
+		SkipNamespaceValidation: true, // SubjectAccessReview objects are cluster-scoped.
+	}, {
+		Name: "deployment update due to the scrape annotations",
+		Objects: []runtime.Object{
+			rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+			),
+			rttestingv1.NewChannel(sinkName, testNS,
+				rttestingv1.WithInitChannelConditions,
+				rttestingv1.WithChannelAddress(sinkDNS),
+			),
+			makeReceiveAdapterWithoutScrapeAnnotations(t),
+		},
+		Key: testNS + "/" + sourceName,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "ApiServerSourceDeploymentUpdated", `Deployment "apiserversource-test-apiserver-source-1234" updated`),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rttestingv1.NewApiServerSource(sourceName, testNS,
+				rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+					Resources: []sourcesv1.APIVersionKindSelector{{
+						APIVersion: "v1",
+						Kind:       "Namespace",
+					}},
+					SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+				}),
+				rttestingv1.WithApiServerSourceUID(sourceUID),
+				rttestingv1.WithApiServerSourceObjectMetaGeneration(generation),
+				// Status Update:
+				rttestingv1.WithInitApiServerSourceConditions,
+				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceDeploymentUnavailable,
@@ -538,6 +1264,9 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceDeploymentUnavailable,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
@@ -592,6 +1321,9 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceDeploymentUnavailable,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
@@ -643,6 +1375,9 @@ func TestReconcile(t *testing.T) {
 				rttestingv1.WithInitApiServerSourceConditions,
 				rttestingv1.WithApiServerSourceDeployed,
 				rttestingv1.WithApiServerSourceSink(sinkURI),
+				rttestingv1.WithApiServerSourceSinksNotConfigured,
+				rttestingv1.WithApiServerSourceDeadLetterSinkNotConfigured,
+				rttestingv1.WithApiServerSourceClusterNotConfigured,
 				rttestingv1.WithApiServerSourceSufficientPermissions,
 				rttestingv1.WithApiServerSourceReferenceModeEventTypes(source),
 				rttestingv1.WithApiServerSourceStatusObservedGeneration(generation),
@@ -661,7 +1396,16 @@ func TestReconcile(t *testing.T) {
 	table.Test(t, rttestingv1.MakeFactory(func(ctx context.Context, listers *rttestingv1.Listers, cmw configmap.Watcher) controller.Reconciler {
 		ctx = addressable.WithDuck(ctx)
 		r := &Reconciler{
-			kubeClientSet:       fakekubeclient.Get(ctx),
+			kubeClientSet:    fakekubeclient.Get(ctx),
+			dynamicClientSet: fakedynamicclient.Get(ctx),
+			namespaceLister:  listers.GetNamespaceLister(),
+			secretLister:     listers.GetSecretLister(),
+			probeCluster: func(_ context.Context, kubeconfig []byte) error {
+				if string(kubeconfig) == "unreachable" {
+					return errUnreachable
+				}
+				return nil
+			},
 			ceSource:            source,
 			receiveAdapterImage: image,
 			sinkResolver:        resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
@@ -712,6 +1456,53 @@ func makeReceiveAdapterWithName(t *testing.T, sourceName string) *appsv1.Deploym
 	return ra
 }
 
+func makeAvailableReceiveAdapterWithSpec(t *testing.T, spec sourcesv1.ApiServerSourceSpec, opts ...rttestingv1.ApiServerSourceOption) *appsv1.Deployment {
+	t.Helper()
+
+	src := rttestingv1.NewApiServerSource(sourceName, testNS, append([]rttestingv1.ApiServerSourceOption{
+		rttestingv1.WithApiServerSourceSpec(spec),
+		rttestingv1.WithApiServerSourceUID(sourceUID),
+	}, opts...)...)
+
+	args := resources.ReceiveAdapterArgs{
+		Image:   image,
+		Source:  src,
+		Labels:  resources.Labels(sourceName),
+		SinkURI: sinkURI.String(),
+		Configs: &reconcilersource.EmptyVarsGenerator{},
+	}
+
+	ra, err := resources.MakeReceiveAdapter(&args)
+	require.NoError(t, err)
+
+	rttesting.WithDeploymentAvailable()(ra)
+	return ra
+}
+
+func makeKubeconfigSecret(key, kubeconfig string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: kubeconfigSecretName},
+		Data:       map[string][]byte{key: []byte(kubeconfig)},
+	}
+}
+
+func makePauseConfigMap(paused string) *corev1.ConfigMap {
+	src := rttestingv1.NewApiServerSource(sourceName, testNS,
+		rttestingv1.WithApiServerSourceUID(sourceUID),
+		rttestingv1.WithApiServerSourceAnnotation(sourcesv1.PausedAnnotationKey, paused),
+	)
+	return resources.MakePauseConfigMap(src)
+}
+
+func makeThrottlingConfigMap(data map[string]string) *corev1.ConfigMap {
+	src := rttestingv1.NewApiServerSource(sourceName, testNS,
+		rttestingv1.WithApiServerSourceUID(sourceUID),
+	)
+	cm := resources.MakeThrottlingConfigMap(src)
+	cm.Data = data
+	return cm
+}
+
 func makeAvailableReceiveAdapter(t *testing.T) *appsv1.Deployment {
 	ra := makeReceiveAdapter(t)
 	rttesting.WithDeploymentAvailable()(ra)
@@ -794,6 +1585,14 @@ func makeReceiveAdapterWithDifferentEnv(t *testing.T) *appsv1.Deployment {
 	return ra
 }
 
+func makeReceiveAdapterWithoutScrapeAnnotations(t *testing.T) *appsv1.Deployment {
+	ra := makeReceiveAdapter(t)
+	ra.Spec.Template.Annotations = map[string]string{
+		"sidecar.istio.io/inject": "false",
+	}
+	return ra
+}
+
 func makeReceiveAdapterWithDifferentServiceAccount(t *testing.T, name string) *appsv1.Deployment {
 	ra := makeReceiveAdapter(t)
 	ra.Spec.Template.Spec.ServiceAccountName = name
@@ -807,10 +1606,14 @@ func makeReceiveAdapterWithDifferentContainerCount(t *testing.T) *appsv1.Deploym
 }
 
 func makeSubjectAccessReview(resource, verb, sa string) *authorizationv1.SubjectAccessReview {
+	return makeNamespacedSubjectAccessReview(testNS, resource, verb, sa)
+}
+
+func makeNamespacedSubjectAccessReview(namespace, resource, verb, sa string) *authorizationv1.SubjectAccessReview {
 	return &authorizationv1.SubjectAccessReview{
 		Spec: authorizationv1.SubjectAccessReviewSpec{
 			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Namespace: testNS,
+				Namespace: namespace,
 				Verb:      verb,
 				Group:     "",
 				Resource:  resource,
@@ -820,6 +1623,20 @@ func makeSubjectAccessReview(resource, verb, sa string) *authorizationv1.Subject
 	}
 }
 
+// forbiddenNamespacesReactor denies the SubjectAccessReviews in the
+// namespaces, and allows the others.
+func forbiddenNamespacesReactor(namespaces ...string) clientgotesting.ReactionFunc {
+	forbidden := sets.NewString(namespaces...)
+	return func(action clientgotesting.Action) (handled bool, ret runtime.Object, err error) {
+		if action.GetVerb() == "create" && action.GetResource().Resource == "subjectaccessreviews" {
+			ret := action.(clientgotesting.CreateAction).GetObject().DeepCopyObject().(*authorizationv1.SubjectAccessReview)
+			ret.Status.Allowed = !forbidden.Has(ret.Spec.ResourceAttributes.Namespace)
+			return true, ret, nil
+		}
+		return false, nil, nil
+	}
+}
+
 func subjectAccessReviewCreateReactor(allowed bool) clientgotesting.ReactionFunc {
 	return func(action clientgotesting.Action) (handled bool, ret runtime.Object, err error) {
 		if action.GetVerb() == "create" && action.GetResource().Resource == "subjectaccessreviews" {
@@ -830,3 +1647,253 @@ func subjectAccessReviewCreateReactor(allowed bool) clientgotesting.ReactionFunc
 		return false, nil, nil
 	}
 }
+
+func TestResolveKafkaSink(t *testing.T) {
+	kafkaSink := func(spec map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "eventing.knative.dev/v1alpha1",
+			"kind":       "KafkaSink",
+			"metadata": map[string]interface{}{
+				"name":      sinkName,
+				"namespace": testNS,
+			},
+			"spec": spec,
+		}}
+	}
+	kafkaSinkDest := &duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Name:       sinkName,
+			Namespace:  testNS,
+			Kind:       "KafkaSink",
+			APIVersion: "eventing.knative.dev/v1alpha1",
+		},
+	}
+
+	tests := map[string]struct {
+		dest    *duckv1.Destination
+		sink    *unstructured.Unstructured
+		want    *resources.KafkaSinkArgs
+		wantErr bool
+	}{
+		"not a KafkaSink": {
+			dest: sinkDest.DeepCopy(),
+		},
+		"uri": {
+			dest: &duckv1.Destination{URI: sinkURI},
+		},
+		"KafkaSink": {
+			dest: kafkaSinkDest,
+			sink: kafkaSink(map[string]interface{}{
+				"topic":            "events",
+				"bootstrapServers": []interface{}{"broker-1:9092", "broker-2:9092"},
+				"contentMode":      "structured",
+				"auth": map[string]interface{}{
+					"secret": map[string]interface{}{
+						"ref": map[string]interface{}{"name": "kafka-secret"},
+					},
+				},
+			}),
+			want: &resources.KafkaSinkArgs{
+				BootstrapServers: []string{"broker-1:9092", "broker-2:9092"},
+				Topic:            "events",
+				ContentMode:      "structured",
+				AuthSecretName:   "kafka-secret",
+			},
+		},
+		"KafkaSink without topic": {
+			dest: kafkaSinkDest,
+			sink: kafkaSink(map[string]interface{}{
+				"bootstrapServers": []interface{}{"broker-1:9092"},
+			}),
+			wantErr: true,
+		},
+		"missing KafkaSink": {
+			dest:    kafkaSinkDest,
+			wantErr: true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			var objects []runtime.Object
+			if tc.sink != nil {
+				objects = append(objects, tc.sink)
+			}
+			r := &Reconciler{
+				dynamicClientSet: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+					map[schema.GroupVersionResource]string{
+						{Group: "eventing.knative.dev", Version: "v1alpha1", Resource: "kafkasinks"}: "KafkaSinkList",
+					}, objects...),
+			}
+			got, err := r.resolveKafkaSink(context.Background(), tc.dest)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("resolveKafkaSink() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveAudiences(t *testing.T) {
+	addressable := func(name, audience string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "messaging.knative.dev/v1",
+			"kind":       "Channel",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": testNS,
+			},
+		}}
+		if audience != "" {
+			obj.Object["status"] = map[string]interface{}{
+				"address": map[string]interface{}{"audience": audience},
+			}
+		}
+		return obj
+	}
+	src := &sourcesv1.ApiServerSource{
+		ObjectMeta: metav1.ObjectMeta{Name: sourceName, Namespace: testNS},
+		Spec: sourcesv1.ApiServerSourceSpec{
+			SourceSpec: duckv1.SourceSpec{Sink: sinkDest},
+			Sinks: []sourcesv1.ApiServerSourceSink{{
+				Sink: duckv1.Destination{URI: sinkURI},
+			}, {
+				Sink: otherSinkDest,
+			}},
+			Delivery: &eventingduckv1.DeliverySpec{DeadLetterSink: &deadLetterSinkDest},
+		},
+	}
+
+	// The dead letter sink does not exist. It is reported by the sink
+	// resolver rather than the audiences.
+	r := &Reconciler{
+		dynamicClientSet: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			addressable(sinkName, "sink-audience"), addressable("testothersink", "other-sink-audience")),
+	}
+	got, err := r.resolveAudiences(context.Background(), src)
+	if err != nil {
+		t.Fatal("resolveAudiences() =", err)
+	}
+	require.Equal(t, resources.SinkAudiences{
+		Sink:  "sink-audience",
+		Sinks: []string{"", "other-sink-audience"},
+	}, got)
+}
+
+func TestTemplatedEventTypes(t *testing.T) {
+	eventTypes := []string{
+		apisources.ApiServerSourceAddEventType,
+		apisources.ApiServerSourceDeleteEventType,
+		apisources.ApiServerSourceBatchEventType,
+	}
+	tests := map[string]struct {
+		resources []sourcesv1.APIVersionKindSelector
+		want      []string
+	}{
+		"no template": {
+			resources: []sourcesv1.APIVersionKindSelector{{APIVersion: "v1", Kind: "Pod"}},
+			want:      eventTypes,
+		},
+		"template": {
+			resources: []sourcesv1.APIVersionKindSelector{{APIVersion: "apps/v1", Kind: "Deployment", EventTypeTemplate: "com.mycorp.{kind}.{verb}"}},
+			want: []string{
+				apisources.ApiServerSourceBatchEventType,
+				"com.mycorp.deployment.add",
+				"com.mycorp.deployment.delete",
+			},
+		},
+		"template and defaults": {
+			resources: []sourcesv1.APIVersionKindSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", EventTypeTemplate: "com.mycorp.{kind}.{verb}"},
+				{APIVersion: "v1", Kind: "Pod"},
+			},
+			want: []string{
+				apisources.ApiServerSourceBatchEventType,
+				apisources.ApiServerSourceAddEventType,
+				apisources.ApiServerSourceDeleteEventType,
+				"com.mycorp.deployment.add",
+				"com.mycorp.deployment.delete",
+			},
+		},
+		"verbs": {
+			resources: []sourcesv1.APIVersionKindSelector{{APIVersion: "v1", Kind: "Pod", Verbs: []string{sourcesv1.DeleteVerb}}},
+			want: []string{
+				apisources.ApiServerSourceDeleteEventType,
+				apisources.ApiServerSourceBatchEventType,
+			},
+		},
+		"verbs and all verbs": {
+			resources: []sourcesv1.APIVersionKindSelector{
+				{APIVersion: "v1", Kind: "Pod", Verbs: []string{sourcesv1.DeleteVerb}},
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+			},
+			want: []string{
+				apisources.ApiServerSourceDeleteEventType,
+				apisources.ApiServerSourceBatchEventType,
+				apisources.ApiServerSourceAddEventType,
+			},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			src := &sourcesv1.ApiServerSource{Spec: sourcesv1.ApiServerSourceSpec{Resources: tc.resources}}
+			require.Equal(t, tc.want, templatedEventTypes(src, eventTypes))
+		})
+	}
+}
+
+func TestEventTypeSchemas(t *testing.T) {
+	tests := map[string]struct {
+		mode      string
+		resources []sourcesv1.APIVersionKindSelector
+		want      []eventTypeEntry
+	}{
+		"reference mode": {
+			mode:      sourcesv1.ReferenceMode,
+			resources: []sourcesv1.APIVersionKindSelector{{APIVersion: "v1", Kind: "Pod"}},
+		},
+		"group": {
+			mode:      sourcesv1.ResourceMode,
+			resources: []sourcesv1.APIVersionKindSelector{{APIVersion: "apps/v1", Kind: "Deployment"}},
+			want: []eventTypeEntry{
+				{Type: apisources.ApiServerSourceAddEventType, Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+				{Type: apisources.ApiServerSourceDeleteEventType, Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+				{Type: apisources.ApiServerSourceUpdateEventType, Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+			},
+		},
+		"shared types": {
+			mode: sourcesv1.ResourceMode,
+			resources: []sourcesv1.APIVersionKindSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+				{APIVersion: "v1", Kind: "Pod"},
+			},
+		},
+		"templates": {
+			mode: sourcesv1.ResourceMode,
+			resources: []sourcesv1.APIVersionKindSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", EventTypeTemplate: "com.mycorp.{kind}.{verb}"},
+				{APIVersion: "v1", Kind: "Pod", EventTypeTemplate: "com.mycorp.{kind}.{verb}"},
+			},
+			want: []eventTypeEntry{
+				{Type: "com.mycorp.deployment.add", Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+				{Type: "com.mycorp.deployment.delete", Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+				{Type: "com.mycorp.deployment.update", Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+				{Type: "com.mycorp.pod.add", Schema: "https://api/openapi/v3/api/v1#Pod"},
+				{Type: "com.mycorp.pod.delete", Schema: "https://api/openapi/v3/api/v1#Pod"},
+				{Type: "com.mycorp.pod.update", Schema: "https://api/openapi/v3/api/v1#Pod"},
+			},
+		},
+		"verbs": {
+			mode:      sourcesv1.ResourceMode,
+			resources: []sourcesv1.APIVersionKindSelector{{APIVersion: "apps/v1", Kind: "Deployment", Verbs: []string{sourcesv1.DeleteVerb}}},
+			want: []eventTypeEntry{
+				{Type: apisources.ApiServerSourceDeleteEventType, Schema: "https://api/openapi/v3/apis/apps/v1#Deployment"},
+			},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			src := &sourcesv1.ApiServerSource{Spec: sourcesv1.ApiServerSourceSpec{EventMode: tc.mode, Resources: tc.resources}}
+			require.Equal(t, tc.want, eventTypeSchemas(src, "https://api/"))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserversource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"knative.dev/pkg/kmeta"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	listers "knative.dev/eventing/pkg/client/listers/sources/v1"
+)
+
+const (
+	// clusterProbeTimeout bounds the requests probing the remote clusters.
+	clusterProbeTimeout = 10 * time.Second
+	// clusterProbeInterval is how often the connection to the remote
+	// clusters is probed again.
+	clusterProbeInterval = 5 * time.Minute
+)
+
+// clusterProbe returns an error when the cluster of kubeconfig cannot be
+// reached with its credentials.
+type clusterProbe func(ctx context.Context, kubeconfig []byte) error
+
+// probeCluster gets the version of the API server of the cluster of
+// kubeconfig.
+func probeCluster(ctx context.Context, kubeconfig []byte) error {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to load the kubeconfig: %w", err)
+	}
+	config.Timeout = clusterProbeTimeout
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return err
+	}
+	_, err = client.ServerVersion()
+	return err
+}
+
+// reconcileCluster probes the connection to the remote cluster of src, with
+// the kubeconfig of its Secret.
+func (r *Reconciler) reconcileCluster(ctx context.Context, src *v1.ApiServerSource) error {
+	c := src.Spec.Cluster
+	if c == nil {
+		src.Status.MarkClusterNotConfigured()
+		return nil
+	}
+
+	ref := c.KubeconfigSecretRef
+	secret, err := r.secretLister.Secrets(src.Namespace).Get(ref.Name)
+	if apierrors.IsNotFound(err) {
+		src.Status.MarkClusterDisconnected("KubeconfigNotFound", "Secret %q not found", ref.Name)
+		return fmt.Errorf("the kubeconfig Secret %q of the cluster %q was not found", ref.Name, c.Name)
+	} else if err != nil {
+		return fmt.Errorf("error getting the kubeconfig Secret %q: %w", ref.Name, err)
+	}
+	kubeconfig, ok := secret.Data[ref.Key]
+	if !ok {
+		src.Status.MarkClusterDisconnected("KubeconfigNotFound", "Secret %q has no key %q", ref.Name, ref.Key)
+		return fmt.Errorf("the kubeconfig Secret %q of the cluster %q has no key %q", ref.Name, c.Name, ref.Key)
+	}
+
+	probe := r.probeCluster
+	if probe == nil {
+		probe = probeCluster
+	}
+	if err := probe(ctx, kubeconfig); err != nil {
+		src.Status.MarkClusterDisconnected("ClusterUnreachable", "Cluster %q cannot be reached: %v", c.Name, err)
+		return fmt.Errorf("the cluster %q cannot be reached: %w", c.Name, err)
+	}
+	src.Status.MarkClusterConnected()
+	return nil
+}
+
+// enqueueSourcesOfSecret enqueues the sources whose remote cluster the
+// kubeconfig of the Secret obj is for, so they connect to it again when it
+// changes.
+func enqueueSourcesOfSecret(lister listers.ApiServerSourceLister, enqueue func(interface{})) func(obj interface{}) {
+	return func(obj interface{}) {
+		secret, err := kmeta.DeletionHandlingAccessor(obj)
+		if err != nil {
+			return
+		}
+		sources, err := lister.ApiServerSources(secret.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			return
+		}
+		for _, src := range sources {
+			if c := src.Spec.Cluster; c != nil && c.KubeconfigSecretRef.Name == secret.GetName() {
+				enqueue(src)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserversource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	rttestingv1 "knative.dev/eventing/pkg/reconciler/testing/v1"
+)
+
+const kubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: %s
+contexts:
+- name: remote
+  context:
+    cluster: remote
+current-context: remote
+`
+
+func TestProbeCluster(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major":"1","minor":"24","gitVersion":"v1.24.0"}`))
+	}))
+	defer server.Close()
+
+	if err := probeCluster(context.Background(), []byte(fmt.Sprintf(kubeconfigTemplate, server.URL))); err != nil {
+		t.Error("probeCluster() =", err)
+	}
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+	if err := probeCluster(context.Background(), []byte(fmt.Sprintf(kubeconfigTemplate, unreachable.URL))); err == nil {
+		t.Error("probeCluster() = nil, want an error for an unreachable cluster")
+	}
+
+	if err := probeCluster(context.Background(), []byte("not: [a kubeconfig")); err == nil {
+		t.Error("probeCluster() = nil, want an error for an invalid kubeconfig")
+	}
+}
+
+func TestEnqueueSourcesOfSecret(t *testing.T) {
+	withCluster := func(secret string) rttestingv1.ApiServerSourceOption {
+		return rttestingv1.WithApiServerSourceSpec(sourcesv1.ApiServerSourceSpec{
+			Cluster: &sourcesv1.ApiServerSourceCluster{
+				Name: "remote",
+				KubeconfigSecretRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				},
+			},
+		})
+	}
+	listers := rttestingv1.NewListers([]runtime.Object{
+		rttestingv1.NewApiServerSource("match", testNS, withCluster(kubeconfigSecretName)),
+		rttestingv1.NewApiServerSource("other-secret", testNS, withCluster("other")),
+		rttestingv1.NewApiServerSource("other-namespace", "other", withCluster(kubeconfigSecretName)),
+		rttestingv1.NewApiServerSource("local", testNS),
+	})
+
+	var enqueued []string
+	handler := enqueueSourcesOfSecret(listers.GetApiServerSourceLister(), func(obj interface{}) {
+		enqueued = append(enqueued, obj.(*sourcesv1.ApiServerSource).Name)
+	})
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: kubeconfigSecretName}}
+	handler(secret)
+	// The deleted Secrets are handled as well.
+	handler(cache.DeletedFinalStateUnknown{Key: testNS + "/" + kubeconfigSecretName, Obj: secret})
+
+	if want := []string{"match", "match"}; fmt.Sprint(enqueued) != fmt.Sprint(want) {
+		t.Errorf("enqueued = %v, want %v", enqueued, want)
+	}
+}
@@ -27,10 +27,15 @@ import (
 	"knative.dev/pkg/resolver"
 
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/reconciler/apiserversource/resources"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
+	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret"
+	"knative.dev/pkg/injection/clients/dynamicclient"
 
 	apiserversourceinformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1/apiserversource"
 	apiserversourcereconciler "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/apiserversource"
@@ -52,11 +57,17 @@ func NewController(
 
 	deploymentInformer := deploymentinformer.Get(ctx)
 	apiServerSourceInformer := apiserversourceinformer.Get(ctx)
+	namespaceInformer := namespaceinformer.Get(ctx)
+	configMapInformer := configmapinformer.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
 
 	r := &Reconciler{
-		kubeClientSet: kubeclient.Get(ctx),
-		ceSource:      GetCfgHost(ctx),
-		configs:       reconcilersource.WatchConfigurations(ctx, component, cmw),
+		kubeClientSet:    kubeclient.Get(ctx),
+		dynamicClientSet: dynamicclient.Get(ctx),
+		namespaceLister:  namespaceInformer.Lister(),
+		secretLister:     secretInformer.Lister(),
+		ceSource:         GetCfgHost(ctx),
+		configs:          reconcilersource.WatchConfigurations(ctx, component, cmw),
 	}
 
 	env := &envConfig{}
@@ -76,5 +87,23 @@ func NewController(
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	// The receive adapters report their throttling in their ConfigMaps.
+	configMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			return resources.IsThrottlingConfigMap(obj) && controller.FilterController(&v1.ApiServerSource{})(obj)
+		},
+		Handler: controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	// The namespaces the sources select can change with the namespaces.
+	namespaceInformer.Informer().AddEventHandler(controller.HandleAll(func(interface{}) {
+		impl.GlobalResync(apiServerSourceInformer.Informer())
+	}))
+
+	// The sources connect to their remote cluster again when the Secret
+	// holding its kubeconfig changes, such as when it is rotated.
+	secretInformer.Informer().AddEventHandler(controller.HandleAll(
+		enqueueSourcesOfSecret(apiServerSourceInformer.Lister(), impl.Enqueue)))
+
 	return impl
 }
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/kmeta"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// CheckpointConfigMapName returns the name of the ConfigMap the receive
+// adapter of source stores its checkpoint in.
+func CheckpointConfigMapName(source *v1.ApiServerSource) string {
+	if source.Spec.Checkpoint != nil && source.Spec.Checkpoint.ConfigMapName != "" {
+		return source.Spec.Checkpoint.ConfigMapName
+	}
+	return kmeta.ChildName(source.Name, "-checkpoint")
+}
+
+// MakeCheckpointConfigMap generates (but does not insert into K8s) the empty
+// ConfigMap the receive adapter of source stores its checkpoint in.
+func MakeCheckpointConfigMap(source *v1.ApiServerSource) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: source.Namespace,
+			Name:      CheckpointConfigMapName(source),
+			Labels:    Labels(source.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(source),
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing/pkg/adapter/apiserver"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// PauseConfigMapName returns the name of the ConfigMap the emission of the
+// events of the receive adapter of source is paused and resumed through.
+func PauseConfigMapName(source *v1.ApiServerSource) string {
+	return kmeta.ChildName(source.Name, "-pause")
+}
+
+// IsPausable returns whether the emission of the events of source is paused
+// and resumed through its pause ConfigMap, which is the case once it has the
+// v1.PausedAnnotationKey annotation, whatever its value.
+func IsPausable(source *v1.ApiServerSource) bool {
+	_, ok := source.Annotations[v1.PausedAnnotationKey]
+	return ok
+}
+
+// MakePauseConfigMap generates (but does not insert into K8s) the ConfigMap
+// the emission of the events of the receive adapter of source is paused and
+// resumed through, from the annotations of source.
+func MakePauseConfigMap(source *v1.ApiServerSource) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: source.Namespace,
+			Name:      PauseConfigMapName(source),
+			Labels:    Labels(source.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(source),
+			},
+		},
+		Data: map[string]string{
+			apiserver.PausedKey:         strconv.FormatBool(source.Annotations[v1.PausedAnnotationKey] == "true"),
+			apiserver.ReplayOnResumeKey: strconv.FormatBool(source.Annotations[v1.ReplayOnResumeAnnotationKey] == "true"),
+		},
+	}
+}
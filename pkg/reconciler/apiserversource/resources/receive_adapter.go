@@ -19,6 +19,8 @@ package resources
 import (
 	"encoding/json"
 	"fmt"
+	"path"
+	"strconv"
 
 	"knative.dev/eventing/pkg/adapter/v2"
 
@@ -28,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/ptr"
@@ -38,14 +41,93 @@ import (
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
 )
 
+const (
+	// kafkaAuthVolumeName is the name of the volume of the Secret holding the
+	// TLS and SASL configuration of the connection to the Kafka brokers.
+	kafkaAuthVolumeName = "kafka-auth"
+	// kafkaAuthPath is the directory the keys of that Secret are mounted in.
+	kafkaAuthPath = "/etc/kafka-auth"
+
+	// metricsPort is the port the metrics of the receive adapter are served
+	// on, in the Prometheus format.
+	metricsPort = 9090
+
+	// oidcTokensVolumeName is the name of the volume of the ServiceAccount
+	// tokens for the OIDC audiences of the sinks.
+	oidcTokensVolumeName = "oidc-tokens"
+	// oidcTokensPath is the directory those tokens are projected in.
+	oidcTokensPath = "/var/run/knative/oidc"
+	// oidcTokenExpirationSeconds is the requested lifetime of those tokens.
+	// The kubelet rotates them before they expire.
+	oidcTokenExpirationSeconds = int64(3600)
+
+	// caCertsVolumeName is the name of the volume of the CA certificates the
+	// sinks' certificates are verified with.
+	caCertsVolumeName = "tls-ca-certs"
+	// caCertsPath is the directory they are mounted in, in caCertsFileName.
+	caCertsPath     = "/etc/knative/tls/ca"
+	caCertsFileName = "ca.crt"
+	// clientCertVolumeName is the name of the volume of the Secret holding
+	// the client certificate presented to the sinks.
+	clientCertVolumeName = "tls-client-cert"
+	// clientCertPath is the directory the keys of that Secret are mounted in.
+	clientCertPath = "/etc/knative/tls/client"
+
+	// kubeconfigVolumeName is the name of the volume of the kubeconfig of
+	// the remote cluster the resources are watched in.
+	kubeconfigVolumeName = "cluster-kubeconfig"
+	// kubeconfigPath is the directory it is mounted in, in
+	// kubeconfigFileName. The kubelet updates it when the Secret is rotated.
+	kubeconfigPath     = "/etc/knative/cluster"
+	kubeconfigFileName = "kubeconfig"
+)
+
 // ReceiveAdapterArgs are the arguments needed to create a ApiServer Receive Adapter.
-// Every field is required.
+// Every field is required, but Kafka.
 type ReceiveAdapterArgs struct {
-	Image   string
-	Source  *v1.ApiServerSource
-	Labels  map[string]string
-	SinkURI string
-	Configs reconcilersource.ConfigAccessor
+	Image             string
+	Source            *v1.ApiServerSource
+	Labels            map[string]string
+	SinkURI           string
+	SinkURIs          []string
+	DeadLetterSinkURI string
+	Configs           reconcilersource.ConfigAccessor
+
+	// Kafka is the Kafka topic the events are written to rather than sent
+	// to the sink over HTTP, when the sink is a KafkaSink.
+	Kafka *KafkaSinkArgs
+
+	// Audiences are the OIDC audiences of the sinks. The events sent to a
+	// sink with an audience are authenticated with a token for it.
+	Audiences SinkAudiences
+}
+
+// SinkAudiences are the OIDC audiences of the sinks, "" for the sinks which
+// do not require authentication.
+type SinkAudiences struct {
+	Sink           string
+	Sinks          []string
+	DeadLetterSink string
+}
+
+// unique returns the sorted set of the audiences which are not "".
+func (a SinkAudiences) unique() []string {
+	set := sets.NewString(a.Sink, a.DeadLetterSink)
+	set.Insert(a.Sinks...)
+	set.Delete("")
+	return set.List()
+}
+
+// KafkaSinkArgs are the arguments of the Kafka topic of a KafkaSink.
+type KafkaSinkArgs struct {
+	BootstrapServers []string
+	Topic            string
+	ContentMode      string
+
+	// AuthSecretName is the name of the Secret holding the TLS and SASL
+	// configuration of the connection to the brokers. The connection uses
+	// plaintext when it is empty.
+	AuthSecretName string
 }
 
 // MakeReceiveAdapter generates (but does not insert into K8s) the Receive Adapter Deployment for
@@ -58,7 +140,7 @@ func MakeReceiveAdapter(args *ReceiveAdapterArgs) (*appsv1.Deployment, error) {
 		return nil, fmt.Errorf("error generating env vars: %w", err)
 	}
 
-	return &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: args.Source.Namespace,
 			Name:      kmeta.ChildName(fmt.Sprintf("apiserversource-%s-", args.Source.Name), string(args.Source.GetUID())),
@@ -76,6 +158,11 @@ func MakeReceiveAdapter(args *ReceiveAdapterArgs) (*appsv1.Deployment, error) {
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
 						"sidecar.istio.io/inject": "false", // needs to talk to the api server.
+						// The metrics of the events are scraped from the
+						// metrics port.
+						"prometheus.io/scrape": "true",
+						"prometheus.io/port":   strconv.Itoa(metricsPort),
+						"prometheus.io/path":   "/metrics",
 					},
 					Labels: args.Labels,
 				},
@@ -89,7 +176,7 @@ func MakeReceiveAdapter(args *ReceiveAdapterArgs) (*appsv1.Deployment, error) {
 							Env:   env,
 							Ports: []corev1.ContainerPort{{
 								Name:          "metrics",
-								ContainerPort: 9090,
+								ContainerPort: metricsPort,
 							}, {
 								Name:          "health",
 								ContainerPort: 8080,
@@ -106,15 +193,177 @@ func MakeReceiveAdapter(args *ReceiveAdapterArgs) (*appsv1.Deployment, error) {
 				},
 			},
 		},
-	}, nil
+	}
+	if args.Kafka != nil && args.Kafka.AuthSecretName != "" {
+		podSpec := &deployment.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: kafkaAuthVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: args.Kafka.AuthSecretName},
+			},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      kafkaAuthVolumeName,
+			MountPath: kafkaAuthPath,
+			ReadOnly:  true,
+		})
+	}
+	if t := args.Source.Spec.TLS; t != nil {
+		podSpec := &deployment.Spec.Template.Spec
+		if b := t.CACerts; b != nil {
+			volume := corev1.Volume{Name: caCertsVolumeName}
+			if ref := b.ConfigMapKeyRef; ref != nil {
+				volume.ConfigMap = &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: ref.LocalObjectReference,
+					Items:                []corev1.KeyToPath{{Key: ref.Key, Path: caCertsFileName}},
+					Optional:             ref.Optional,
+				}
+			} else if ref := b.SecretKeyRef; ref != nil {
+				volume.Secret = &corev1.SecretVolumeSource{
+					SecretName: ref.Name,
+					Items:      []corev1.KeyToPath{{Key: ref.Key, Path: caCertsFileName}},
+					Optional:   ref.Optional,
+				}
+			}
+			podSpec.Volumes = append(podSpec.Volumes, volume)
+			podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      caCertsVolumeName,
+				MountPath: caCertsPath,
+				ReadOnly:  true,
+			})
+		}
+		if t.ClientCertSecretName != "" {
+			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+				Name: clientCertVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: t.ClientCertSecretName},
+				},
+			})
+			podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      clientCertVolumeName,
+				MountPath: clientCertPath,
+				ReadOnly:  true,
+			})
+		}
+	}
+	if c := args.Source.Spec.Cluster; c != nil {
+		podSpec := &deployment.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: kubeconfigVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: c.KubeconfigSecretRef.Name,
+					Items:      []corev1.KeyToPath{{Key: c.KubeconfigSecretRef.Key, Path: kubeconfigFileName}},
+				},
+			},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      kubeconfigVolumeName,
+			MountPath: kubeconfigPath,
+			ReadOnly:  true,
+		})
+	}
+	if audiences := args.Audiences.unique(); len(audiences) > 0 {
+		sources := make([]corev1.VolumeProjection, 0, len(audiences))
+		for _, audience := range audiences {
+			sources = append(sources, corev1.VolumeProjection{
+				ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+					Audience:          audience,
+					ExpirationSeconds: ptr.Int64(oidcTokenExpirationSeconds),
+					Path:              adapter.OIDCTokenFileName(audience),
+				},
+			})
+		}
+		podSpec := &deployment.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: oidcTokensVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{Sources: sources},
+			},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      oidcTokensVolumeName,
+			MountPath: oidcTokensPath,
+			ReadOnly:  true,
+		})
+	}
+	return deployment, nil
 }
 
 func makeEnv(args *ReceiveAdapterArgs) ([]corev1.EnvVar, error) {
 	cfg := &apiserver.Config{
-		Namespace:     args.Source.Namespace,
-		Resources:     make([]apiserver.ResourceWatch, 0, len(args.Source.Spec.Resources)),
-		ResourceOwner: args.Source.Spec.ResourceOwner,
-		EventMode:     args.Source.Spec.EventMode,
+		Namespace:       args.Source.Namespace,
+		Resources:       make([]apiserver.ResourceWatch, 0, len(args.Source.Spec.Resources)),
+		ResourceOwner:   args.Source.Spec.ResourceOwner,
+		OwnerRefMode:    args.Source.Spec.OwnerRefMode,
+		EventMode:       args.Source.Spec.EventMode,
+		Format:          args.Source.Spec.Format,
+		FieldsToDrop:    args.Source.Spec.FieldsToDrop,
+		DataContentType: args.Source.Spec.DataContentType,
+		DataSchema:      args.Source.Spec.DataSchema,
+		Batch:           args.Source.Spec.Batch,
+		Delivery:        args.Source.Spec.Delivery,
+		DeadLetterSink:  args.DeadLetterSinkURI,
+		Filters:         args.Source.Spec.Filters,
+	}
+	if d := args.Source.Spec.OwnerRefMaxDepth; d != nil {
+		cfg.OwnerRefMaxDepth = *d
+	}
+	cfg.OwnerRefDeduplicationWindow = args.Source.Spec.OwnerRefDeduplicationWindow
+	if c := args.Source.Spec.Concurrency; c != nil {
+		cfg.Concurrency = *c
+	}
+	cfg.ExtensionExpressions = args.Source.Spec.ExtensionExpressions
+	cfg.Namespaces = args.Source.Spec.Namespaces
+	if s := args.Source.Spec.NamespaceSelector; s != nil {
+		selector, _ := metav1.LabelSelectorAsSelector(s)
+		cfg.NamespaceSelector = selector.String()
+	}
+	cfg.ResyncPeriod = args.Source.Spec.ResyncPeriod
+	cfg.DeadLetterSinkAudience = args.Audiences.DeadLetterSink
+	for i, uri := range args.SinkURIs {
+		sink := apiserver.SinkConfig{
+			URI:     uri,
+			Filters: args.Source.Spec.Sinks[i].Filters,
+		}
+		if i < len(args.Audiences.Sinks) {
+			sink.Audience = args.Audiences.Sinks[i]
+		}
+		cfg.Sinks = append(cfg.Sinks, sink)
+	}
+	if k := args.Kafka; k != nil {
+		cfg.Kafka = &apiserver.KafkaSinkConfig{
+			BootstrapServers: k.BootstrapServers,
+			Topic:            k.Topic,
+			ContentMode:      k.ContentMode,
+		}
+		if k.AuthSecretName != "" {
+			cfg.Kafka.AuthPath = kafkaAuthPath
+		}
+	}
+	if r := args.Source.Spec.RateLimit; r != nil {
+		cfg.RateLimit = &apiserver.RateLimitConfig{
+			ApiServerSourceRateLimit: *r,
+			ConfigMapName:            ThrottlingConfigMapName(args.Source),
+		}
+	}
+	if c := args.Source.Spec.Checkpoint; c != nil {
+		cfg.Checkpoint = &v1.ApiServerSourceCheckpoint{
+			ConfigMapName: CheckpointConfigMapName(args.Source),
+			Interval:      c.Interval,
+		}
+	}
+	if IsPausable(args.Source) {
+		cfg.Pause = &apiserver.PauseConfig{
+			ConfigMapName: PauseConfigMapName(args.Source),
+		}
+	}
+	cfg.Acknowledgement = args.Source.Spec.Acknowledgement
+	if c := args.Source.Spec.Cluster; c != nil {
+		cfg.Cluster = &apiserver.ClusterConfig{
+			Name:           c.Name,
+			KubeconfigFile: path.Join(kubeconfigPath, kubeconfigFileName),
+		}
 	}
 
 	for _, r := range args.Source.Spec.Resources {
@@ -124,7 +373,14 @@ func makeEnv(args *ReceiveAdapterArgs) ([]corev1.EnvVar, error) {
 		}
 		gvr, _ := meta.UnsafeGuessKindToResource(gv.WithKind(r.Kind))
 
-		rw := apiserver.ResourceWatch{GVR: gvr}
+		rw := apiserver.ResourceWatch{
+			GVR:               gvr,
+			FieldSelector:     r.FieldSelector,
+			ClassifyUpdates:   r.ClassifyUpdates,
+			Subresource:       r.Subresource,
+			EventTypeTemplate: r.EventTypeTemplate,
+			Verbs:             r.Verbs,
+		}
 
 		if r.LabelSelector != nil {
 			selector, _ := metav1.LabelSelectorAsSelector(r.LabelSelector)
@@ -165,6 +421,27 @@ func makeEnv(args *ReceiveAdapterArgs) ([]corev1.EnvVar, error) {
 
 	envs = append(envs, args.Configs.ToEnvVars()...)
 
+	if t := args.Source.Spec.TLS; t != nil {
+		if t.CACerts != nil {
+			envs = append(envs, corev1.EnvVar{Name: adapter.EnvConfigCACertsFile, Value: path.Join(caCertsPath, caCertsFileName)})
+		}
+		if t.ClientCertSecretName != "" {
+			envs = append(envs, corev1.EnvVar{
+				Name:  adapter.EnvConfigClientCertFile,
+				Value: path.Join(clientCertPath, corev1.TLSCertKey),
+			}, corev1.EnvVar{
+				Name:  adapter.EnvConfigClientKeyFile,
+				Value: path.Join(clientCertPath, corev1.TLSPrivateKeyKey),
+			})
+		}
+	}
+	if len(args.Audiences.unique()) > 0 {
+		envs = append(envs, corev1.EnvVar{Name: adapter.EnvConfigOIDCTokensDir, Value: oidcTokensPath})
+	}
+	if args.Audiences.Sink != "" {
+		envs = append(envs, corev1.EnvVar{Name: adapter.EnvConfigSinkAudience, Value: args.Audiences.Sink})
+	}
+
 	if args.Source.Spec.CloudEventOverrides != nil {
 		ceJson, err := json.Marshal(args.Source.Spec.CloudEventOverrides)
 		if err != nil {
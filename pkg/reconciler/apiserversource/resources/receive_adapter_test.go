@@ -18,6 +18,7 @@ package resources
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -31,6 +32,9 @@ import (
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/ptr"
 
+	"knative.dev/eventing/pkg/adapter/v2"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/eventing/pkg/reconciler/source"
 
@@ -48,26 +52,65 @@ func TestMakeReceiveAdapters(t *testing.T) {
 			Name:      name,
 			Namespace: "source-namespace",
 			UID:       "1234",
+			Annotations: map[string]string{
+				v1.PausedAnnotationKey: "false",
+			},
 		},
 		Spec: v1.ApiServerSourceSpec{
 			Resources: []v1.APIVersionKindSelector{{
-				APIVersion: "",
-				Kind:       "Namespace",
+				APIVersion:        "",
+				Kind:              "Namespace",
+				EventTypeTemplate: "com.mycorp.{kind}.{verb}",
 			}, {
-				APIVersion: "batch/v1",
-				Kind:       "Job",
+				APIVersion:  "batch/v1",
+				Kind:        "Job",
+				Subresource: v1.ScaleSubresource,
+				Verbs:       []string{v1.DeleteVerb},
 			}, {
 				APIVersion: "",
 				Kind:       "Pod",
 				LabelSelector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"test-key1": "test-value1"},
 				},
+				FieldSelector:   "status.phase=Running",
+				ClassifyUpdates: true,
 			}},
+			Namespaces: []string{"apps"},
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "web"},
+			},
 			ResourceOwner: &v1.APIVersionKind{
 				APIVersion: "custom/v1",
 				Kind:       "Parent",
 			},
-			EventMode:          "Resource",
+			OwnerRefMode:                v1.OwnerRefModeController,
+			OwnerRefMaxDepth:            ptr.Int32(3),
+			Concurrency:                 ptr.Int32(4),
+			OwnerRefDeduplicationWindow: "5s",
+			ExtensionExpressions:        map[string]string{"app": "labels['app']"},
+			ResyncPeriod:                "1h",
+			EventMode:                   "Resource",
+			Format:                      v1.KubernetesEventFormat,
+			FieldsToDrop:                []string{"metadata.managedFields"},
+			Delivery: &eventingduckv1.DeliverySpec{
+				Retry:        ptr.Int32(3),
+				BackoffDelay: ptr.String("PT0.1S"),
+			},
+			RateLimit: &v1.ApiServerSourceRateLimit{
+				EventsPerSecond: 10,
+				Burst:           20,
+				OverflowPolicy:  v1.OverflowPolicyDrop,
+			},
+			Checkpoint:      &v1.ApiServerSourceCheckpoint{Interval: "10s"},
+			Acknowledgement: &v1.ApiServerSourceAcknowledgement{PatchesPerSecond: 5},
+			Filters: []eventingv1.SubscriptionsAPIFilter{{
+				Prefix: map[string]string{"type": "dev.knative.apiserver.resource."},
+			}},
+			Sinks: []v1.ApiServerSourceSink{{
+				Filters: []eventingv1.SubscriptionsAPIFilter{{
+					Exact: map[string]string{"type": "dev.knative.apiserver.resource.delete"},
+				}},
+			}},
 			ServiceAccountName: "source-svc-acct",
 		},
 	}
@@ -103,6 +146,9 @@ func TestMakeReceiveAdapters(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
 						"sidecar.istio.io/inject": "false",
+						"prometheus.io/scrape":    "true",
+						"prometheus.io/port":      "9090",
+						"prometheus.io/path":      "/metrics",
 					},
 					Labels: map[string]string{
 						"test-key1": "test-value1",
@@ -129,7 +175,7 @@ func TestMakeReceiveAdapters(t *testing.T) {
 									Value: "sink-uri",
 								}, {
 									Name:  "K_SOURCE_CONFIG",
-									Value: `{"namespace":"source-namespace","resources":[{"gvr":{"Group":"","Version":"","Resource":"namespaces"}},{"gvr":{"Group":"batch","Version":"v1","Resource":"jobs"}},{"gvr":{"Group":"","Version":"","Resource":"pods"},"selector":"test-key1=test-value1"}],"owner":{"apiVersion":"custom/v1","kind":"Parent"},"mode":"Resource"}`,
+									Value: `{"namespace":"source-namespace","namespaces":["apps"],"namespaceSelector":"team=web","resources":[{"gvr":{"Group":"","Version":"","Resource":"namespaces"},"eventTypeTemplate":"com.mycorp.{kind}.{verb}"},{"gvr":{"Group":"batch","Version":"v1","Resource":"jobs"},"subresource":"scale","verbs":["delete"]},{"gvr":{"Group":"","Version":"","Resource":"pods"},"selector":"test-key1=test-value1","fieldSelector":"status.phase=Running","classifyUpdates":true}],"owner":{"apiVersion":"custom/v1","kind":"Parent"},"ownerRefMode":"Controller","ownerRefMaxDepth":3,"ownerRefDeduplicationWindow":"5s","extensionExpressions":{"app":"labels['app']"},"resyncPeriod":"1h","mode":"Resource","format":"KubernetesEvent","fieldsToDrop":["metadata.managedFields"],"delivery":{"retry":3,"backoffDelay":"PT0.1S"},"deadLetterSink":"dead-letter-sink-uri","concurrency":4,"rateLimit":{"eventsPerSecond":10,"burst":20,"overflowPolicy":"Drop","configMapName":"source-name-throttling"},"checkpoint":{"configMapName":"source-name-checkpoint","interval":"10s"},"pause":{"configMapName":"source-name-pause"},"acknowledgement":{"patchesPerSecond":5},"filters":[{"prefix":{"type":"dev.knative.apiserver.resource."}}],"sinks":[{"uri":"other-sink-uri","filters":[{"exact":{"type":"dev.knative.apiserver.resource.delete"}}]}]}`,
 								}, {
 									Name:  "SYSTEM_NAMESPACE",
 									Value: "knative-testing",
@@ -179,9 +225,152 @@ func TestMakeReceiveAdapters(t *testing.T) {
 		Value: `{"extensions":{"1":"one"}}`,
 	})
 
+	kafka := &KafkaSinkArgs{
+		BootstrapServers: []string{"broker-1:9092", "broker-2:9092"},
+		Topic:            "events",
+		ContentMode:      "structured",
+		AuthSecretName:   "kafka-secret",
+	}
+	kafkaWant := want.DeepCopy()
+	kafkaConfig := &kafkaWant.Spec.Template.Spec.Containers[0].Env[1]
+	kafkaConfig.Value = strings.TrimSuffix(kafkaConfig.Value, "}") +
+		`,"kafka":{"bootstrapServers":["broker-1:9092","broker-2:9092"],"topic":"events","contentMode":"structured","authPath":"/etc/kafka-auth"}}`
+	kafkaWant.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name: "kafka-auth",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "kafka-secret"},
+		},
+	}}
+	kafkaWant.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+		Name:      "kafka-auth",
+		MountPath: "/etc/kafka-auth",
+		ReadOnly:  true,
+	}}
+
+	audiences := SinkAudiences{
+		Sink:           "sink-audience",
+		Sinks:          []string{"other-sink-audience"},
+		DeadLetterSink: "sink-audience",
+	}
+	oidcWant := want.DeepCopy()
+	oidcContainer := &oidcWant.Spec.Template.Spec.Containers[0]
+	oidcConfig := &oidcContainer.Env[1]
+	oidcConfig.Value = strings.Replace(oidcConfig.Value, `"deadLetterSink":"dead-letter-sink-uri",`,
+		`"deadLetterSink":"dead-letter-sink-uri","deadLetterSinkAudience":"sink-audience",`, 1)
+	oidcConfig.Value = strings.Replace(oidcConfig.Value, `{"uri":"other-sink-uri",`,
+		`{"uri":"other-sink-uri","audience":"other-sink-audience",`, 1)
+	oidcContainer.Env = append(oidcContainer.Env, corev1.EnvVar{
+		Name:  "K_OIDC_TOKENS_DIR",
+		Value: "/var/run/knative/oidc",
+	}, corev1.EnvVar{
+		Name:  "K_SINK_AUDIENCE",
+		Value: "sink-audience",
+	})
+	oidcWant.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name: "oidc-tokens",
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          "other-sink-audience",
+						ExpirationSeconds: ptr.Int64(3600),
+						Path:              adapter.OIDCTokenFileName("other-sink-audience"),
+					},
+				}, {
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          "sink-audience",
+						ExpirationSeconds: ptr.Int64(3600),
+						Path:              adapter.OIDCTokenFileName("sink-audience"),
+					},
+				}},
+			},
+		},
+	}}
+	oidcContainer.VolumeMounts = []corev1.VolumeMount{{
+		Name:      "oidc-tokens",
+		MountPath: "/var/run/knative/oidc",
+		ReadOnly:  true,
+	}}
+
+	tlsSrc := src.DeepCopy()
+	tlsSrc.Spec.TLS = &v1.ApiServerSourceTLS{
+		CACerts: &v1.ApiServerSourceCABundle{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "trust-bundle"},
+				Key:                  "trust-bundle.pem",
+			},
+		},
+		ClientCertSecretName: "client-cert",
+	}
+	tlsWant := want.DeepCopy()
+	tlsContainer := &tlsWant.Spec.Template.Spec.Containers[0]
+	tlsContainer.Env = append(tlsContainer.Env, corev1.EnvVar{
+		Name:  "K_CA_CERTS_FILE",
+		Value: "/etc/knative/tls/ca/ca.crt",
+	}, corev1.EnvVar{
+		Name:  "K_CLIENT_CERT_FILE",
+		Value: "/etc/knative/tls/client/tls.crt",
+	}, corev1.EnvVar{
+		Name:  "K_CLIENT_KEY_FILE",
+		Value: "/etc/knative/tls/client/tls.key",
+	})
+	tlsWant.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name: "tls-ca-certs",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "trust-bundle"},
+				Items:                []corev1.KeyToPath{{Key: "trust-bundle.pem", Path: "ca.crt"}},
+			},
+		},
+	}, {
+		Name: "tls-client-cert",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "client-cert"},
+		},
+	}}
+	tlsContainer.VolumeMounts = []corev1.VolumeMount{{
+		Name:      "tls-ca-certs",
+		MountPath: "/etc/knative/tls/ca",
+		ReadOnly:  true,
+	}, {
+		Name:      "tls-client-cert",
+		MountPath: "/etc/knative/tls/client",
+		ReadOnly:  true,
+	}}
+
+	clusterSrc := src.DeepCopy()
+	clusterSrc.Spec.Cluster = &v1.ApiServerSourceCluster{
+		Name: "remote",
+		KubeconfigSecretRef: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "remote-kubeconfig"},
+			Key:                  "config",
+		},
+	}
+	clusterWant := want.DeepCopy()
+	clusterContainer := &clusterWant.Spec.Template.Spec.Containers[0]
+	clusterConfig := &clusterContainer.Env[1]
+	clusterConfig.Value = strings.TrimSuffix(clusterConfig.Value, "}") +
+		`,"cluster":{"name":"remote","kubeconfigFile":"/etc/knative/cluster/kubeconfig"}}`
+	clusterWant.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name: "cluster-kubeconfig",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: "remote-kubeconfig",
+				Items:      []corev1.KeyToPath{{Key: "config", Path: "kubeconfig"}},
+			},
+		},
+	}}
+	clusterContainer.VolumeMounts = []corev1.VolumeMount{{
+		Name:      "cluster-kubeconfig",
+		MountPath: "/etc/knative/cluster",
+		ReadOnly:  true,
+	}}
+
 	testCases := map[string]struct {
-		want *appsv1.Deployment
-		src  *v1.ApiServerSource
+		want      *appsv1.Deployment
+		src       *v1.ApiServerSource
+		kafka     *KafkaSinkArgs
+		audiences SinkAudiences
 	}{
 		"TestMakeReceiveAdapter": {
 
@@ -190,6 +379,20 @@ func TestMakeReceiveAdapters(t *testing.T) {
 		}, "TestMakeReceiveAdapterWithExtensionOverride": {
 			src:  ceSrc,
 			want: ceWant,
+		}, "TestMakeReceiveAdapterWithKafkaSink": {
+			src:   src,
+			kafka: kafka,
+			want:  kafkaWant,
+		}, "TestMakeReceiveAdapterWithOIDCAudiences": {
+			src:       src,
+			audiences: audiences,
+			want:      oidcWant,
+		}, "TestMakeReceiveAdapterWithTLS": {
+			src:  tlsSrc,
+			want: tlsWant,
+		}, "TestMakeReceiveAdapterWithCluster": {
+			src:  clusterSrc,
+			want: clusterWant,
 		},
 	}
 	for n, tc := range testCases {
@@ -202,8 +405,12 @@ func TestMakeReceiveAdapters(t *testing.T) {
 					"test-key1": "test-value1",
 					"test-key2": "test-value2",
 				},
-				SinkURI: "sink-uri",
-				Configs: &source.EmptyVarsGenerator{},
+				SinkURI:           "sink-uri",
+				SinkURIs:          []string{"other-sink-uri"},
+				DeadLetterSinkURI: "dead-letter-sink-uri",
+				Configs:           &source.EmptyVarsGenerator{},
+				Kafka:             tc.kafka,
+				Audiences:         tc.audiences,
 			})
 
 			if diff := cmp.Diff(tc.want, got); diff != "" {
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/kmeta"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+)
+
+// throttlingConfigMapLabel marks the ConfigMaps the receive adapters report
+// their throttling in, whose changes are reflected in the status of their
+// source.
+const throttlingConfigMapLabel = "sources.knative.dev/throttling"
+
+// ThrottlingConfigMapName returns the name of the ConfigMap the receive
+// adapter of source reports its throttling in.
+func ThrottlingConfigMapName(source *v1.ApiServerSource) string {
+	return kmeta.ChildName(source.Name, "-throttling")
+}
+
+// IsThrottlingConfigMap returns whether obj is a ConfigMap a receive adapter
+// reports its throttling in.
+func IsThrottlingConfigMap(obj interface{}) bool {
+	cm, ok := obj.(*corev1.ConfigMap)
+	return ok && cm.Labels[throttlingConfigMapLabel] == "true"
+}
+
+// MakeThrottlingConfigMap generates (but does not insert into K8s) the empty
+// ConfigMap the receive adapter of source reports its throttling in.
+func MakeThrottlingConfigMap(source *v1.ApiServerSource) *corev1.ConfigMap {
+	labels := Labels(source.Name)
+	labels[throttlingConfigMapLabel] = "true"
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: source.Namespace,
+			Name:      ThrottlingConfigMapName(source),
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(source),
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/reconciler/source"
+
+	_ "knative.dev/pkg/metrics/testing"
+	_ "knative.dev/pkg/system/testing"
+)
+
+func TestMakeReceiveAdapter(t *testing.T) {
+	name := "source-name"
+	src := &v1.BrokerStatusSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "source-namespace",
+			UID:       "1234",
+		},
+		Spec: v1.BrokerStatusSourceSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "payments"},
+			},
+			ServiceAccountName: "source-svc-acct",
+		},
+	}
+
+	wantEnv := []corev1.EnvVar{{
+		Name:  "K_SINK",
+		Value: "sink-uri",
+	}, {
+		Name:  "SELECTOR",
+		Value: "team=payments",
+	}, {
+		Name:  "SYSTEM_NAMESPACE",
+		Value: "knative-testing",
+	}, {
+		Name: "NAMESPACE",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{
+				FieldPath: "metadata.namespace",
+			},
+		},
+	}, {
+		Name:  "NAME",
+		Value: name,
+	}, {
+		Name:  "METRICS_DOMAIN",
+		Value: "knative.dev/eventing",
+	}, {
+		Name:  source.EnvLoggingCfg,
+		Value: "",
+	}, {
+		Name:  source.EnvMetricsCfg,
+		Value: "",
+	}, {
+		Name:  source.EnvTracingCfg,
+		Value: "",
+	}}
+
+	ceSrc := src.DeepCopy()
+	ceSrc.Spec.CloudEventOverrides = &duckv1.CloudEventOverrides{Extensions: map[string]string{"1": "one"}}
+	ceWantEnv := append(append([]corev1.EnvVar{}, wantEnv...), corev1.EnvVar{
+		Name:  "K_CE_OVERRIDES",
+		Value: `{"extensions":{"1":"one"}}`,
+	})
+
+	testCases := map[string]struct {
+		src     *v1.BrokerStatusSource
+		wantEnv []corev1.EnvVar
+	}{
+		"TestMakeReceiveAdapter": {
+			src:     src,
+			wantEnv: wantEnv,
+		},
+		"TestMakeReceiveAdapterWithExtensionOverride": {
+			src:     ceSrc,
+			wantEnv: ceWantEnv,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			labels := Labels(name)
+			got, err := MakeReceiveAdapter(&ReceiveAdapterArgs{
+				Image:   "test-image",
+				Source:  tc.src,
+				Labels:  labels,
+				SinkURI: "sink-uri",
+				Configs: &source.EmptyVarsGenerator{},
+			})
+			if err != nil {
+				t.Fatal("MakeReceiveAdapter() =", err)
+			}
+
+			if want := kmeta.ChildName(fmt.Sprintf("brokerstatussource-%s-", name), "1234"); got.Name != want {
+				t.Errorf("unexpected name, want %q, got %q", want, got.Name)
+			}
+			if !metav1.IsControlledBy(got, tc.src) {
+				t.Error("expected the deployment to be controlled by the source")
+			}
+			if diff := cmp.Diff(labels, got.Spec.Selector.MatchLabels); diff != "" {
+				t.Error("unexpected selector (-want, +got) =", diff)
+			}
+
+			podSpec := got.Spec.Template.Spec
+			if podSpec.ServiceAccountName != "source-svc-acct" {
+				t.Errorf("unexpected service account, want %q, got %q", "source-svc-acct", podSpec.ServiceAccountName)
+			}
+			if podSpec.Containers[0].Image != "test-image" {
+				t.Errorf("unexpected image, want %q, got %q", "test-image", podSpec.Containers[0].Image)
+			}
+			if diff := cmp.Diff(tc.wantEnv, podSpec.Containers[0].Env); diff != "" {
+				t.Error("unexpected env (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channelstatussource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
+
+	"knative.dev/eventing/pkg/apis/sources"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	channelstatussourcereconciler "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/channelstatussource"
+	"knative.dev/eventing/pkg/reconciler/channelstatussource/resources"
+	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
+)
+
+const (
+	// Name of the corev1.Events emitted from the reconciliation process
+	channelstatussourceDeploymentCreated = "ChannelStatusSourceDeploymentCreated"
+	channelstatussourceDeploymentUpdated = "ChannelStatusSourceDeploymentUpdated"
+
+	component = "channelstatussource"
+)
+
+func newWarningSinkNotFound(sink *duckv1.Destination) pkgreconciler.Event {
+	b, _ := json.Marshal(sink)
+	return pkgreconciler.NewEvent(corev1.EventTypeWarning, "SinkNotFound", "Sink not found: %s", string(b))
+}
+
+// Reconciler reconciles a ChannelStatusSource object
+type Reconciler struct {
+	kubeClientSet kubernetes.Interface
+
+	receiveAdapterImage string
+
+	sinkResolver *resolver.URIResolver
+
+	configs reconcilersource.ConfigAccessor
+}
+
+var _ channelstatussourcereconciler.Interface = (*Reconciler)(nil)
+
+func (r *Reconciler) ReconcileKind(ctx context.Context, source *v1.ChannelStatusSource) pkgreconciler.Event {
+	// This Source attempts to reconcile two things.
+	// 1. Determine the sink's URI.
+	//     - Nothing to delete.
+	// 2. Create a receive adapter in the form of a Deployment.
+	//     - Will be garbage collected by K8s when this ChannelStatusSource is deleted.
+	dest := source.Spec.Sink.DeepCopy()
+	if dest.Ref != nil {
+		// To call URIFromDestination(), dest.Ref must have a Namespace. If there is
+		// no Namespace defined in dest.Ref, we will use the Namespace of the source
+		// as the Namespace of dest.Ref.
+		if dest.Ref.Namespace == "" {
+			dest.Ref.Namespace = source.GetNamespace()
+		}
+	}
+
+	sinkURI, err := r.sinkResolver.URIFromDestinationV1(ctx, *dest, source)
+	if err != nil {
+		source.Status.MarkNoSink("NotFound", "")
+		return newWarningSinkNotFound(dest)
+	}
+	source.Status.MarkSink(sinkURI)
+
+	ra, err := r.createReceiveAdapter(ctx, source, sinkURI.String())
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to create the receive adapter", zap.Error(err))
+		return err
+	}
+	source.Status.PropagateDeploymentAvailability(ra)
+
+	source.Status.CloudEventAttributes = []duckv1.CloudEventAttributes{{
+		Type:   sources.ChannelStatusSourceEventType,
+		Source: v1.ChannelStatusSourceSource(source.Namespace, source.Name),
+	}}
+	return nil
+}
+
+func (r *Reconciler) createReceiveAdapter(ctx context.Context, src *v1.ChannelStatusSource, sinkURI string) (*appsv1.Deployment, error) {
+	expected, err := resources.MakeReceiveAdapter(&resources.ReceiveAdapterArgs{
+		Image:   r.receiveAdapterImage,
+		Source:  src,
+		Labels:  resources.Labels(src.Name),
+		SinkURI: sinkURI,
+		Configs: r.configs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ra, err := r.kubeClientSet.AppsV1().Deployments(src.Namespace).Get(ctx, expected.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ra, err = r.kubeClientSet.AppsV1().Deployments(src.Namespace).Create(ctx, expected, metav1.CreateOptions{})
+		msg := "Deployment created"
+		if err != nil {
+			msg = fmt.Sprint("Deployment created, error:", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, channelstatussourceDeploymentCreated, "%s", msg)
+		return ra, err
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting receive adapter: %v", err)
+	} else if !metav1.IsControlledBy(ra, src) {
+		return nil, fmt.Errorf("deployment %q is not owned by ChannelStatusSource %q", ra.Name, src.Name)
+	} else if podSpecChanged(ra.Spec.Template.Spec, expected.Spec.Template.Spec) {
+		ra.Spec.Template.Spec = expected.Spec.Template.Spec
+		if ra, err = r.kubeClientSet.AppsV1().Deployments(src.Namespace).Update(ctx, ra, metav1.UpdateOptions{}); err != nil {
+			return ra, err
+		}
+		controller.GetEventRecorder(ctx).Eventf(src, corev1.EventTypeNormal, channelstatussourceDeploymentUpdated, "Deployment %q updated", ra.Name)
+		return ra, nil
+	} else {
+		logging.FromContext(ctx).Debugw("Reusing existing receive adapter", zap.Any("receiveAdapter", ra))
+	}
+	return ra, nil
+}
+
+func podSpecChanged(oldPodSpec corev1.PodSpec, newPodSpec corev1.PodSpec) bool {
+	if !equality.Semantic.DeepDerivative(newPodSpec, oldPodSpec) {
+		return true
+	}
+	if len(oldPodSpec.Containers) != len(newPodSpec.Containers) {
+		return true
+	}
+	for i := range newPodSpec.Containers {
+		if !equality.Semantic.DeepEqual(newPodSpec.Containers[i].Env, oldPodSpec.Containers[i].Env) {
+			return true
+		}
+	}
+	return false
+}
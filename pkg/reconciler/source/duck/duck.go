@@ -189,6 +189,26 @@ func (r *Reconciler) makeEventTypes(ctx context.Context, src *duckv1.Source) []v
 			entries[et.Type] = et
 		}
 	}
+	// The Source may know better the schemas of the types it emits, for example
+	// from what it is configured to watch, so the entries of its status take
+	// precedence over the ones of the CRD.
+	if v, ok := src.Status.Annotations[eventing.EventTypesAnnotationKey]; ok {
+		var ets []eventTypeEntry
+		if err := json.Unmarshal([]byte(v), &ets); err != nil {
+			logging.FromContext(ctx).Errorw("Error unmarshalling EventTypes of the Source status", zap.String("annotation", eventing.EventTypesAnnotationKey), zap.Error(err))
+		}
+		for _, et := range ets {
+			entry := entries[et.Type]
+			entry.Type = et.Type
+			if et.Schema != "" {
+				entry.Schema = et.Schema
+			}
+			if et.Description != "" {
+				entry.Description = et.Description
+			}
+			entries[et.Type] = entry
+		}
+	}
 
 	eventTypes := make([]v1beta1.EventType, 0)
 	for _, attrib := range src.Status.CloudEventAttributes {
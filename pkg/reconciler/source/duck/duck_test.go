@@ -213,6 +213,35 @@ func TestAllCases(t *testing.T) {
 					return et
 				}(),
 			},
+		}, {
+			Name: "valid source with broker sink, create missing event types, read schema from source status",
+			Objects: []runtime.Object{
+				func() runtime.Object {
+					s := makeSource([]duckv1.CloudEventAttributes{{
+						Type:   "my-type-1",
+						Source: "http://my-source-1",
+					}})
+					s.Status.Annotations = map[string]string{
+						eventing.EventTypesAnnotationKey: `[{"type":"my-type-1","schema":"/some-schema-from-source"}]`,
+					}
+					return s
+				}(),
+				makeSourceCRD([]eventTypeEntry{{
+					Type:        "my-type-1",
+					Schema:      "/some-schema-from-crd",
+					Description: "This came from the annotation in a crd for the source.",
+				}}),
+			},
+			Key: testNS + "/" + sourceName,
+			WantCreates: []runtime.Object{
+				func() runtime.Object {
+					et := makeEventType("my-type-1", "http://my-source-1")
+					et.Name = "57e77dd979f925ea116c74b59fad6f0c"
+					et.Spec.Schema, _ = apis.ParseURL("/some-schema-from-source")
+					et.Spec.Description = "This came from the annotation in a crd for the source."
+					return et
+				}(),
+			},
 		}, {
 			Name: "valid source with broker sink, create missing event types, CRD has bad data",
 			Objects: []runtime.Object{
@@ -60,6 +60,15 @@ func WithInitApiServerSourceConditions(s *v1.ApiServerSource) {
 	s.Status.InitializeConditions()
 }
 
+func WithApiServerSourceAnnotation(key, value string) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		if s.Annotations == nil {
+			s.Annotations = make(map[string]string)
+		}
+		s.Annotations[key] = value
+	}
+}
+
 func WithApiServerSourceSinkNotFound(s *v1.ApiServerSource) {
 	s.Status.MarkNoSink("NotFound", "")
 }
@@ -70,6 +79,32 @@ func WithApiServerSourceSink(uri *apis.URL) ApiServerSourceOption {
 	}
 }
 
+func WithApiServerSourceSinksNotConfigured(s *v1.ApiServerSource) {
+	s.Status.MarkSinks(nil)
+}
+
+func WithApiServerSourceSinksNotFound(messageFormat string, messageA ...interface{}) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		s.Status.MarkSinksResolvedFailed("SinksNotFound", messageFormat, messageA...)
+	}
+}
+
+func WithApiServerSourceDeadLetterSinkNotConfigured(s *v1.ApiServerSource) {
+	s.Status.MarkDeadLetterSinkNotConfigured()
+}
+
+func WithApiServerSourceDeadLetterSinkNotFound(messageFormat string, messageA ...interface{}) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		s.Status.MarkDeadLetterSinkResolvedFailed("NotFound", messageFormat, messageA...)
+	}
+}
+
+func WithApiServerSourceDeadLetterSink(uri *apis.URL) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		s.Status.MarkDeadLetterSinkResolvedSucceeded(uri)
+	}
+}
+
 func WithApiServerSourceDeploymentUnavailable(s *v1.ApiServerSource) {
 	// The Deployment uses GenerateName, so its name is empty.
 	name := kmeta.ChildName(fmt.Sprintf("apiserversource-%s-", s.Name), string(s.GetUID()))
@@ -106,6 +141,29 @@ func WithApiServerSourceResourceModeEventTypes(source string) ApiServerSourceOpt
 	}
 }
 
+func WithApiServerSourceStatusAnnotation(key, value string) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		if s.Status.Annotations == nil {
+			s.Status.Annotations = make(map[string]string)
+		}
+		s.Status.Annotations[key] = value
+	}
+}
+
+func WithApiServerSourceClusterNotConfigured(s *v1.ApiServerSource) {
+	s.Status.MarkClusterNotConfigured()
+}
+
+func WithApiServerSourceClusterConnected(s *v1.ApiServerSource) {
+	s.Status.MarkClusterConnected()
+}
+
+func WithApiServerSourceClusterDisconnected(reason, messageFormat string, messageA ...interface{}) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		s.Status.MarkClusterDisconnected(reason, messageFormat, messageA...)
+	}
+}
+
 func WithApiServerSourceSufficientPermissions(s *v1.ApiServerSource) {
 	s.Status.MarkSufficientPermissions()
 }
@@ -114,6 +172,22 @@ func WithApiServerSourceNoSufficientPermissions(s *v1.ApiServerSource) {
 	s.Status.MarkNoSufficientPermissions("", `User system:serviceaccount:testnamespace:default cannot get, list, watch resource "namespaces" in API group ""`)
 }
 
+func WithApiServerSourceForbiddenNamespaces(namespaces ...string) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		s.Status.MarkForbiddenNamespaces(namespaces)
+	}
+}
+
+func WithApiServerSourceThrottled(eventsPerSecond int32, overflow, since string) ApiServerSourceOption {
+	return func(s *v1.ApiServerSource) {
+		s.Status.MarkThrottled("RateLimited", "The events above %d per second are %s since %s.", eventsPerSecond, overflow, since)
+	}
+}
+
+func WithApiServerSourceNotThrottled(s *v1.ApiServerSource) {
+	s.Status.MarkNotThrottled()
+}
+
 func WithApiServerSourceDeleted(c *v1.ApiServerSource) {
 	t := metav1.NewTime(time.Unix(1e9, 0))
 	c.ObjectMeta.SetDeletionTimestamp(&t)
@@ -0,0 +1,34 @@
+package packets
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+)
+
+// Pingreq is the Variable Header definition for a Pingreq control packet
+type Pingreq struct {
+}
+
+func (p *Pingreq) String() string {
+	return fmt.Sprintf("PINGREQ")
+}
+
+//Unpack is the implementation of the interface required function for a packet
+func (p *Pingreq) Unpack(r *bytes.Buffer) error {
+	return nil
+}
+
+// Buffers is the implementation of the interface required function for a packet
+func (p *Pingreq) Buffers() net.Buffers {
+	return nil
+}
+
+// WriteTo is the implementation of the interface required function for a packet
+func (p *Pingreq) WriteTo(w io.Writer) (int64, error) {
+	cp := &ControlPacket{FixedHeader: FixedHeader{Type: PINGREQ}}
+	cp.Content = p
+
+	return cp.WriteTo(w)
+}